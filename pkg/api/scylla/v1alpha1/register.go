@@ -55,6 +55,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ScyllaDBManagerTaskList{},
 		&ScyllaDBDatacenterNodesStatusReport{},
 		&ScyllaDBDatacenterNodesStatusReportList{},
+		&ScyllaDBVolumeSnapshot{},
+		&ScyllaDBVolumeSnapshotList{},
+		&ScyllaDBSnapshot{},
+		&ScyllaDBSnapshotList{},
 	)
 	metav1.AddToGroupVersion(scheme, GroupVersion)
 	return nil
@@ -51,6 +51,14 @@ type ScyllaDBClusterSpec struct {
 	// datacenters specify the datacenters in the cluster.
 	Datacenters []ScyllaDBClusterDatacenter `json:"datacenters"`
 
+	// lostDatacenters lists names of datacenters removed from datacenters whose remote Kubernetes clusters are permanently lost.
+	// Nodes of a lost datacenter are removed from the cluster by a surviving datacenter, without decommissioning them
+	// or cleaning up the objects left in its remote Kubernetes cluster.
+	// A lost datacenter can be added again, e.g. in a new remote Kubernetes cluster, once its removal completes
+	// and it's taken off this list.
+	// +optional
+	LostDatacenters []string `json:"lostDatacenters,omitempty"`
+
 	// disableAutomaticOrphanedNodeReplacement controls if automatic orphan node replacement should be disabled.
 	DisableAutomaticOrphanedNodeReplacement bool `json:"disableAutomaticOrphanedNodeReplacement,omitempty"`
 
@@ -77,6 +85,33 @@ type ScyllaDBClusterSpec struct {
 	// about readiness gates.
 	// +optional
 	ReadinessGates []corev1.PodReadinessGate `json:"readinessGates,omitempty"`
+
+	// rolloutStrategy controls how changes to ScyllaDB nodes are rolled out in every datacenter.
+	// Canary rollouts are approved for each datacenter separately, using datacenters[].rolloutApprovedRevision.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
+
+	// maintenanceWindows restrict when disruptive operations can start in every datacenter.
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+
+	// restoreFromBackup initializes the cluster with data restored from a ScyllaDB Manager backup.
+	// It can only be set when the ScyllaDBCluster is created.
+	// +optional
+	RestoreFromBackup *RestoreFromBackupOptions `json:"restoreFromBackup,omitempty"`
+
+	// upgradeStrategy controls how ScyllaDB version upgrades are rolled out across datacenters.
+	// Datacenters are upgraded one at a time, each has to be fully rolled out and healthy before the next one is upgraded.
+	// +optional
+	UpgradeStrategy *ScyllaDBClusterUpgradeStrategy `json:"upgradeStrategy,omitempty"`
+}
+
+// ScyllaDBClusterUpgradeStrategy controls how ScyllaDB version upgrades are rolled out across datacenters.
+type ScyllaDBClusterUpgradeStrategy struct {
+	// datacenterOrder is the list of datacenter names in the order they are upgraded in.
+	// Datacenters that aren't listed are upgraded after the listed ones, in the order they are specified in.
+	// +optional
+	DatacenterOrder []string `json:"datacenterOrder,omitempty"`
 }
 
 type ScyllaDBClusterDatacenter struct {
@@ -91,6 +126,15 @@ type ScyllaDBClusterDatacenter struct {
 	// forceRedeploymentReason can be used to force a rolling restart of all racks in this DC by providing a unique string.
 	// +optional
 	ForceRedeploymentReason *string `json:"forceRedeploymentReason,omitempty"`
+
+	// rebuild streams data to the nodes of this datacenter from another datacenter once all of them are up.
+	// It can only be set when the datacenter is added to the cluster.
+	// +optional
+	Rebuild *RebuildOptions `json:"rebuild,omitempty"`
+
+	// rolloutApprovedRevision approves continuing the canary rollout of the revision reported in the rollout status of this datacenter.
+	// +optional
+	RolloutApprovedRevision *string `json:"rolloutApprovedRevision,omitempty"`
 }
 
 type ScyllaDBClusterDatacenterTemplate struct {
@@ -200,6 +244,10 @@ type ScyllaDBClusterDatacenterStatus struct {
 	// remoteNamespaceName is the name of the corev1.Namespace where dependant objects are reconciled in remote Kubernetes cluster.
 	RemoteNamespaceName *string `json:"remoteNamespaceName,omitempty"`
 
+	// remoteKubernetesClusterName is the name of the RemoteKubernetesCluster where the datacenter is reconciled.
+	// +optional
+	RemoteKubernetesClusterName *string `json:"remoteKubernetesClusterName,omitempty"`
+
 	// version is the current version of ScyllaDB in use.
 	// +optional
 	CurrentVersion *string `json:"currentVersion,omitempty"`
@@ -236,8 +284,65 @@ type ScyllaDBClusterDatacenterStatus struct {
 	// racks contains rack statuses.
 	// +optional
 	Racks []ScyllaDBClusterRackStatus `json:"racks,omitempty"`
+
+	// rollout reflects the state of the latest rollout in datacenter when a canary rollout strategy is used.
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+
+	// upgrade reflects the progress of the ScyllaDB version upgrade in progress in datacenter.
+	// +optional
+	Upgrade *UpgradeStatus `json:"upgrade,omitempty"`
+
+	// upgradeState reflects the state of the datacenter in the ScyllaDB version upgrade of the cluster.
+	// It's only set while the cluster is being upgraded.
+	// +optional
+	UpgradeState *DatacenterUpgradeState `json:"upgradeState,omitempty"`
+
+	// rebuild reflects the progress of streaming data to the datacenter from another datacenter.
+	// +optional
+	Rebuild *RebuildStatus `json:"rebuild,omitempty"`
+
+	// removal reflects the progress of the datacenter removal.
+	// It's only set for datacenters that were removed from the spec and are being removed from the cluster.
+	// +optional
+	Removal *DatacenterRemovalStatus `json:"removal,omitempty"`
 }
 
+// +kubebuilder:validation:Enum="Decommission";"RemoveNode"
+type DatacenterRemovalMethod string
+
+const (
+	// DecommissionDatacenterRemovalMethod removes the datacenter by decommissioning its nodes one by one.
+	DecommissionDatacenterRemovalMethod DatacenterRemovalMethod = "Decommission"
+
+	// RemoveNodeDatacenterRemovalMethod removes the datacenter, whose remote Kubernetes cluster is gone,
+	// by removing its nodes from a surviving datacenter.
+	RemoveNodeDatacenterRemovalMethod DatacenterRemovalMethod = "RemoveNode"
+)
+
+type DatacenterRemovalStatus struct {
+	// method is the method used to remove the nodes of the datacenter.
+	Method DatacenterRemovalMethod `json:"method"`
+
+	// hostIDs holds the host IDs of the datacenter nodes which remain to be removed using the RemoveNode method.
+	// +optional
+	HostIDs []string `json:"hostIDs,omitempty"`
+}
+
+// +kubebuilder:validation:Enum="Pending";"Upgrading";"Upgraded"
+type DatacenterUpgradeState string
+
+const (
+	// PendingDatacenterUpgradeState is the state of a datacenter waiting for the preceding datacenters to be upgraded.
+	PendingDatacenterUpgradeState DatacenterUpgradeState = "Pending"
+
+	// UpgradingDatacenterUpgradeState is the state of a datacenter being upgraded.
+	UpgradingDatacenterUpgradeState DatacenterUpgradeState = "Upgrading"
+
+	// UpgradedDatacenterUpgradeState is the state of a datacenter that is upgraded, rolled out and healthy.
+	UpgradedDatacenterUpgradeState DatacenterUpgradeState = "Upgraded"
+)
+
 // ScyllaDBClusterStatus defines the observed state of ScyllaDBCluster.
 type ScyllaDBClusterStatus struct {
 	// observedGeneration is the most recent generation observed for this ScyllaDBCluster. It corresponds to the
@@ -281,6 +386,10 @@ type ScyllaDBClusterStatus struct {
 	// Datacenters reflect the status of datacenters.
 	// +optional
 	Datacenters []ScyllaDBClusterDatacenterStatus `json:"datacenters,omitempty"`
+
+	// restoreFromBackup reflects the progress of initializing the cluster from a ScyllaDB Manager backup.
+	// +optional
+	RestoreFromBackup *RestoreFromBackupStatus `json:"restoreFromBackup,omitempty"`
 }
 
 // +kubebuilder:object:root=true
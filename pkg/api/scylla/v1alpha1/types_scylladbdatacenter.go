@@ -78,6 +78,57 @@ type ScyllaDBDatacenterSpec struct {
 	// +optional
 	DisableAutomaticOrphanedNodeReplacement *bool `json:"disableAutomaticOrphanedNodeReplacement,omitempty"`
 
+	// storageMigration controls how racks are migrated to a different storage class.
+	// +optional
+	StorageMigration *StorageMigrationOptions `json:"storageMigration,omitempty"`
+
+	// rolloutStrategy controls how changes to ScyllaDB nodes are rolled out.
+	// +optional
+	RolloutStrategy *RolloutStrategy `json:"rolloutStrategy,omitempty"`
+
+	// restoreFromBackup initializes the datacenter with data restored from a ScyllaDB Manager backup.
+	// It can only be set when the ScyllaDBDatacenter is created.
+	// +optional
+	RestoreFromBackup *RestoreFromBackupOptions `json:"restoreFromBackup,omitempty"`
+
+	// rebuild streams data to the nodes of a datacenter added to an existing cluster from another datacenter.
+	// It can only be set when the ScyllaDBDatacenter is created.
+	// +optional
+	Rebuild *RebuildOptions `json:"rebuild,omitempty"`
+
+	// upgradeSnapshots controls the snapshots taken on ScyllaDB nodes before version upgrades.
+	// +optional
+	UpgradeSnapshots *UpgradeSnapshotsOptions `json:"upgradeSnapshots,omitempty"`
+
+	// upgradeFailurePolicy controls how ScyllaDB version upgrades that fail to make progress are handled.
+	// If not specified, an upgrade waits for the upgraded nodes indefinitely.
+	// +optional
+	UpgradeFailurePolicy *UpgradeFailurePolicy `json:"upgradeFailurePolicy,omitempty"`
+
+	// upgradePath lists intermediate ScyllaDB images, in the upgrade order, the datacenter is upgraded through
+	// before it's upgraded to the image specified in scyllaDB.image.
+	// Every intermediate image is fully rolled out before the next one is used.
+	// Images that aren't newer than the version the datacenter runs are skipped.
+	// +optional
+	UpgradePath []string `json:"upgradePath,omitempty"`
+
+	// upgradeSSTables specifies whether SSTables are rewritten in the current format after the nodes are upgraded
+	// to a new ScyllaDB release. Patch upgrades don't upgrade SSTables.
+	// SSTables are upgraded one node at a time, as the last step of the upgrade.
+	// Defaults to false.
+	// +optional
+	UpgradeSSTables *bool `json:"upgradeSSTables,omitempty"`
+
+	// maintenanceWindows restrict when disruptive operations can start. These are rolling restarts, version upgrades,
+	// storage migrations, node replacements, cleanup, SSTables upgrade and rebuild jobs, node removals
+	// and decommissioning nodes of removed racks. Operations that are needed outside of maintenance windows are queued until
+	// the next window starts. Operations that have already started are not interrupted when a window ends.
+	// Rolling restarts start rack by rack, so racks that haven't started restarting by the end of a window
+	// wait for the next one.
+	// When empty, operations can start at any time.
+	// +optional
+	MaintenanceWindows []MaintenanceWindow `json:"maintenanceWindows,omitempty"`
+
 	// minTerminationGracePeriodSeconds specifies minimum duration in seconds to wait before every drained node is
 	// terminated. This gives time to potential load balancer in front of a node to notice that node is not ready anymore
 	// and stop forwarding new requests.
@@ -227,11 +278,230 @@ type StorageOptions struct {
 	Metadata *ObjectTemplateMetadata `json:"metadata,omitempty"`
 
 	// capacity describes the requested size of each persistent volume.
+	// It can be increased, in which case the persistent volume claims of the rack are expanded online.
+	// This requires the storage class to allow volume expansion. Decreasing the capacity is not supported.
 	Capacity string `json:"capacity"`
 
 	// storageClassName specifies the name of a storageClass to request.
+	// Changing it migrates the rack to the new storage class by replacing its nodes one at a time.
 	// +optional
 	StorageClassName *string `json:"storageClassName,omitempty"`
+
+	// dataSource specifies a set of volume snapshots used to pre-populate the persistent volumes of a newly created rack.
+	// It is only used when the rack is created, and it can't be changed afterwards.
+	// +optional
+	DataSource *StorageDataSource `json:"dataSource,omitempty"`
+}
+
+// StorageDataSource references volume snapshots used to pre-populate persistent volumes.
+type StorageDataSource struct {
+	// scyllaDBVolumeSnapshotRef references a completed ScyllaDBVolumeSnapshot in the same namespace.
+	// The n-th node of the rack is seeded from the n-th volume snapshot taken of the source rack.
+	// Nodes without a corresponding volume snapshot start with empty volumes.
+	// Seeded nodes get a new host ID and tokens when they join the cluster.
+	ScyllaDBVolumeSnapshotRef LocalObjectReference `json:"scyllaDBVolumeSnapshotRef"`
+
+	// rackName is the name of the rack in the ScyllaDBVolumeSnapshot to seed from.
+	// Defaults to the name of this rack.
+	// +optional
+	RackName *string `json:"rackName,omitempty"`
+}
+
+// StorageMigrationOptions controls migration of racks to a different storage class.
+// When the storage class of a rack changes, its nodes are replaced one at a time onto new persistent volumes.
+type StorageMigrationOptions struct {
+	// paused controls whether replacing further nodes is paused.
+	// A node replacement that's already in progress is always finished.
+	// +optional
+	Paused *bool `json:"paused,omitempty"`
+}
+
+// RestoreFromBackupOptions describe a ScyllaDB Manager backup to initialize a new cluster with.
+// The cluster is registered with the global ScyllaDB Manager instance until the restore completes and,
+// once the cluster is rolled out, the schema and then the tables are restored using ScyllaDBManagerTasks.
+// The cluster doesn't become available until the restore completes.
+// The ScyllaDB version has to use Raft-based schema management, so restoring the schema doesn't require a rolling restart.
+type RestoreFromBackupOptions struct {
+	// location specifies a list of backup locations to restore from, in the following format: `[<dc>:]<provider>:<name>`.
+	// `<dc>:` is optional and allows to specify the location for a datacenter in a multi-datacenter cluster.
+	// `<provider>` specifies the storage provider.
+	// `<name>` specifies a bucket name and must be an alphanumeric string which may contain a dash and or a dot, but other characters are forbidden.
+	Location []string `json:"location"`
+
+	// snapshotTag specifies the tag of the backup snapshot to restore, e.g. `sm_20250101000000UTC`.
+	SnapshotTag string `json:"snapshotTag"`
+
+	// keyspace specifies a list of `glob` patterns used to include or exclude tables from restore.
+	// The patterns match keyspaces and tables. Keyspace names are separated from table names with a dot e.g. `!keyspace.table_prefix_*`.
+	// +optional
+	Keyspace []string `json:"keyspace,omitempty"`
+}
+
+// RestoreFromBackupStatus reflects the progress of initializing a cluster from a ScyllaDB Manager backup.
+type RestoreFromBackupStatus struct {
+	// schemaRestored indicates whether the schema has been restored.
+	SchemaRestored bool `json:"schemaRestored"`
+
+	// completionTime reflects when the restore of both the schema and the tables completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// RebuildOptions describe how data is streamed to a datacenter added to an existing cluster.
+// Once all nodes of the datacenter are up, the nodes are rebuilt from the source datacenter one at a time.
+// Nodes added to the datacenter while rebuild is set are rebuilt as well, so it should be unset once the rebuild completes.
+type RebuildOptions struct {
+	// sourceDatacenter is the name of the datacenter the data is streamed from.
+	SourceDatacenter string `json:"sourceDatacenter"`
+
+	// alterKeyspaceReplication specifies whether keyspaces replicated to the source datacenter using NetworkTopologyStrategy
+	// are altered to be replicated to this datacenter with the same replication factor before the nodes are rebuilt.
+	// Keyspaces which already specify a replication factor for this datacenter aren't altered.
+	// The keyspaces are altered over the unencrypted CQL port without credentials, so it can only be used when ScyllaDB doesn't require authentication or client encryption on that port. Otherwise, the rebuild fails and the failure is reported in the Degraded condition.
+	// Defaults to false.
+	// +optional
+	AlterKeyspaceReplication *bool `json:"alterKeyspaceReplication,omitempty"`
+}
+
+// +kubebuilder:validation:Enum="WaitingForNodes";"Rebuilding";"Completed"
+type RebuildPhase string
+
+const (
+	// WaitingForNodesRebuildPhase is the phase in which the rebuild waits for all nodes of the datacenter to be up.
+	WaitingForNodesRebuildPhase RebuildPhase = "WaitingForNodes"
+
+	// RebuildingRebuildPhase is the phase in which the nodes are rebuilt one at a time.
+	RebuildingRebuildPhase RebuildPhase = "Rebuilding"
+
+	// CompletedRebuildPhase is the phase of a rebuild that has finished on all nodes.
+	CompletedRebuildPhase RebuildPhase = "Completed"
+)
+
+// RebuildStatus reflects the progress of streaming data to a datacenter from another datacenter.
+type RebuildStatus struct {
+	// phase is the current phase of the rebuild.
+	Phase RebuildPhase `json:"phase"`
+
+	// sourceDatacenter is the name of the datacenter the data is streamed from.
+	SourceDatacenter string `json:"sourceDatacenter"`
+
+	// nodes is the number of nodes to rebuild.
+	Nodes int32 `json:"nodes"`
+
+	// rebuiltNodes is the number of nodes that have been rebuilt.
+	RebuiltNodes int32 `json:"rebuiltNodes"`
+
+	// rebuildingNode is the name of the node that is being rebuilt.
+	// +optional
+	RebuildingNode *string `json:"rebuildingNode,omitempty"`
+}
+
+// UpgradeSnapshotsOptions control the snapshots taken on ScyllaDB nodes before version upgrades.
+// System keyspaces are snapshotted on all nodes before an upgrade starts and data keyspaces are snapshotted
+// on every node before the node is upgraded.
+type UpgradeSnapshotsOptions struct {
+	// dataSnapshots specifies whether data keyspaces are snapshotted on every node before it's upgraded.
+	// Defaults to true.
+	// +optional
+	DataSnapshots *bool `json:"dataSnapshots,omitempty"`
+
+	// retainedUpgrades specifies the number of the most recent completed upgrades whose snapshots are retained on the nodes.
+	// When set to 0, snapshots are removed as soon as they are no longer needed by the upgrade.
+	// Defaults to 0.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	RetainedUpgrades *int32 `json:"retainedUpgrades,omitempty"`
+
+	// ttl specifies for how long the retained snapshots are kept once the upgrade completes.
+	// If not specified, retained snapshots are kept until they are superseded by snapshots of more recent upgrades.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+// UpgradeSnapshotStatus reflects the snapshots taken on ScyllaDB nodes before a version upgrade.
+type UpgradeSnapshotStatus struct {
+	// fromVersion is the ScyllaDB version the datacenter was upgraded from.
+	FromVersion string `json:"fromVersion"`
+
+	// toVersion is the ScyllaDB version the datacenter was upgraded to.
+	ToVersion string `json:"toVersion"`
+
+	// systemSnapshotTag is the tag of the snapshot of system keyspaces.
+	SystemSnapshotTag string `json:"systemSnapshotTag"`
+
+	// dataSnapshotTag is the tag of the snapshot of data keyspaces.
+	// +optional
+	DataSnapshotTag *string `json:"dataSnapshotTag,omitempty"`
+
+	// completionTime is the time when the upgrade completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// expirationTime is the time when the snapshots are removed from the nodes.
+	// +optional
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+}
+
+type UpgradeFailureAction string
+
+const (
+	// HaltUpgradeFailureAction stops the upgrade and leaves the nodes as they are.
+	HaltUpgradeFailureAction UpgradeFailureAction = "Halt"
+
+	// RollbackUpgradeFailureAction stops the upgrade and rolls the upgraded nodes back to the version the upgrade started from.
+	RollbackUpgradeFailureAction UpgradeFailureAction = "Rollback"
+)
+
+// UpgradeFailurePolicy controls how ScyllaDB version upgrades that fail to make progress are handled.
+type UpgradeFailurePolicy struct {
+	// nodeReadinessTimeout specifies how long a node can take to be upgraded and become ready
+	// before the upgrade is considered failed.
+	NodeReadinessTimeout metav1.Duration `json:"nodeReadinessTimeout"`
+
+	// action specifies what happens when an upgrade fails.
+	// A halted upgrade can be rolled back by reverting the ScyllaDB image to the version the upgrade started from.
+	// Changing the ScyllaDB image to any other version abandons the failed upgrade.
+	// +kubebuilder:validation:Enum=Halt;Rollback
+	// +kubebuilder:default:="Halt"
+	// +optional
+	Action UpgradeFailureAction `json:"action,omitempty"`
+}
+
+// RolloutStrategy controls how changes to ScyllaDB nodes are rolled out.
+type RolloutStrategy struct {
+	// canary makes every rollout update a limited number of canary nodes first and hold the rest of the nodes
+	// until the canary nodes soak for the specified duration or the rollout is explicitly approved.
+	// +optional
+	Canary *CanaryRolloutStrategy `json:"canary,omitempty"`
+}
+
+// CanaryRolloutStrategy describes the canary phase of a rollout.
+type CanaryRolloutStrategy struct {
+	// nodes specifies the number of nodes that are updated first.
+	// Canary nodes are picked from racks in the order they are specified, starting with the highest ordinals.
+	// +kubebuilder:validation:Minimum=1
+	Nodes int32 `json:"nodes"`
+
+	// soakDuration specifies how long the canary nodes have to stay ready before the rollout continues automatically.
+	// If not set, the rollout continues only after it's approved.
+	// +optional
+	SoakDuration *metav1.Duration `json:"soakDuration,omitempty"`
+
+	// approvedRevision approves continuing the rollout of the revision reported in the rollout status.
+	// Once a rollout continues past its canary nodes, changing this field doesn't pause it again.
+	// It can't be set in ScyllaDBCluster, which approves rollouts for each datacenter separately.
+	// +optional
+	ApprovedRevision *string `json:"approvedRevision,omitempty"`
+}
+
+// MaintenanceWindow describes a recurring time window.
+type MaintenanceWindow struct {
+	// cron specifies when the window starts, using the same syntax as ScyllaDBManagerTaskSchedule cron.
+	// The schedule is evaluated in UTC.
+	Cron string `json:"cron"`
+
+	// duration specifies how long the window lasts.
+	Duration metav1.Duration `json:"duration"`
 }
 
 type TLSCertificateType string
@@ -485,6 +755,162 @@ type RackStatus struct {
 	// stale should eventually become false when the appropriate controller writes a fresh status.
 	// +optional
 	Stale *bool `json:"stale,omitempty"`
+
+	// conditions hold conditions describing the rack state, like the progress of storage expansion.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// storageMigration reflects the progress of migrating the rack to a different storage class.
+	// +optional
+	StorageMigration *RackStorageMigrationStatus `json:"storageMigration,omitempty"`
+
+	// volumeSnapshotSeededNodes lists the names of nodes in the rack whose volumes were pre-populated from volume snapshots.
+	// +optional
+	VolumeSnapshotSeededNodes []string `json:"volumeSnapshotSeededNodes,omitempty"`
+
+	// removal reflects the progress of decommissioning a rack that was removed from the spec.
+	// +optional
+	Removal *RackRemovalStatus `json:"removal,omitempty"`
+}
+
+// RackRemovalStatus describes the progress of removing a rack.
+type RackRemovalStatus struct {
+	// remainingNodes is the number of nodes of the rack that are yet to be decommissioned.
+	RemainingNodes int32 `json:"remainingNodes"`
+
+	// decommissioningNode is the name of the node being decommissioned.
+	// +optional
+	DecommissioningNode *string `json:"decommissioningNode,omitempty"`
+}
+
+// RackStorageMigrationStatus describes the progress of migrating a rack to a different storage class.
+type RackStorageMigrationStatus struct {
+	// storageClassName is the name of the storage class the rack is migrating to.
+	StorageClassName string `json:"storageClassName"`
+
+	// nodes is the number of nodes in the rack.
+	Nodes int32 `json:"nodes"`
+
+	// migratedNodes is the number of nodes using the new storage class.
+	MigratedNodes int32 `json:"migratedNodes"`
+
+	// paused indicates whether the migration is paused.
+	Paused bool `json:"paused"`
+}
+
+const (
+	// RackStorageResizeProgressingCondition indicates whether the persistent volume claims of a rack are being expanded.
+	RackStorageResizeProgressingCondition = "StorageResizeProgressing"
+
+	// RackStorageResizeDegradedCondition indicates whether expanding the persistent volume claims of a rack failed.
+	RackStorageResizeDegradedCondition = "StorageResizeDegraded"
+
+	// RackStorageMigrationProgressingCondition indicates whether a rack is being migrated to a different storage class.
+	RackStorageMigrationProgressingCondition = "StorageMigrationProgressing"
+
+	// RackStorageMigrationDegradedCondition indicates whether migrating a rack to a different storage class failed.
+	RackStorageMigrationDegradedCondition = "StorageMigrationDegraded"
+)
+
+const (
+	// WaitingForMaintenanceWindowCondition indicates whether disruptive operations are queued until the next maintenance window.
+	WaitingForMaintenanceWindowCondition = "WaitingForMaintenanceWindow"
+
+	// UpgradeDegradedCondition indicates whether a ScyllaDB version upgrade has failed.
+	// A failed upgrade is reported until the ScyllaDB image is changed to a version other than the failed one.
+	UpgradeDegradedCondition = "UpgradeDegraded"
+)
+
+// RolloutStatus describes the state of a rollout.
+type RolloutStatus struct {
+	// revision identifies the desired state of ScyllaDB nodes that is being rolled out.
+	Revision string `json:"revision"`
+
+	// updatedNodes lists the nodes that run the revision.
+	// +optional
+	UpdatedNodes []string `json:"updatedNodes,omitempty"`
+
+	// paused indicates whether the rollout is paused after updating the canary nodes,
+	// waiting for them to soak or for an approval.
+	Paused bool `json:"paused"`
+
+	// canaryReadyTime is the time when all canary nodes were updated and ready.
+	// +optional
+	CanaryReadyTime *metav1.Time `json:"canaryReadyTime,omitempty"`
+
+	// canaryCompleted indicates whether the canary phase has finished and the rollout continues to the rest of the nodes.
+	CanaryCompleted bool `json:"canaryCompleted"`
+}
+
+type UpgradePhase string
+
+const (
+	// PreHooksUpgradePhase is the phase in which schema agreement is awaited and system keyspaces are snapshotted.
+	PreHooksUpgradePhase UpgradePhase = "PreHooks"
+
+	// RolloutInitUpgradePhase is the phase in which the updated StatefulSets are applied without updating any node.
+	RolloutInitUpgradePhase UpgradePhase = "RolloutInit"
+
+	// RolloutRunUpgradePhase is the phase in which nodes are drained, snapshotted and upgraded one by one.
+	RolloutRunUpgradePhase UpgradePhase = "RolloutRun"
+
+	// PostHooksUpgradePhase is the phase in which the upgrade is finalized after all nodes have been upgraded.
+	PostHooksUpgradePhase UpgradePhase = "PostHooks"
+
+	// RollbackUpgradePhase is the phase in which the nodes of a failed upgrade are rolled back.
+	RollbackUpgradePhase UpgradePhase = "Rollback"
+
+	// FailedUpgradePhase is the phase of a failed upgrade waiting for the ScyllaDB image to change.
+	FailedUpgradePhase UpgradePhase = "Failed"
+)
+
+// RackUpgradeStatus reflects the progress of a ScyllaDB version upgrade in a rack.
+type RackUpgradeStatus struct {
+	// name is the name of the rack.
+	Name string `json:"name"`
+
+	// nodes is the number of nodes in the rack.
+	Nodes int32 `json:"nodes"`
+
+	// upgradedNodes is the number of ready nodes in the rack that run the version being upgraded to.
+	UpgradedNodes int32 `json:"upgradedNodes"`
+
+	// sstablesUpgradedNodes is the number of nodes in the rack that have had their SSTables upgraded.
+	// It's only set when SSTables are upgraded as part of the upgrade.
+	// +optional
+	SSTablesUpgradedNodes *int32 `json:"sstablesUpgradedNodes,omitempty"`
+}
+
+// UpgradeStatus reflects the progress of a ScyllaDB version upgrade.
+type UpgradeStatus struct {
+	// phase is the current phase of the upgrade.
+	Phase UpgradePhase `json:"phase"`
+
+	// fromVersion is the ScyllaDB version the upgrade started from.
+	FromVersion string `json:"fromVersion"`
+
+	// toVersion is the ScyllaDB version being upgraded to.
+	ToVersion string `json:"toVersion"`
+
+	// startTime is the time when the upgrade started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// systemSnapshotTag is the tag of the snapshot of system keyspaces taken before the upgrade.
+	// +optional
+	SystemSnapshotTag *string `json:"systemSnapshotTag,omitempty"`
+
+	// dataSnapshotTag is the tag of the snapshots of data keyspaces taken before the nodes are upgraded.
+	// +optional
+	DataSnapshotTag *string `json:"dataSnapshotTag,omitempty"`
+
+	// upgradingNode is the name of the node that is being upgraded.
+	// +optional
+	UpgradingNode *string `json:"upgradingNode,omitempty"`
+
+	// racks reflect the progress of the upgrade in racks.
+	// +optional
+	Racks []RackUpgradeStatus `json:"racks,omitempty"`
 }
 
 // ScyllaDBDatacenterStatus defines the observed state of ScyllaDBDatacenter.
@@ -527,6 +953,26 @@ type ScyllaDBDatacenterStatus struct {
 
 	// racks reflect the status of datacenter racks.
 	Racks []RackStatus `json:"racks"`
+
+	// rollout reflects the state of the latest rollout when a canary rollout strategy is used.
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+
+	// restoreFromBackup reflects the progress of initializing the datacenter from a ScyllaDB Manager backup.
+	// +optional
+	RestoreFromBackup *RestoreFromBackupStatus `json:"restoreFromBackup,omitempty"`
+
+	// rebuild reflects the progress of streaming data to the datacenter from another datacenter.
+	// +optional
+	Rebuild *RebuildStatus `json:"rebuild,omitempty"`
+
+	// upgradeSnapshots reflect the snapshots taken before version upgrades that are still present on the nodes.
+	// +optional
+	UpgradeSnapshots []UpgradeSnapshotStatus `json:"upgradeSnapshots,omitempty"`
+
+	// upgrade reflects the progress of the ScyllaDB version upgrade in progress.
+	// +optional
+	Upgrade *UpgradeStatus `json:"upgrade,omitempty"`
 }
 
 // +kubebuilder:object:root=true
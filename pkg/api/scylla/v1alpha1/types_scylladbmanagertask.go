@@ -10,8 +10,11 @@ import (
 type ScyllaDBManagerTaskType string
 
 const (
-	ScyllaDBManagerTaskTypeBackup ScyllaDBManagerTaskType = "Backup"
-	ScyllaDBManagerTaskTypeRepair ScyllaDBManagerTaskType = "Repair"
+	ScyllaDBManagerTaskTypeBackup         ScyllaDBManagerTaskType = "Backup"
+	ScyllaDBManagerTaskTypeRepair         ScyllaDBManagerTaskType = "Repair"
+	ScyllaDBManagerTaskTypeRestore        ScyllaDBManagerTaskType = "Restore"
+	ScyllaDBManagerTaskTypeValidateBackup ScyllaDBManagerTaskType = "ValidateBackup"
+	ScyllaDBManagerTaskTypeHealthcheck    ScyllaDBManagerTaskType = "Healthcheck"
 )
 
 type ScyllaDBManagerTaskSchedule struct {
@@ -20,6 +23,12 @@ type ScyllaDBManagerTaskSchedule struct {
 	// +optional
 	Cron *string `json:"cron,omitempty"`
 
+	// timezone specifies the IANA time zone name, e.g. `Europe/Warsaw`, in which the cron expression is interpreted.
+	// It allows for schedules that don't drift with daylight saving time changes.
+	// It can only be set together with cron. If not set, cron is interpreted in UTC.
+	// +optional
+	Timezone *string `json:"timezone,omitempty"`
+
 	// numRetries specifies how many times a scheduled task should be retried before failing.
 	// +optional
 	NumRetries *int64 `json:"numRetries,omitempty"`
@@ -127,6 +136,87 @@ type ScyllaDBManagerRepairTaskOptions struct {
 	SmallTableThreshold *resource.Quantity `json:"smallTableThreshold,omitempty"`
 }
 
+type ScyllaDBManagerRestoreTaskOptions struct {
+	// schedule specifies when the restore task is run.
+	// Restore tasks can't be recurring, so cron can't be set.
+	ScyllaDBManagerTaskSchedule `json:",inline"`
+
+	// location specifies a list of backup locations to restore from, in the following format: `[<dc>:]<provider>:<name>`.
+	// `<dc>:` is optional and allows to specify the location for a datacenter in a multi-datacenter cluster.
+	// `<provider>` specifies the storage provider.
+	// `<name>` specifies a bucket name and must be an alphanumeric string which may contain a dash and or a dot, but other characters are forbidden.
+	Location []string `json:"location"`
+
+	// snapshotTag specifies the tag of the backup snapshot to restore, e.g. `sm_20250101000000UTC`.
+	SnapshotTag string `json:"snapshotTag"`
+
+	// keyspace specifies a list of `glob` patterns used to include or exclude tables from restore.
+	// The patterns match keyspaces and tables. Keyspace names are separated from table names with a dot e.g. `!keyspace.table_prefix_*`.
+	// +optional
+	Keyspace []string `json:"keyspace,omitempty"`
+
+	// restoreSchema indicates that the schema should be restored.
+	// Exactly one of restoreSchema and restoreTables has to be enabled.
+	// +optional
+	RestoreSchema *bool `json:"restoreSchema,omitempty"`
+
+	// restoreTables indicates that the contents of the tables should be restored.
+	// Exactly one of restoreSchema and restoreTables has to be enabled.
+	// +optional
+	RestoreTables *bool `json:"restoreTables,omitempty"`
+
+	// batchSize specifies the number of SSTables per shard that are downloaded and restored by a node in a single batch.
+	// When set to zero, the batch size is adjusted to restore all SSTables of a node in a single batch.
+	// If not set, the default value is left to ScyllaDB Manager to decide.
+	// +optional
+	BatchSize *int64 `json:"batchSize,omitempty"`
+
+	// parallel specifies the maximum number of ScyllaDB nodes that restore batches at the same time.
+	// When set to zero, all nodes restore at the same time.
+	// If not set, the default value is left to ScyllaDB Manager to decide.
+	// +optional
+	Parallel *int64 `json:"parallel,omitempty"`
+}
+
+type ScyllaDBManagerValidateBackupTaskOptions struct {
+	// schedule specifies the schedule on which the validate backup task is run.
+	ScyllaDBManagerTaskSchedule `json:",inline"`
+
+	// location specifies a list of backup locations to validate in the following format: `[<dc>:]<provider>:<name>`.
+	// `<dc>:` is optional and allows to specify the location for a datacenter in a multi-datacenter cluster.
+	// `<provider>` specifies the storage provider.
+	// `<name>` specifies a bucket name and must be an alphanumeric string which may contain a dash and or a dot, but other characters are forbidden.
+	Location []string `json:"location"`
+
+	// deleteOrphanedFiles indicates that the files which don't belong to any snapshot should be deleted from the backup locations.
+	// +optional
+	DeleteOrphanedFiles *bool `json:"deleteOrphanedFiles,omitempty"`
+
+	// parallel specifies the maximum number of ScyllaDB nodes that validate the backup locations at the same time.
+	// When set to zero, all nodes take part in the validation at the same time.
+	// If not set, the default value is left to ScyllaDB Manager to decide.
+	// +optional
+	Parallel *int64 `json:"parallel,omitempty"`
+}
+
+// +kubebuilder:validation:Enum="CQL";"REST";"Alternator"
+type ScyllaDBManagerHealthcheckMode string
+
+const (
+	ScyllaDBManagerHealthcheckModeCQL        ScyllaDBManagerHealthcheckMode = "CQL"
+	ScyllaDBManagerHealthcheckModeREST       ScyllaDBManagerHealthcheckMode = "REST"
+	ScyllaDBManagerHealthcheckModeAlternator ScyllaDBManagerHealthcheckMode = "Alternator"
+)
+
+type ScyllaDBManagerHealthcheckTaskOptions struct {
+	// mode specifies which of the healthcheck tasks, that ScyllaDB Manager creates for every registered cluster, is configured.
+	// Healthcheck tasks aren't created or deleted by the ScyllaDBManagerTask. On deletion, the default interval is restored.
+	Mode ScyllaDBManagerHealthcheckMode `json:"mode"`
+
+	// interval specifies how often the healthcheck is run.
+	Interval metav1.Duration `json:"interval"`
+}
+
 type ScyllaDBManagerTaskSpec struct {
 	// scyllaDBClusterRef is a typed reference to the target cluster in the same namespace.
 	// Supported kinds are ScyllaDBCluster and ScyllaDBDatacenter in scylla.scylladb.com group.
@@ -142,6 +232,74 @@ type ScyllaDBManagerTaskSpec struct {
 	// repair specifies the options for a repair task.
 	// +optional
 	Repair *ScyllaDBManagerRepairTaskOptions `json:"repair,omitempty"`
+
+	// restore specifies the options for a restore task.
+	// +optional
+	Restore *ScyllaDBManagerRestoreTaskOptions `json:"restore,omitempty"`
+
+	// validateBackup specifies the options for a validate backup task.
+	// +optional
+	ValidateBackup *ScyllaDBManagerValidateBackupTaskOptions `json:"validateBackup,omitempty"`
+
+	// healthcheck specifies the options for a healthcheck task.
+	// +optional
+	Healthcheck *ScyllaDBManagerHealthcheckTaskOptions `json:"healthcheck,omitempty"`
+}
+
+type ScyllaDBManagerTaskRunStatus struct {
+	// id reflects the identification number of the run in ScyllaDB Manager state.
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// status reflects the status of the run, as reported by ScyllaDB Manager, e.g. `RUNNING`, `DONE` or `ERROR`.
+	// +optional
+	Status *string `json:"status,omitempty"`
+
+	// stage reflects the stage the run is in, as reported by ScyllaDB Manager.
+	// It is only reported for backup and restore tasks.
+	// +optional
+	Stage *string `json:"stage,omitempty"`
+
+	// progress reflects the completion percentage of the run.
+	// +optional
+	Progress *int32 `json:"progress,omitempty"`
+
+	// cause reflects the reason of the run's failure.
+	// +optional
+	Cause *string `json:"cause,omitempty"`
+
+	// startTime reflects when the run started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// endTime reflects when the run finished.
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+}
+
+type ScyllaDBManagerBackupTaskStatus struct {
+	// latestSnapshotTag reflects the snapshot tag of the latest successfully completed backup run.
+	// It can be used as the snapshot tag of a restore task.
+	// +optional
+	LatestSnapshotTag *string `json:"latestSnapshotTag,omitempty"`
+}
+
+type ScyllaDBManagerTaskOnDemandRunStatus struct {
+	// request reflects the value of the run-now annotation that the run was triggered for.
+	Request string `json:"request"`
+
+	// triggerTime reflects when the run was requested from ScyllaDB Manager.
+	TriggerTime metav1.Time `json:"triggerTime"`
+
+	// previousRunID reflects the identification number of the latest run of the task in ScyllaDB Manager state
+	// at the time the run was requested. It tells the triggered run apart from the runs started before it.
+	// +optional
+	PreviousRunID *string `json:"previousRunID,omitempty"`
+
+	// runID reflects the identification number of the triggered run in ScyllaDB Manager state.
+	// It is set once the run is observed to have started.
+	// +optional
+	RunID *string `json:"runID,omitempty"`
 }
 
 type ScyllaDBManagerTaskStatus struct {
@@ -158,6 +316,27 @@ type ScyllaDBManagerTaskStatus struct {
 	// It can be used to identify the task when interacting directly with ScyllaDB Manager.
 	// +optional
 	TaskID *string `json:"taskID,omitempty"`
+
+	// nextActivation reflects when the task is scheduled to run next, as reported by ScyllaDB Manager.
+	// +optional
+	NextActivation *metav1.Time `json:"nextActivation,omitempty"`
+
+	// nextActivationInTimezone reflects nextActivation in the time zone of the task's schedule, in RFC3339 format.
+	// It is only set when the schedule has a time zone configured.
+	// +optional
+	NextActivationInTimezone *string `json:"nextActivationInTimezone,omitempty"`
+
+	// lastRun reflects the progress and the outcome of the latest run of the task.
+	// +optional
+	LastRun *ScyllaDBManagerTaskRunStatus `json:"lastRun,omitempty"`
+
+	// backup reflects the state specific to backup tasks.
+	// +optional
+	Backup *ScyllaDBManagerBackupTaskStatus `json:"backup,omitempty"`
+
+	// onDemandRun reflects the latest run triggered with the run-now annotation.
+	// +optional
+	OnDemandRun *ScyllaDBManagerTaskOnDemandRunStatus `json:"onDemandRun,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -167,6 +346,11 @@ type ScyllaDBManagerTaskStatus struct {
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:printcolumn:name="PROGRESSING",type=string,JSONPath=".status.conditions[?(@.type=='Progressing')].status"
 // +kubebuilder:printcolumn:name="DEGRADED",type=string,JSONPath=".status.conditions[?(@.type=='Degraded')].status"
+// +kubebuilder:printcolumn:name="LAST RUN",type=string,JSONPath=".status.lastRun.status"
+// +kubebuilder:printcolumn:name="PROGRESS",type=integer,JSONPath=".status.lastRun.progress"
+// +kubebuilder:printcolumn:name="NEXT ACTIVATION",type=date,JSONPath=".status.nextActivation"
+// +kubebuilder:printcolumn:name="SNAPSHOT TAG",type=string,JSONPath=".status.backup.latestSnapshotTag",priority=1
+// +kubebuilder:printcolumn:name="CAUSE",type=string,JSONPath=".status.lastRun.cause",priority=1
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 
 type ScyllaDBManagerTask struct {
@@ -0,0 +1,108 @@
+// Copyright (C) 2025 ScyllaDB
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type ScyllaDBSnapshotKeyspace struct {
+	// name specifies the name of the keyspace.
+	Name string `json:"name"`
+
+	// tables specifies the names of the tables of the keyspace to snapshot.
+	// If empty, all tables of the keyspace are snapshotted.
+	// +optional
+	Tables []string `json:"tables,omitempty"`
+}
+
+type ScyllaDBSnapshotSpec struct {
+	// scyllaDBDatacenterRef specifies the reference to the local ScyllaDBDatacenter whose nodes should be snapshotted.
+	ScyllaDBDatacenterRef LocalObjectReference `json:"scyllaDBDatacenterRef"`
+
+	// keyspaces specifies the keyspaces and tables to snapshot.
+	// If empty, all keyspaces are snapshotted.
+	// +optional
+	Keyspaces []ScyllaDBSnapshotKeyspace `json:"keyspaces,omitempty"`
+
+	// ttl specifies for how long the snapshot is retained once it's taken.
+	// When it expires, the ScyllaDBSnapshot is deleted along with the snapshot on the ScyllaDB nodes.
+	// If not specified, the snapshot is retained until the ScyllaDBSnapshot is deleted.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
+type ScyllaDBSnapshotNodeStatus struct {
+	// rackName is the name of the rack the node belongs to.
+	RackName string `json:"rackName"`
+
+	// podName is the name of the ScyllaDB node Pod.
+	PodName string `json:"podName"`
+
+	// snapshotTime is the time when the snapshot was taken on the node.
+	// +optional
+	SnapshotTime *metav1.Time `json:"snapshotTime,omitempty"`
+
+	// error reflects the last error encountered when taking the snapshot on the node.
+	// +optional
+	Error *string `json:"error,omitempty"`
+}
+
+type ScyllaDBSnapshotStatus struct {
+	// observedGeneration is the most recent generation observed for this ScyllaDBSnapshot. It corresponds to the
+	// ScyllaDBSnapshot's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// conditions hold conditions describing ScyllaDBSnapshot state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// snapshotTag is the tag of the snapshot taken on the ScyllaDB nodes.
+	// +optional
+	SnapshotTag *string `json:"snapshotTag,omitempty"`
+
+	// nodes reflect the state of the snapshot on every ScyllaDB node.
+	// +optional
+	Nodes []ScyllaDBSnapshotNodeStatus `json:"nodes,omitempty"`
+
+	// completionTime is the time when the snapshot was taken on all the nodes.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// expirationTime is the time when the snapshot expires and is removed.
+	// +optional
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:printcolumn:name="AVAILABLE",type=string,JSONPath=".status.conditions[?(@.type=='Available')].status"
+// +kubebuilder:printcolumn:name="PROGRESSING",type=string,JSONPath=".status.conditions[?(@.type=='Progressing')].status"
+// +kubebuilder:printcolumn:name="DEGRADED",type=string,JSONPath=".status.conditions[?(@.type=='Degraded')].status"
+// +kubebuilder:printcolumn:name="TAG",type=string,JSONPath=".status.snapshotTag"
+// +kubebuilder:printcolumn:name="EXPIRATION",type="date",JSONPath=".status.expirationTime"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ScyllaDBSnapshot defines a ScyllaDB snapshot of the selected keyspaces and tables, taken on all ScyllaDB nodes in a ScyllaDBDatacenter
+// without ScyllaDB Manager.
+type ScyllaDBSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec defines the desired state of ScyllaDBSnapshot.
+	Spec ScyllaDBSnapshotSpec `json:"spec,omitempty"`
+
+	// status reflects the observed state of ScyllaDBSnapshot.
+	Status ScyllaDBSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ScyllaDBSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScyllaDBSnapshot `json:"items"`
+}
@@ -0,0 +1,88 @@
+// Copyright (C) 2025 ScyllaDB
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+type ScyllaDBVolumeSnapshotSpec struct {
+	// scyllaDBDatacenterRef specifies the reference to the local ScyllaDBDatacenter whose data volumes should be snapshotted.
+	ScyllaDBDatacenterRef LocalObjectReference `json:"scyllaDBDatacenterRef"`
+
+	// volumeSnapshotClassName specifies the name of the VolumeSnapshotClass used for the created VolumeSnapshots.
+	// If not specified, the default VolumeSnapshotClass of the CSI driver is used.
+	// +optional
+	VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty"`
+}
+
+type ScyllaDBVolumeSnapshotVolumeStatus struct {
+	// rackName is the name of the rack the snapshotted node belongs to.
+	RackName string `json:"rackName"`
+
+	// podName is the name of the snapshotted ScyllaDB node Pod.
+	PodName string `json:"podName"`
+
+	// persistentVolumeClaimName is the name of the snapshotted data PersistentVolumeClaim.
+	PersistentVolumeClaimName string `json:"persistentVolumeClaimName"`
+
+	// volumeSnapshotName is the name of the VolumeSnapshot created from the data PersistentVolumeClaim.
+	VolumeSnapshotName string `json:"volumeSnapshotName"`
+
+	// readyToUse reflects whether the VolumeSnapshot is ready to be used to restore a volume.
+	// +optional
+	ReadyToUse *bool `json:"readyToUse,omitempty"`
+}
+
+type ScyllaDBVolumeSnapshotStatus struct {
+	// observedGeneration is the most recent generation observed for this ScyllaDBVolumeSnapshot. It corresponds to the
+	// ScyllaDBVolumeSnapshot's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
+
+	// conditions hold conditions describing ScyllaDBVolumeSnapshot state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// snapshotTag is the tag of the ScyllaDB snapshot taken on every node before the VolumeSnapshots were created.
+	// +optional
+	SnapshotTag *string `json:"snapshotTag,omitempty"`
+
+	// volumeSnapshots reflect the VolumeSnapshots of the data volumes of every ScyllaDB node.
+	// +optional
+	VolumeSnapshots []ScyllaDBVolumeSnapshotVolumeStatus `json:"volumeSnapshots,omitempty"`
+
+	// completionTime is the time when all the VolumeSnapshots became ready to use.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:printcolumn:name="AVAILABLE",type=string,JSONPath=".status.conditions[?(@.type=='Available')].status"
+// +kubebuilder:printcolumn:name="PROGRESSING",type=string,JSONPath=".status.conditions[?(@.type=='Progressing')].status"
+// +kubebuilder:printcolumn:name="DEGRADED",type=string,JSONPath=".status.conditions[?(@.type=='Degraded')].status"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+
+// ScyllaDBVolumeSnapshot defines a crash-consistent snapshot of the data volumes of all ScyllaDB nodes in a ScyllaDBDatacenter,
+// taken with CSI VolumeSnapshots.
+type ScyllaDBVolumeSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec defines the desired state of ScyllaDBVolumeSnapshot.
+	Spec ScyllaDBVolumeSnapshotSpec `json:"spec,omitempty"`
+
+	// status reflects the observed state of ScyllaDBVolumeSnapshot.
+	Status ScyllaDBVolumeSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+type ScyllaDBVolumeSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScyllaDBVolumeSnapshot `json:"items"`
+}
@@ -6,8 +6,8 @@
 package v1alpha1
 
 import (
-	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -91,6 +91,32 @@ func (in *CQLExposeOptions) DeepCopy() *CQLExposeOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryRolloutStrategy) DeepCopyInto(out *CanaryRolloutStrategy) {
+	*out = *in
+	if in.SoakDuration != nil {
+		in, out := &in.SoakDuration, &out.SoakDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ApprovedRevision != nil {
+		in, out := &in.ApprovedRevision, &out.ApprovedRevision
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryRolloutStrategy.
+func (in *CanaryRolloutStrategy) DeepCopy() *CanaryRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientHealthcheckProbes) DeepCopyInto(out *ClientHealthcheckProbes) {
 	*out = *in
@@ -133,6 +159,27 @@ func (in *Components) DeepCopy() *Components {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatacenterRemovalStatus) DeepCopyInto(out *DatacenterRemovalStatus) {
+	*out = *in
+	if in.HostIDs != nil {
+		in, out := &in.HostIDs, &out.HostIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatacenterRemovalStatus.
+func (in *DatacenterRemovalStatus) DeepCopy() *DatacenterRemovalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatacenterRemovalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeviceDiscovery) DeepCopyInto(out *DeviceDiscovery) {
 	*out = *in
@@ -544,6 +591,23 @@ func (in *LoopDeviceConfiguration) DeepCopy() *LoopDeviceConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MountConfiguration) DeepCopyInto(out *MountConfiguration) {
 	*out = *in
@@ -710,7 +774,7 @@ func (in *NodeConfigPlacement) DeepCopyInto(out *NodeConfigPlacement) {
 	in.Affinity.DeepCopyInto(&out.Affinity)
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+		*out = make([]corev1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -746,7 +810,7 @@ func (in *NodeConfigSpec) DeepCopyInto(out *NodeConfigSpec) {
 	}
 	if in.Sysctls != nil {
 		in, out := &in.Sysctls, &out.Sysctls
-		*out = make([]v1.Sysctl, len(*in))
+		*out = make([]corev1.Sysctl, len(*in))
 		copy(*out, *in)
 	}
 	return
@@ -798,7 +862,7 @@ func (in *NodeServiceTemplate) DeepCopyInto(out *NodeServiceTemplate) {
 	in.ObjectTemplateMetadata.DeepCopyInto(&out.ObjectTemplateMetadata)
 	if in.ExternalTrafficPolicy != nil {
 		in, out := &in.ExternalTrafficPolicy, &out.ExternalTrafficPolicy
-		*out = new(v1.ServiceExternalTrafficPolicy)
+		*out = new(corev1.ServiceExternalTrafficPolicy)
 		**out = **in
 	}
 	if in.AllocateLoadBalancerNodePorts != nil {
@@ -813,7 +877,7 @@ func (in *NodeServiceTemplate) DeepCopyInto(out *NodeServiceTemplate) {
 	}
 	if in.InternalTrafficPolicy != nil {
 		in, out := &in.InternalTrafficPolicy, &out.InternalTrafficPolicy
-		*out = new(v1.ServiceInternalTrafficPolicy)
+		*out = new(corev1.ServiceInternalTrafficPolicy)
 		**out = **in
 	}
 	return
@@ -932,22 +996,22 @@ func (in *Placement) DeepCopyInto(out *Placement) {
 	*out = *in
 	if in.NodeAffinity != nil {
 		in, out := &in.NodeAffinity, &out.NodeAffinity
-		*out = new(v1.NodeAffinity)
+		*out = new(corev1.NodeAffinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.PodAffinity != nil {
 		in, out := &in.PodAffinity, &out.PodAffinity
-		*out = new(v1.PodAffinity)
+		*out = new(corev1.PodAffinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.PodAntiAffinity != nil {
 		in, out := &in.PodAntiAffinity, &out.PodAntiAffinity
-		*out = new(v1.PodAntiAffinity)
+		*out = new(corev1.PodAntiAffinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+		*out = make([]corev1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -970,22 +1034,22 @@ func (in *PlacementSpec) DeepCopyInto(out *PlacementSpec) {
 	*out = *in
 	if in.NodeAffinity != nil {
 		in, out := &in.NodeAffinity, &out.NodeAffinity
-		*out = new(v1.NodeAffinity)
+		*out = new(corev1.NodeAffinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.PodAffinity != nil {
 		in, out := &in.PodAffinity, &out.PodAffinity
-		*out = new(v1.PodAffinity)
+		*out = new(corev1.PodAffinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.PodAntiAffinity != nil {
 		in, out := &in.PodAntiAffinity, &out.PodAntiAffinity
-		*out = new(v1.PodAntiAffinity)
+		*out = new(corev1.PodAntiAffinity)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+		*out = make([]corev1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1187,6 +1251,27 @@ func (in *RackNodesStatusReport) DeepCopy() *RackNodesStatusReport {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RackRemovalStatus) DeepCopyInto(out *RackRemovalStatus) {
+	*out = *in
+	if in.DecommissioningNode != nil {
+		in, out := &in.DecommissioningNode, &out.DecommissioningNode
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RackRemovalStatus.
+func (in *RackRemovalStatus) DeepCopy() *RackRemovalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RackRemovalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RackSpec) DeepCopyInto(out *RackSpec) {
 	*out = *in
@@ -1237,6 +1322,28 @@ func (in *RackStatus) DeepCopyInto(out *RackStatus) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StorageMigration != nil {
+		in, out := &in.StorageMigration, &out.StorageMigration
+		*out = new(RackStorageMigrationStatus)
+		**out = **in
+	}
+	if in.VolumeSnapshotSeededNodes != nil {
+		in, out := &in.VolumeSnapshotSeededNodes, &out.VolumeSnapshotSeededNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Removal != nil {
+		in, out := &in.Removal, &out.Removal
+		*out = new(RackRemovalStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1250,6 +1357,22 @@ func (in *RackStatus) DeepCopy() *RackStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RackStorageMigrationStatus) DeepCopyInto(out *RackStorageMigrationStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RackStorageMigrationStatus.
+func (in *RackStorageMigrationStatus) DeepCopy() *RackStorageMigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RackStorageMigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RackTemplate) DeepCopyInto(out *RackTemplate) {
 	*out = *in
@@ -1298,6 +1421,69 @@ func (in *RackTemplate) DeepCopy() *RackTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RackUpgradeStatus) DeepCopyInto(out *RackUpgradeStatus) {
+	*out = *in
+	if in.SSTablesUpgradedNodes != nil {
+		in, out := &in.SSTablesUpgradedNodes, &out.SSTablesUpgradedNodes
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RackUpgradeStatus.
+func (in *RackUpgradeStatus) DeepCopy() *RackUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RackUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RebuildOptions) DeepCopyInto(out *RebuildOptions) {
+	*out = *in
+	if in.AlterKeyspaceReplication != nil {
+		in, out := &in.AlterKeyspaceReplication, &out.AlterKeyspaceReplication
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebuildOptions.
+func (in *RebuildOptions) DeepCopy() *RebuildOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RebuildOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RebuildStatus) DeepCopyInto(out *RebuildStatus) {
+	*out = *in
+	if in.RebuildingNode != nil {
+		in, out := &in.RebuildingNode, &out.RebuildingNode
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RebuildStatus.
+func (in *RebuildStatus) DeepCopy() *RebuildStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RebuildStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteKubernetesCluster) DeepCopyInto(out *RemoteKubernetesCluster) {
 	*out = *in
@@ -1391,7 +1577,7 @@ func (in *RemoteKubernetesClusterStatus) DeepCopyInto(out *RemoteKubernetesClust
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1468,6 +1654,98 @@ func (in *RemoteOwnerList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreFromBackupOptions) DeepCopyInto(out *RestoreFromBackupOptions) {
+	*out = *in
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Keyspace != nil {
+		in, out := &in.Keyspace, &out.Keyspace
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreFromBackupOptions.
+func (in *RestoreFromBackupOptions) DeepCopy() *RestoreFromBackupOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreFromBackupOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreFromBackupStatus) DeepCopyInto(out *RestoreFromBackupStatus) {
+	*out = *in
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreFromBackupStatus.
+func (in *RestoreFromBackupStatus) DeepCopy() *RestoreFromBackupStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreFromBackupStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStatus) DeepCopyInto(out *RolloutStatus) {
+	*out = *in
+	if in.UpdatedNodes != nil {
+		in, out := &in.UpdatedNodes, &out.UpdatedNodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CanaryReadyTime != nil {
+		in, out := &in.CanaryReadyTime, &out.CanaryReadyTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStatus.
+func (in *RolloutStatus) DeepCopy() *RolloutStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStrategy) DeepCopyInto(out *RolloutStrategy) {
+	*out = *in
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryRolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategy.
+func (in *RolloutStrategy) DeepCopy() *RolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScyllaDB) DeepCopyInto(out *ScyllaDB) {
 	*out = *in
@@ -1541,6 +1819,16 @@ func (in *ScyllaDBClusterDatacenter) DeepCopyInto(out *ScyllaDBClusterDatacenter
 		*out = new(string)
 		**out = **in
 	}
+	if in.Rebuild != nil {
+		in, out := &in.Rebuild, &out.Rebuild
+		*out = new(RebuildOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RolloutApprovedRevision != nil {
+		in, out := &in.RolloutApprovedRevision, &out.RolloutApprovedRevision
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -1562,6 +1850,11 @@ func (in *ScyllaDBClusterDatacenterStatus) DeepCopyInto(out *ScyllaDBClusterData
 		*out = new(string)
 		**out = **in
 	}
+	if in.RemoteKubernetesClusterName != nil {
+		in, out := &in.RemoteKubernetesClusterName, &out.RemoteKubernetesClusterName
+		*out = new(string)
+		**out = **in
+	}
 	if in.CurrentVersion != nil {
 		in, out := &in.CurrentVersion, &out.CurrentVersion
 		*out = new(string)
@@ -1609,6 +1902,31 @@ func (in *ScyllaDBClusterDatacenterStatus) DeepCopyInto(out *ScyllaDBClusterData
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(UpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeState != nil {
+		in, out := &in.UpgradeState, &out.UpgradeState
+		*out = new(DatacenterUpgradeState)
+		**out = **in
+	}
+	if in.Rebuild != nil {
+		in, out := &in.Rebuild, &out.Rebuild
+		*out = new(RebuildStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Removal != nil {
+		in, out := &in.Removal, &out.Removal
+		*out = new(DatacenterRemovalStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1851,6 +2169,11 @@ func (in *ScyllaDBClusterSpec) DeepCopyInto(out *ScyllaDBClusterSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LostDatacenters != nil {
+		in, out := &in.LostDatacenters, &out.LostDatacenters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.MinTerminationGracePeriodSeconds != nil {
 		in, out := &in.MinTerminationGracePeriodSeconds, &out.MinTerminationGracePeriodSeconds
 		*out = new(int32)
@@ -1863,9 +2186,29 @@ func (in *ScyllaDBClusterSpec) DeepCopyInto(out *ScyllaDBClusterSpec) {
 	}
 	if in.ReadinessGates != nil {
 		in, out := &in.ReadinessGates, &out.ReadinessGates
-		*out = make([]v1.PodReadinessGate, len(*in))
+		*out = make([]corev1.PodReadinessGate, len(*in))
+		copy(*out, *in)
+	}
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
 		copy(*out, *in)
 	}
+	if in.RestoreFromBackup != nil {
+		in, out := &in.RestoreFromBackup, &out.RestoreFromBackup
+		*out = new(RestoreFromBackupOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeStrategy != nil {
+		in, out := &in.UpgradeStrategy, &out.UpgradeStrategy
+		*out = new(ScyllaDBClusterUpgradeStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1889,7 +2232,7 @@ func (in *ScyllaDBClusterStatus) DeepCopyInto(out *ScyllaDBClusterStatus) {
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1936,6 +2279,11 @@ func (in *ScyllaDBClusterStatus) DeepCopyInto(out *ScyllaDBClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RestoreFromBackup != nil {
+		in, out := &in.RestoreFromBackup, &out.RestoreFromBackup
+		*out = new(RestoreFromBackupStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1949,6 +2297,27 @@ func (in *ScyllaDBClusterStatus) DeepCopy() *ScyllaDBClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBClusterUpgradeStrategy) DeepCopyInto(out *ScyllaDBClusterUpgradeStrategy) {
+	*out = *in
+	if in.DatacenterOrder != nil {
+		in, out := &in.DatacenterOrder, &out.DatacenterOrder
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBClusterUpgradeStrategy.
+func (in *ScyllaDBClusterUpgradeStrategy) DeepCopy() *ScyllaDBClusterUpgradeStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBClusterUpgradeStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScyllaDBDatacenter) DeepCopyInto(out *ScyllaDBDatacenter) {
 	*out = *in
@@ -2097,12 +2466,12 @@ func (in *ScyllaDBDatacenterSpec) DeepCopyInto(out *ScyllaDBDatacenterSpec) {
 	}
 	if in.ImagePullSecrets != nil {
 		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
-		*out = make([]v1.LocalObjectReference, len(*in))
+		*out = make([]corev1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
 	if in.DNSPolicy != nil {
 		in, out := &in.DNSPolicy, &out.DNSPolicy
-		*out = new(v1.DNSPolicy)
+		*out = new(corev1.DNSPolicy)
 		**out = **in
 	}
 	if in.DNSDomains != nil {
@@ -2117,12 +2486,12 @@ func (in *ScyllaDBDatacenterSpec) DeepCopyInto(out *ScyllaDBDatacenterSpec) {
 	}
 	if in.IPFamilyPolicy != nil {
 		in, out := &in.IPFamilyPolicy, &out.IPFamilyPolicy
-		*out = new(v1.IPFamilyPolicy)
+		*out = new(corev1.IPFamilyPolicy)
 		**out = **in
 	}
 	if in.IPFamilies != nil {
 		in, out := &in.IPFamilies, &out.IPFamilies
-		*out = make([]v1.IPFamily, len(*in))
+		*out = make([]corev1.IPFamily, len(*in))
 		copy(*out, *in)
 	}
 	if in.ExposeOptions != nil {
@@ -2147,19 +2516,64 @@ func (in *ScyllaDBDatacenterSpec) DeepCopyInto(out *ScyllaDBDatacenterSpec) {
 		*out = new(bool)
 		**out = **in
 	}
-	if in.MinTerminationGracePeriodSeconds != nil {
-		in, out := &in.MinTerminationGracePeriodSeconds, &out.MinTerminationGracePeriodSeconds
-		*out = new(int32)
-		**out = **in
+	if in.StorageMigration != nil {
+		in, out := &in.StorageMigration, &out.StorageMigration
+		*out = new(StorageMigrationOptions)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.MinReadySeconds != nil {
-		in, out := &in.MinReadySeconds, &out.MinReadySeconds
-		*out = new(int32)
-		**out = **in
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ReadinessGates != nil {
-		in, out := &in.ReadinessGates, &out.ReadinessGates
-		*out = make([]v1.PodReadinessGate, len(*in))
+	if in.RestoreFromBackup != nil {
+		in, out := &in.RestoreFromBackup, &out.RestoreFromBackup
+		*out = new(RestoreFromBackupOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rebuild != nil {
+		in, out := &in.Rebuild, &out.Rebuild
+		*out = new(RebuildOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeSnapshots != nil {
+		in, out := &in.UpgradeSnapshots, &out.UpgradeSnapshots
+		*out = new(UpgradeSnapshotsOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeFailurePolicy != nil {
+		in, out := &in.UpgradeFailurePolicy, &out.UpgradeFailurePolicy
+		*out = new(UpgradeFailurePolicy)
+		**out = **in
+	}
+	if in.UpgradePath != nil {
+		in, out := &in.UpgradePath, &out.UpgradePath
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UpgradeSSTables != nil {
+		in, out := &in.UpgradeSSTables, &out.UpgradeSSTables
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaintenanceWindows != nil {
+		in, out := &in.MaintenanceWindows, &out.MaintenanceWindows
+		*out = make([]MaintenanceWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.MinTerminationGracePeriodSeconds != nil {
+		in, out := &in.MinTerminationGracePeriodSeconds, &out.MinTerminationGracePeriodSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinReadySeconds != nil {
+		in, out := &in.MinReadySeconds, &out.MinReadySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReadinessGates != nil {
+		in, out := &in.ReadinessGates, &out.ReadinessGates
+		*out = make([]corev1.PodReadinessGate, len(*in))
 		copy(*out, *in)
 	}
 	return
@@ -2185,7 +2599,7 @@ func (in *ScyllaDBDatacenterStatus) DeepCopyInto(out *ScyllaDBDatacenterStatus)
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2222,6 +2636,33 @@ func (in *ScyllaDBDatacenterStatus) DeepCopyInto(out *ScyllaDBDatacenterStatus)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RestoreFromBackup != nil {
+		in, out := &in.RestoreFromBackup, &out.RestoreFromBackup
+		*out = new(RestoreFromBackupStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rebuild != nil {
+		in, out := &in.Rebuild, &out.Rebuild
+		*out = new(RebuildStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UpgradeSnapshots != nil {
+		in, out := &in.UpgradeSnapshots, &out.UpgradeSnapshots
+		*out = make([]UpgradeSnapshotStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Upgrade != nil {
+		in, out := &in.Upgrade, &out.Upgrade
+		*out = new(UpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2261,7 +2702,7 @@ func (in *ScyllaDBManagerAgentTemplate) DeepCopyInto(out *ScyllaDBManagerAgentTe
 	*out = *in
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.CustomConfigSecretRef != nil {
@@ -2271,14 +2712,14 @@ func (in *ScyllaDBManagerAgentTemplate) DeepCopyInto(out *ScyllaDBManagerAgentTe
 	}
 	if in.Volumes != nil {
 		in, out := &in.Volumes, &out.Volumes
-		*out = make([]v1.Volume, len(*in))
+		*out = make([]corev1.Volume, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	if in.VolumeMounts != nil {
 		in, out := &in.VolumeMounts, &out.VolumeMounts
-		*out = make([]v1.VolumeMount, len(*in))
+		*out = make([]corev1.VolumeMount, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2348,6 +2789,27 @@ func (in *ScyllaDBManagerBackupTaskOptions) DeepCopy() *ScyllaDBManagerBackupTas
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBManagerBackupTaskStatus) DeepCopyInto(out *ScyllaDBManagerBackupTaskStatus) {
+	*out = *in
+	if in.LatestSnapshotTag != nil {
+		in, out := &in.LatestSnapshotTag, &out.LatestSnapshotTag
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBManagerBackupTaskStatus.
+func (in *ScyllaDBManagerBackupTaskStatus) DeepCopy() *ScyllaDBManagerBackupTaskStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBManagerBackupTaskStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScyllaDBManagerClusterRegistration) DeepCopyInto(out *ScyllaDBManagerClusterRegistration) {
 	*out = *in
@@ -2436,7 +2898,7 @@ func (in *ScyllaDBManagerClusterRegistrationStatus) DeepCopyInto(out *ScyllaDBMa
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2459,6 +2921,23 @@ func (in *ScyllaDBManagerClusterRegistrationStatus) DeepCopy() *ScyllaDBManagerC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBManagerHealthcheckTaskOptions) DeepCopyInto(out *ScyllaDBManagerHealthcheckTaskOptions) {
+	*out = *in
+	out.Interval = in.Interval
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBManagerHealthcheckTaskOptions.
+func (in *ScyllaDBManagerHealthcheckTaskOptions) DeepCopy() *ScyllaDBManagerHealthcheckTaskOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBManagerHealthcheckTaskOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScyllaDBManagerRepairTaskOptions) DeepCopyInto(out *ScyllaDBManagerRepairTaskOptions) {
 	*out = *in
@@ -2516,6 +2995,53 @@ func (in *ScyllaDBManagerRepairTaskOptions) DeepCopy() *ScyllaDBManagerRepairTas
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBManagerRestoreTaskOptions) DeepCopyInto(out *ScyllaDBManagerRestoreTaskOptions) {
+	*out = *in
+	in.ScyllaDBManagerTaskSchedule.DeepCopyInto(&out.ScyllaDBManagerTaskSchedule)
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Keyspace != nil {
+		in, out := &in.Keyspace, &out.Keyspace
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RestoreSchema != nil {
+		in, out := &in.RestoreSchema, &out.RestoreSchema
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RestoreTables != nil {
+		in, out := &in.RestoreTables, &out.RestoreTables
+		*out = new(bool)
+		**out = **in
+	}
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Parallel != nil {
+		in, out := &in.Parallel, &out.Parallel
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBManagerRestoreTaskOptions.
+func (in *ScyllaDBManagerRestoreTaskOptions) DeepCopy() *ScyllaDBManagerRestoreTaskOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBManagerRestoreTaskOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScyllaDBManagerTask) DeepCopyInto(out *ScyllaDBManagerTask) {
 	*out = *in
@@ -2577,6 +3103,82 @@ func (in *ScyllaDBManagerTaskList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBManagerTaskOnDemandRunStatus) DeepCopyInto(out *ScyllaDBManagerTaskOnDemandRunStatus) {
+	*out = *in
+	in.TriggerTime.DeepCopyInto(&out.TriggerTime)
+	if in.PreviousRunID != nil {
+		in, out := &in.PreviousRunID, &out.PreviousRunID
+		*out = new(string)
+		**out = **in
+	}
+	if in.RunID != nil {
+		in, out := &in.RunID, &out.RunID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBManagerTaskOnDemandRunStatus.
+func (in *ScyllaDBManagerTaskOnDemandRunStatus) DeepCopy() *ScyllaDBManagerTaskOnDemandRunStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBManagerTaskOnDemandRunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBManagerTaskRunStatus) DeepCopyInto(out *ScyllaDBManagerTaskRunStatus) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.Stage != nil {
+		in, out := &in.Stage, &out.Stage
+		*out = new(string)
+		**out = **in
+	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Cause != nil {
+		in, out := &in.Cause, &out.Cause
+		*out = new(string)
+		**out = **in
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBManagerTaskRunStatus.
+func (in *ScyllaDBManagerTaskRunStatus) DeepCopy() *ScyllaDBManagerTaskRunStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBManagerTaskRunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScyllaDBManagerTaskSchedule) DeepCopyInto(out *ScyllaDBManagerTaskSchedule) {
 	*out = *in
@@ -2585,6 +3187,11 @@ func (in *ScyllaDBManagerTaskSchedule) DeepCopyInto(out *ScyllaDBManagerTaskSche
 		*out = new(string)
 		**out = **in
 	}
+	if in.Timezone != nil {
+		in, out := &in.Timezone, &out.Timezone
+		*out = new(string)
+		**out = **in
+	}
 	if in.NumRetries != nil {
 		in, out := &in.NumRetries, &out.NumRetries
 		*out = new(int64)
@@ -2592,7 +3199,7 @@ func (in *ScyllaDBManagerTaskSchedule) DeepCopyInto(out *ScyllaDBManagerTaskSche
 	}
 	if in.RetryWait != nil {
 		in, out := &in.RetryWait, &out.RetryWait
-		*out = new(metav1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.StartDate != nil {
@@ -2626,6 +3233,21 @@ func (in *ScyllaDBManagerTaskSpec) DeepCopyInto(out *ScyllaDBManagerTaskSpec) {
 		*out = new(ScyllaDBManagerRepairTaskOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Restore != nil {
+		in, out := &in.Restore, &out.Restore
+		*out = new(ScyllaDBManagerRestoreTaskOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ValidateBackup != nil {
+		in, out := &in.ValidateBackup, &out.ValidateBackup
+		*out = new(ScyllaDBManagerValidateBackupTaskOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Healthcheck != nil {
+		in, out := &in.Healthcheck, &out.Healthcheck
+		*out = new(ScyllaDBManagerHealthcheckTaskOptions)
+		**out = **in
+	}
 	return
 }
 
@@ -2649,7 +3271,7 @@ func (in *ScyllaDBManagerTaskStatus) DeepCopyInto(out *ScyllaDBManagerTaskStatus
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2659,6 +3281,30 @@ func (in *ScyllaDBManagerTaskStatus) DeepCopyInto(out *ScyllaDBManagerTaskStatus
 		*out = new(string)
 		**out = **in
 	}
+	if in.NextActivation != nil {
+		in, out := &in.NextActivation, &out.NextActivation
+		*out = (*in).DeepCopy()
+	}
+	if in.NextActivationInTimezone != nil {
+		in, out := &in.NextActivationInTimezone, &out.NextActivationInTimezone
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastRun != nil {
+		in, out := &in.LastRun, &out.LastRun
+		*out = new(ScyllaDBManagerTaskRunStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(ScyllaDBManagerBackupTaskStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OnDemandRun != nil {
+		in, out := &in.OnDemandRun, &out.OnDemandRun
+		*out = new(ScyllaDBManagerTaskOnDemandRunStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2672,6 +3318,38 @@ func (in *ScyllaDBManagerTaskStatus) DeepCopy() *ScyllaDBManagerTaskStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBManagerValidateBackupTaskOptions) DeepCopyInto(out *ScyllaDBManagerValidateBackupTaskOptions) {
+	*out = *in
+	in.ScyllaDBManagerTaskSchedule.DeepCopyInto(&out.ScyllaDBManagerTaskSchedule)
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DeleteOrphanedFiles != nil {
+		in, out := &in.DeleteOrphanedFiles, &out.DeleteOrphanedFiles
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Parallel != nil {
+		in, out := &in.Parallel, &out.Parallel
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBManagerValidateBackupTaskOptions.
+func (in *ScyllaDBManagerValidateBackupTaskOptions) DeepCopy() *ScyllaDBManagerValidateBackupTaskOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBManagerValidateBackupTaskOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScyllaDBMonitoring) DeepCopyInto(out *ScyllaDBMonitoring) {
 	*out = *in
@@ -2770,7 +3448,7 @@ func (in *ScyllaDBMonitoringStatus) DeepCopyInto(out *ScyllaDBMonitoringStatus)
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -2789,52 +3467,7 @@ func (in *ScyllaDBMonitoringStatus) DeepCopy() *ScyllaDBMonitoringStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScyllaDBTemplate) DeepCopyInto(out *ScyllaDBTemplate) {
-	*out = *in
-	if in.Resources != nil {
-		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Storage != nil {
-		in, out := &in.Storage, &out.Storage
-		*out = new(StorageOptions)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.CustomConfigMapRef != nil {
-		in, out := &in.CustomConfigMapRef, &out.CustomConfigMapRef
-		*out = new(string)
-		**out = **in
-	}
-	if in.Volumes != nil {
-		in, out := &in.Volumes, &out.Volumes
-		*out = make([]v1.Volume, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.VolumeMounts != nil {
-		in, out := &in.VolumeMounts, &out.VolumeMounts
-		*out = make([]v1.VolumeMount, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBTemplate.
-func (in *ScyllaDBTemplate) DeepCopy() *ScyllaDBTemplate {
-	if in == nil {
-		return nil
-	}
-	out := new(ScyllaDBTemplate)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScyllaOperatorConfig) DeepCopyInto(out *ScyllaOperatorConfig) {
+func (in *ScyllaDBSnapshot) DeepCopyInto(out *ScyllaDBSnapshot) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -2843,18 +3476,18 @@ func (in *ScyllaOperatorConfig) DeepCopyInto(out *ScyllaOperatorConfig) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaOperatorConfig.
-func (in *ScyllaOperatorConfig) DeepCopy() *ScyllaOperatorConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBSnapshot.
+func (in *ScyllaDBSnapshot) DeepCopy() *ScyllaDBSnapshot {
 	if in == nil {
 		return nil
 	}
-	out := new(ScyllaOperatorConfig)
+	out := new(ScyllaDBSnapshot)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ScyllaOperatorConfig) DeepCopyObject() runtime.Object {
+func (in *ScyllaDBSnapshot) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -2862,15 +3495,392 @@ func (in *ScyllaOperatorConfig) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ScyllaOperatorConfigList) DeepCopyInto(out *ScyllaOperatorConfigList) {
+func (in *ScyllaDBSnapshotKeyspace) DeepCopyInto(out *ScyllaDBSnapshotKeyspace) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]ScyllaOperatorConfig, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
+	if in.Tables != nil {
+		in, out := &in.Tables, &out.Tables
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBSnapshotKeyspace.
+func (in *ScyllaDBSnapshotKeyspace) DeepCopy() *ScyllaDBSnapshotKeyspace {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBSnapshotKeyspace)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBSnapshotList) DeepCopyInto(out *ScyllaDBSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScyllaDBSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBSnapshotList.
+func (in *ScyllaDBSnapshotList) DeepCopy() *ScyllaDBSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScyllaDBSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBSnapshotNodeStatus) DeepCopyInto(out *ScyllaDBSnapshotNodeStatus) {
+	*out = *in
+	if in.SnapshotTime != nil {
+		in, out := &in.SnapshotTime, &out.SnapshotTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Error != nil {
+		in, out := &in.Error, &out.Error
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBSnapshotNodeStatus.
+func (in *ScyllaDBSnapshotNodeStatus) DeepCopy() *ScyllaDBSnapshotNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBSnapshotNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBSnapshotSpec) DeepCopyInto(out *ScyllaDBSnapshotSpec) {
+	*out = *in
+	out.ScyllaDBDatacenterRef = in.ScyllaDBDatacenterRef
+	if in.Keyspaces != nil {
+		in, out := &in.Keyspaces, &out.Keyspaces
+		*out = make([]ScyllaDBSnapshotKeyspace, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBSnapshotSpec.
+func (in *ScyllaDBSnapshotSpec) DeepCopy() *ScyllaDBSnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBSnapshotStatus) DeepCopyInto(out *ScyllaDBSnapshotStatus) {
+	*out = *in
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SnapshotTag != nil {
+		in, out := &in.SnapshotTag, &out.SnapshotTag
+		*out = new(string)
+		**out = **in
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]ScyllaDBSnapshotNodeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpirationTime != nil {
+		in, out := &in.ExpirationTime, &out.ExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBSnapshotStatus.
+func (in *ScyllaDBSnapshotStatus) DeepCopy() *ScyllaDBSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBTemplate) DeepCopyInto(out *ScyllaDBTemplate) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(StorageOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomConfigMapRef != nil {
+		in, out := &in.CustomConfigMapRef, &out.CustomConfigMapRef
+		*out = new(string)
+		**out = **in
+	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]corev1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]corev1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBTemplate.
+func (in *ScyllaDBTemplate) DeepCopy() *ScyllaDBTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBVolumeSnapshot) DeepCopyInto(out *ScyllaDBVolumeSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBVolumeSnapshot.
+func (in *ScyllaDBVolumeSnapshot) DeepCopy() *ScyllaDBVolumeSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBVolumeSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScyllaDBVolumeSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBVolumeSnapshotList) DeepCopyInto(out *ScyllaDBVolumeSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScyllaDBVolumeSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBVolumeSnapshotList.
+func (in *ScyllaDBVolumeSnapshotList) DeepCopy() *ScyllaDBVolumeSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBVolumeSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScyllaDBVolumeSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBVolumeSnapshotSpec) DeepCopyInto(out *ScyllaDBVolumeSnapshotSpec) {
+	*out = *in
+	out.ScyllaDBDatacenterRef = in.ScyllaDBDatacenterRef
+	if in.VolumeSnapshotClassName != nil {
+		in, out := &in.VolumeSnapshotClassName, &out.VolumeSnapshotClassName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBVolumeSnapshotSpec.
+func (in *ScyllaDBVolumeSnapshotSpec) DeepCopy() *ScyllaDBVolumeSnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBVolumeSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBVolumeSnapshotStatus) DeepCopyInto(out *ScyllaDBVolumeSnapshotStatus) {
+	*out = *in
+	if in.ObservedGeneration != nil {
+		in, out := &in.ObservedGeneration, &out.ObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SnapshotTag != nil {
+		in, out := &in.SnapshotTag, &out.SnapshotTag
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeSnapshots != nil {
+		in, out := &in.VolumeSnapshots, &out.VolumeSnapshots
+		*out = make([]ScyllaDBVolumeSnapshotVolumeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBVolumeSnapshotStatus.
+func (in *ScyllaDBVolumeSnapshotStatus) DeepCopy() *ScyllaDBVolumeSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBVolumeSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaDBVolumeSnapshotVolumeStatus) DeepCopyInto(out *ScyllaDBVolumeSnapshotVolumeStatus) {
+	*out = *in
+	if in.ReadyToUse != nil {
+		in, out := &in.ReadyToUse, &out.ReadyToUse
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaDBVolumeSnapshotVolumeStatus.
+func (in *ScyllaDBVolumeSnapshotVolumeStatus) DeepCopy() *ScyllaDBVolumeSnapshotVolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaDBVolumeSnapshotVolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaOperatorConfig) DeepCopyInto(out *ScyllaOperatorConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScyllaOperatorConfig.
+func (in *ScyllaOperatorConfig) DeepCopy() *ScyllaOperatorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScyllaOperatorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScyllaOperatorConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScyllaOperatorConfigList) DeepCopyInto(out *ScyllaOperatorConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ScyllaOperatorConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 	return
@@ -2940,7 +3950,7 @@ func (in *ScyllaOperatorConfigStatus) DeepCopyInto(out *ScyllaOperatorConfigStat
 	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -3014,6 +4024,49 @@ func (in *Storage) DeepCopy() *Storage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageDataSource) DeepCopyInto(out *StorageDataSource) {
+	*out = *in
+	out.ScyllaDBVolumeSnapshotRef = in.ScyllaDBVolumeSnapshotRef
+	if in.RackName != nil {
+		in, out := &in.RackName, &out.RackName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageDataSource.
+func (in *StorageDataSource) DeepCopy() *StorageDataSource {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageDataSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageMigrationOptions) DeepCopyInto(out *StorageMigrationOptions) {
+	*out = *in
+	if in.Paused != nil {
+		in, out := &in.Paused, &out.Paused
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageMigrationOptions.
+func (in *StorageMigrationOptions) DeepCopy() *StorageMigrationOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageMigrationOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageOptions) DeepCopyInto(out *StorageOptions) {
 	*out = *in
@@ -3027,6 +4080,11 @@ func (in *StorageOptions) DeepCopyInto(out *StorageOptions) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.DataSource != nil {
+		in, out := &in.DataSource, &out.DataSource
+		*out = new(StorageDataSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3066,6 +4124,125 @@ func (in *TLSCertificate) DeepCopy() *TLSCertificate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeFailurePolicy) DeepCopyInto(out *UpgradeFailurePolicy) {
+	*out = *in
+	out.NodeReadinessTimeout = in.NodeReadinessTimeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeFailurePolicy.
+func (in *UpgradeFailurePolicy) DeepCopy() *UpgradeFailurePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeFailurePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeSnapshotStatus) DeepCopyInto(out *UpgradeSnapshotStatus) {
+	*out = *in
+	if in.DataSnapshotTag != nil {
+		in, out := &in.DataSnapshotTag, &out.DataSnapshotTag
+		*out = new(string)
+		**out = **in
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExpirationTime != nil {
+		in, out := &in.ExpirationTime, &out.ExpirationTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeSnapshotStatus.
+func (in *UpgradeSnapshotStatus) DeepCopy() *UpgradeSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeSnapshotsOptions) DeepCopyInto(out *UpgradeSnapshotsOptions) {
+	*out = *in
+	if in.DataSnapshots != nil {
+		in, out := &in.DataSnapshots, &out.DataSnapshots
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RetainedUpgrades != nil {
+		in, out := &in.RetainedUpgrades, &out.RetainedUpgrades
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeSnapshotsOptions.
+func (in *UpgradeSnapshotsOptions) DeepCopy() *UpgradeSnapshotsOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeSnapshotsOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpgradeStatus) DeepCopyInto(out *UpgradeStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.SystemSnapshotTag != nil {
+		in, out := &in.SystemSnapshotTag, &out.SystemSnapshotTag
+		*out = new(string)
+		**out = **in
+	}
+	if in.DataSnapshotTag != nil {
+		in, out := &in.DataSnapshotTag, &out.DataSnapshotTag
+		*out = new(string)
+		**out = **in
+	}
+	if in.UpgradingNode != nil {
+		in, out := &in.UpgradingNode, &out.UpgradingNode
+		*out = new(string)
+		**out = **in
+	}
+	if in.Racks != nil {
+		in, out := &in.Racks, &out.Racks
+		*out = make([]RackUpgradeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpgradeStatus.
+func (in *UpgradeStatus) DeepCopy() *UpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(UpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UserManagedTLSCertificateOptions) DeepCopyInto(out *UserManagedTLSCertificateOptions) {
 	*out = *in
@@ -4,6 +4,8 @@ package validation
 
 import (
 	"fmt"
+	"reflect"
+	"slices"
 	"sort"
 	"strings"
 
@@ -46,8 +48,14 @@ func ValidateScyllaDBClusterSpec(spec *scyllav1alpha1.ScyllaDBClusterSpec, fldPa
 
 	for i, dcSpec := range spec.Datacenters {
 		allErrs = append(allErrs, ValidateScyllaDBClusterDatacenter(dcSpec, fldPath.Child("datacenters").Index(i))...)
+
+		if dcSpec.Rebuild != nil {
+			allErrs = append(allErrs, ValidateScyllaDBClusterDatacenterRebuildOptions(dcSpec.Rebuild, dcSpec.Name, spec.Datacenters, fldPath.Child("datacenters").Index(i).Child("rebuild"))...)
+		}
 	}
 
+	allErrs = append(allErrs, ValidateScyllaDBClusterLostDatacenters(spec.LostDatacenters, spec.Datacenters, fldPath.Child("lostDatacenters"))...)
+
 	if spec.ExposeOptions != nil {
 		allErrs = append(allErrs, ValidateScyllaDBClusterSpecExposeOptions(spec.ExposeOptions, fldPath.Child("exposeOptions"))...)
 	}
@@ -66,6 +74,90 @@ func ValidateScyllaDBClusterSpec(spec *scyllav1alpha1.ScyllaDBClusterSpec, fldPa
 		}
 	}
 
+	if spec.RolloutStrategy != nil {
+		allErrs = append(allErrs, ValidateRolloutStrategy(spec.RolloutStrategy, fldPath.Child("rolloutStrategy"))...)
+
+		if spec.RolloutStrategy.Canary != nil && spec.RolloutStrategy.Canary.ApprovedRevision != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("rolloutStrategy", "canary", "approvedRevision"), "rollouts are approved for each datacenter using rolloutApprovedRevision"))
+		}
+	}
+
+	allErrs = append(allErrs, ValidateMaintenanceWindows(spec.MaintenanceWindows, fldPath.Child("maintenanceWindows"))...)
+
+	if spec.RestoreFromBackup != nil {
+		allErrs = append(allErrs, ValidateRestoreFromBackupOptions(spec.RestoreFromBackup, fldPath.Child("restoreFromBackup"))...)
+	}
+
+	if spec.UpgradeStrategy != nil {
+		allErrs = append(allErrs, ValidateScyllaDBClusterUpgradeStrategy(spec.UpgradeStrategy, spec.Datacenters, fldPath.Child("upgradeStrategy"))...)
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBClusterLostDatacenters(lostDatacenters []string, datacenters []scyllav1alpha1.ScyllaDBClusterDatacenter, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	seen := apimachineryutilsets.New[string]()
+	for i, dcName := range lostDatacenters {
+		if len(dcName) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Index(i), ""))
+			continue
+		}
+
+		if seen.Has(dcName) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), dcName))
+			continue
+		}
+		seen.Insert(dcName)
+
+		if slices.ContainsFunc(datacenters, func(dc scyllav1alpha1.ScyllaDBClusterDatacenter) bool {
+			return dc.Name == dcName
+		}) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), dcName, "lost datacenter must be removed from datacenters"))
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBClusterUpgradeStrategy(strategy *scyllav1alpha1.ScyllaDBClusterUpgradeStrategy, datacenters []scyllav1alpha1.ScyllaDBClusterDatacenter, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	seen := apimachineryutilsets.New[string]()
+	for i, dcName := range strategy.DatacenterOrder {
+		if seen.Has(dcName) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("datacenterOrder").Index(i), dcName))
+			continue
+		}
+		seen.Insert(dcName)
+
+		if !slices.ContainsFunc(datacenters, func(dc scyllav1alpha1.ScyllaDBClusterDatacenter) bool {
+			return dc.Name == dcName
+		}) {
+			allErrs = append(allErrs, field.NotFound(fldPath.Child("datacenterOrder").Index(i), dcName))
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBClusterDatacenterRebuildOptions(options *scyllav1alpha1.RebuildOptions, dcName string, datacenters []scyllav1alpha1.ScyllaDBClusterDatacenter, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, ValidateRebuildOptions(options, fldPath)...)
+	if len(options.SourceDatacenter) == 0 {
+		return allErrs
+	}
+
+	if options.SourceDatacenter == dcName {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("sourceDatacenter"), options.SourceDatacenter, "must be a different datacenter"))
+	} else if !slices.ContainsFunc(datacenters, func(dc scyllav1alpha1.ScyllaDBClusterDatacenter) bool {
+		return dc.Name == options.SourceDatacenter
+	}) {
+		allErrs = append(allErrs, field.NotFound(fldPath.Child("sourceDatacenter"), options.SourceDatacenter))
+	}
+
 	return allErrs
 }
 
@@ -227,10 +319,6 @@ func ValidateScyllaDBClusterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBCluster,
 	removedDatacenterNames := apimachineryutilsets.New(oldDatacenterNames...).Difference(apimachineryutilsets.New(newDatacenterNames...)).UnsortedList()
 	sort.Strings(removedDatacenterNames)
 
-	isDatacenterStatusUpToDate := func(sc *scyllav1alpha1.ScyllaDBCluster, dcStatus scyllav1alpha1.ScyllaDBClusterDatacenterStatus) bool {
-		return sc.Status.ObservedGeneration != nil && *sc.Status.ObservedGeneration >= sc.Generation && dcStatus.Stale != nil && !*dcStatus.Stale
-	}
-
 	for _, removedDCName := range removedDatacenterNames {
 		for i, oldDC := range old.Spec.Datacenters {
 			if oldDC.Name != removedDCName {
@@ -245,14 +333,49 @@ func ValidateScyllaDBClusterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBCluster,
 				continue
 			}
 
-			if oldDCStatus.Nodes != nil && *oldDCStatus.Nodes != 0 {
-				allErrs = append(allErrs, field.Forbidden(fldPath.Child("datacenters").Index(i), fmt.Sprintf("datacenter %q can't be removed because its nodes are being scaled down", removedDCName)))
-				continue
+			// The remote Kubernetes cluster recorded in status is used to remove the datacenter once it's gone from the spec.
+			if oldDCStatus.RemoteKubernetesClusterName == nil || *oldDCStatus.RemoteKubernetesClusterName != oldDC.RemoteKubernetesClusterName {
+				allErrs = append(allErrs, field.InternalError(fldPath.Child("datacenters").Index(i), fmt.Errorf("datacenter %q can't be removed because its status doesn't yet reflect its remote Kubernetes cluster; please retry later", removedDCName)))
 			}
+		}
+	}
 
-			if !isDatacenterStatusUpToDate(old, oldDCStatus) {
-				allErrs = append(allErrs, field.InternalError(fldPath.Child("datacenters").Index(i), fmt.Errorf("datacenter %q can't be removed because its status, that's used to determine node count, is not yet up to date with the generation of this resource; please retry later", removedDCName)))
-			}
+	for i, newDC := range new.Spec.Datacenters {
+		_, _, isBeingRemoved := oslices.Find(old.Status.Datacenters, func(dcStatus scyllav1alpha1.ScyllaDBClusterDatacenterStatus) bool {
+			return dcStatus.Name == newDC.Name && dcStatus.Removal != nil
+		})
+		if isBeingRemoved {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("datacenters").Index(i), fmt.Sprintf("datacenter %q can't be added because it's still being removed", newDC.Name)))
+		}
+	}
+
+	// Only datacenters of the cluster can be declared lost, and they have to stay declared lost until their removal completes.
+	for i, dcName := range new.Spec.LostDatacenters {
+		if slices.Contains(old.Spec.LostDatacenters, dcName) {
+			continue
+		}
+
+		isOldDatacenter := slices.ContainsFunc(old.Spec.Datacenters, func(dc scyllav1alpha1.ScyllaDBClusterDatacenter) bool {
+			return dc.Name == dcName
+		})
+		_, _, isBeingRemoved := oslices.Find(old.Status.Datacenters, func(dcStatus scyllav1alpha1.ScyllaDBClusterDatacenterStatus) bool {
+			return dcStatus.Name == dcName && dcStatus.Removal != nil
+		})
+		if !isOldDatacenter && !isBeingRemoved {
+			allErrs = append(allErrs, field.NotFound(fldPath.Child("lostDatacenters").Index(i), dcName))
+		}
+	}
+
+	for _, dcName := range old.Spec.LostDatacenters {
+		if slices.Contains(new.Spec.LostDatacenters, dcName) {
+			continue
+		}
+
+		_, _, isBeingRemoved := oslices.Find(old.Status.Datacenters, func(dcStatus scyllav1alpha1.ScyllaDBClusterDatacenterStatus) bool {
+			return dcStatus.Name == dcName && dcStatus.Removal != nil
+		})
+		if isBeingRemoved {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("lostDatacenters"), fmt.Sprintf("datacenter %q can't be taken off lost datacenters because it's still being removed", dcName)))
 		}
 	}
 
@@ -303,6 +426,11 @@ func ValidateScyllaDBClusterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBCluster,
 	oldRacks := collectRacks(old)
 
 	removedRacks := oslices.Filter(oldRacks, func(odr dcRackProperties) bool {
+		// Racks of removed datacenters are removed together with the datacenter.
+		if slices.Contains(removedDatacenterNames, odr.datacenter) {
+			return false
+		}
+
 		_, _, ok := oslices.Find(newRacks, func(ndr dcRackProperties) bool {
 			return ndr.rack == odr.rack && ndr.datacenter == odr.datacenter
 		})
@@ -420,6 +548,20 @@ func ValidateScyllaDBClusterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBCluster,
 	}
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newNodeServiceType, oldNodeServiceType, fldPath.Child("exposeOptions", "nodeService", "type"))...)
 
+	if !reflect.DeepEqual(new.Spec.RestoreFromBackup, old.Spec.RestoreFromBackup) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("restoreFromBackup"), "restoreFromBackup can't be changed once the ScyllaDBCluster is created"))
+	}
+
+	// Rebuild can only be requested for datacenters being added, and it can be unset once it's no longer needed.
+	for i, newDC := range new.Spec.Datacenters {
+		oldDC, _, ok := oslices.Find(old.Spec.Datacenters, func(oldDC scyllav1alpha1.ScyllaDBClusterDatacenter) bool {
+			return oldDC.Name == newDC.Name
+		})
+		if ok && newDC.Rebuild != nil && !reflect.DeepEqual(newDC.Rebuild, oldDC.Rebuild) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("datacenters").Index(i).Child("rebuild"), "rebuild can't be set or changed once the datacenter is added"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -2509,6 +2509,135 @@ func TestValidateScyllaDBCluster(t *testing.T) {
 			},
 			expectedErrorString: "spec.datacenterTemplate.placement.tolerations[0].effect: Invalid value: \"NoSchedule\": effect must be 'NoExecute' when `tolerationSeconds` is set",
 		},
+		{
+			name: "valid upgrade strategy",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.UpgradeStrategy = &scyllav1alpha1.ScyllaDBClusterUpgradeStrategy{
+					DatacenterOrder: []string{"dc"},
+				}
+				return sc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "upgrade strategy with duplicate and unknown datacenters",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.UpgradeStrategy = &scyllav1alpha1.ScyllaDBClusterUpgradeStrategy{
+					DatacenterOrder: []string{"dc", "dc", "other"},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.upgradeStrategy.datacenterOrder[1]", BadValue: "dc"},
+				&field.Error{Type: field.ErrorTypeNotFound, Field: "spec.upgradeStrategy.datacenterOrder[2]", BadValue: "other"},
+			},
+			expectedErrorString: `[spec.upgradeStrategy.datacenterOrder[1]: Duplicate value: "dc", spec.upgradeStrategy.datacenterOrder[2]: Not found: "other"]`,
+		},
+		{
+			name: "lost datacenters with empty, duplicate and present datacenters",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.LostDatacenters = []string{"", "dc2", "dc2", "dc"}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.lostDatacenters[0]", BadValue: ""},
+				&field.Error{Type: field.ErrorTypeDuplicate, Field: "spec.lostDatacenters[2]", BadValue: "dc2"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.lostDatacenters[3]", BadValue: "dc", Detail: "lost datacenter must be removed from datacenters"},
+			},
+			expectedErrorString: `[spec.lostDatacenters[0]: Required value, spec.lostDatacenters[2]: Duplicate value: "dc2", spec.lostDatacenters[3]: Invalid value: "dc": lost datacenter must be removed from datacenters]`,
+		},
+		{
+			name: "valid rebuild of a datacenter",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				dc2 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc2.Name = "dc2"
+				dc2.Rebuild = &scyllav1alpha1.RebuildOptions{
+					SourceDatacenter:         "dc",
+					AlterKeyspaceReplication: pointer.Ptr(true),
+				}
+				sc.Spec.Datacenters = append(sc.Spec.Datacenters, dc2)
+				return sc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "rebuild with empty source datacenter",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.Datacenters[0].Rebuild = &scyllav1alpha1.RebuildOptions{}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.datacenters[0].rebuild.sourceDatacenter", BadValue: ""},
+			},
+			expectedErrorString: `spec.datacenters[0].rebuild.sourceDatacenter: Required value`,
+		},
+		{
+			name: "rebuild from the same datacenter",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.Datacenters[0].Rebuild = &scyllav1alpha1.RebuildOptions{
+					SourceDatacenter: "dc",
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.datacenters[0].rebuild.sourceDatacenter", BadValue: "dc", Detail: "must be a different datacenter"},
+			},
+			expectedErrorString: `spec.datacenters[0].rebuild.sourceDatacenter: Invalid value: "dc": must be a different datacenter`,
+		},
+		{
+			name: "rebuild from unknown datacenter",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.Datacenters[0].Rebuild = &scyllav1alpha1.RebuildOptions{
+					SourceDatacenter: "other",
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeNotFound, Field: "spec.datacenters[0].rebuild.sourceDatacenter", BadValue: "other"},
+			},
+			expectedErrorString: `spec.datacenters[0].rebuild.sourceDatacenter: Not found: "other"`,
+		},
+		{
+			name: "canary rollout approved for the whole cluster",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.RolloutStrategy = &scyllav1alpha1.RolloutStrategy{
+					Canary: &scyllav1alpha1.CanaryRolloutStrategy{
+						Nodes:            1,
+						ApprovedRevision: pointer.Ptr("abc"),
+					},
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.rolloutStrategy.canary.approvedRevision", BadValue: "", Detail: "rollouts are approved for each datacenter using rolloutApprovedRevision"},
+			},
+			expectedErrorString: `spec.rolloutStrategy.canary.approvedRevision: Forbidden: rollouts are approved for each datacenter using rolloutApprovedRevision`,
+		},
+		{
+			name: "canary rollout approved for a datacenter",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.RolloutStrategy = &scyllav1alpha1.RolloutStrategy{
+					Canary: &scyllav1alpha1.CanaryRolloutStrategy{
+						Nodes: 1,
+					},
+				}
+				sc.Spec.Datacenters[0].RolloutApprovedRevision = pointer.Ptr("abc")
+				return sc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
 	}
 
 	for _, test := range tests {
@@ -2590,6 +2719,29 @@ func TestValidateScyllaDBClusterUpdate(t *testing.T) {
 			expectedErrorList:   nil,
 			expectedErrorString: "",
 		},
+		{
+			name: "restoreFromBackup changed",
+			old: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.RestoreFromBackup = &scyllav1alpha1.RestoreFromBackupOptions{
+					Location:    []string{"gcs:backups"},
+					SnapshotTag: "sm_20250101000000UTC",
+				}
+				return sc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.RestoreFromBackup = &scyllav1alpha1.RestoreFromBackupOptions{
+					Location:    []string{"gcs:backups"},
+					SnapshotTag: "sm_20250202000000UTC",
+				}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.restoreFromBackup", BadValue: "", Detail: "restoreFromBackup can't be changed once the ScyllaDBCluster is created"},
+			},
+			expectedErrorString: "spec.restoreFromBackup: Forbidden: restoreFromBackup can't be changed once the ScyllaDBCluster is created",
+		},
 		{
 			name: "cluster name changed",
 			old:  newValidScyllaDBCluster(),
@@ -2603,6 +2755,299 @@ func TestValidateScyllaDBClusterUpdate(t *testing.T) {
 			},
 			expectedErrorString: `spec.clusterName: Invalid value: "foo": field is immutable`,
 		},
+		{
+			name: "datacenter removed",
+			old: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				dc2 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc2.Name = "dc2"
+				dc2.RemoteKubernetesClusterName = "rkc2"
+				sc.Spec.Datacenters = append(sc.Spec.Datacenters, dc2)
+				sc.Status = scyllav1alpha1.ScyllaDBClusterStatus{
+					ObservedGeneration: pointer.Ptr(sc.Generation),
+					Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+						{
+							Name:                        "dc",
+							RemoteKubernetesClusterName: pointer.Ptr("rkc"),
+							Nodes:                       pointer.Ptr[int32](1),
+						},
+						{
+							Name:                        "dc2",
+							RemoteKubernetesClusterName: pointer.Ptr("rkc2"),
+							Nodes:                       pointer.Ptr[int32](1),
+						},
+					},
+				}
+				return sc
+			}(),
+			new:                 newValidScyllaDBCluster(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "datacenter removed and declared lost",
+			old: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				dc2 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc2.Name = "dc2"
+				dc2.RemoteKubernetesClusterName = "rkc2"
+				sc.Spec.Datacenters = append(sc.Spec.Datacenters, dc2)
+				sc.Status = scyllav1alpha1.ScyllaDBClusterStatus{
+					ObservedGeneration: pointer.Ptr(sc.Generation),
+					Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+						{
+							Name:                        "dc",
+							RemoteKubernetesClusterName: pointer.Ptr("rkc"),
+						},
+						{
+							Name:                        "dc2",
+							RemoteKubernetesClusterName: pointer.Ptr("rkc2"),
+						},
+					},
+				}
+				return sc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.LostDatacenters = []string{"dc2"}
+				return sc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "datacenter being removed declared lost",
+			old: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Status = scyllav1alpha1.ScyllaDBClusterStatus{
+					ObservedGeneration: pointer.Ptr(sc.Generation),
+					Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+						{
+							Name:                        "dc",
+							RemoteKubernetesClusterName: pointer.Ptr("rkc"),
+						},
+						{
+							Name:                        "dc2",
+							RemoteKubernetesClusterName: pointer.Ptr("rkc2"),
+							Removal: &scyllav1alpha1.DatacenterRemovalStatus{
+								Method: scyllav1alpha1.DecommissionDatacenterRemovalMethod,
+							},
+						},
+					},
+				}
+				return sc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.LostDatacenters = []string{"dc2"}
+				return sc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "unknown datacenter declared lost",
+			old:  newValidScyllaDBCluster(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.LostDatacenters = []string{"dc2"}
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeNotFound, Field: "spec.lostDatacenters[0]", BadValue: "dc2"},
+			},
+			expectedErrorString: `spec.lostDatacenters[0]: Not found: "dc2"`,
+		},
+		{
+			name: "lost datacenter taken off lost datacenters while it's still being removed",
+			old: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.LostDatacenters = []string{"dc2"}
+				sc.Status = scyllav1alpha1.ScyllaDBClusterStatus{
+					ObservedGeneration: pointer.Ptr(sc.Generation),
+					Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+						{
+							Name:                        "dc",
+							RemoteKubernetesClusterName: pointer.Ptr("rkc"),
+						},
+						{
+							Name:                        "dc2",
+							RemoteKubernetesClusterName: pointer.Ptr("rkc2"),
+							Removal: &scyllav1alpha1.DatacenterRemovalStatus{
+								Method:  scyllav1alpha1.RemoveNodeDatacenterRemovalMethod,
+								HostIDs: []string{"host-id"},
+							},
+						},
+					},
+				}
+				return sc
+			}(),
+			new: newValidScyllaDBCluster(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.lostDatacenters", BadValue: "", Detail: `datacenter "dc2" can't be taken off lost datacenters because it's still being removed`},
+			},
+			expectedErrorString: `spec.lostDatacenters: Forbidden: datacenter "dc2" can't be taken off lost datacenters because it's still being removed`,
+		},
+		{
+			name: "lost datacenter added again once it's removed",
+			old: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Spec.LostDatacenters = []string{"dc2"}
+				sc.Status = scyllav1alpha1.ScyllaDBClusterStatus{
+					ObservedGeneration: pointer.Ptr(sc.Generation),
+					Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+						{
+							Name:                        "dc",
+							RemoteKubernetesClusterName: pointer.Ptr("rkc"),
+						},
+					},
+				}
+				return sc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				dc2 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc2.Name = "dc2"
+				dc2.RemoteKubernetesClusterName = "rkc3"
+				dc2.Racks[0].ScyllaDB = nil
+				sc.Spec.Datacenters = append(sc.Spec.Datacenters, dc2)
+				return sc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "datacenter removed before its status reflects the remote Kubernetes cluster",
+			old: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				dc2 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc2.Name = "dc2"
+				dc2.RemoteKubernetesClusterName = "rkc2"
+				sc.Spec.Datacenters = append(sc.Spec.Datacenters, dc2)
+				sc.Status = scyllav1alpha1.ScyllaDBClusterStatus{
+					ObservedGeneration: pointer.Ptr(sc.Generation),
+					Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+						{
+							Name:                        "dc",
+							RemoteKubernetesClusterName: pointer.Ptr("rkc"),
+						},
+						{
+							Name: "dc2",
+						},
+					},
+				}
+				return sc
+			}(),
+			new: newValidScyllaDBCluster(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInternal, Field: "spec.datacenters[1]", Detail: `datacenter "dc2" can't be removed because its status doesn't yet reflect its remote Kubernetes cluster; please retry later`},
+			},
+			expectedErrorString: `spec.datacenters[1]: Internal error: datacenter "dc2" can't be removed because its status doesn't yet reflect its remote Kubernetes cluster; please retry later`,
+		},
+		{
+			name: "datacenter added while it's still being removed",
+			old: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				sc.Status = scyllav1alpha1.ScyllaDBClusterStatus{
+					ObservedGeneration: pointer.Ptr(sc.Generation),
+					Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+						{
+							Name:                        "dc",
+							RemoteKubernetesClusterName: pointer.Ptr("rkc"),
+						},
+						{
+							Name:                        "dc2",
+							RemoteKubernetesClusterName: pointer.Ptr("rkc2"),
+							Removal: &scyllav1alpha1.DatacenterRemovalStatus{
+								Method: scyllav1alpha1.DecommissionDatacenterRemovalMethod,
+							},
+						},
+					},
+				}
+				return sc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				dc2 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc2.Name = "dc2"
+				dc2.RemoteKubernetesClusterName = "rkc2"
+				dc2.Racks[0].ScyllaDB = nil
+				sc.Spec.Datacenters = append(sc.Spec.Datacenters, dc2)
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.datacenters[1]", BadValue: "", Detail: `datacenter "dc2" can't be added because it's still being removed`},
+			},
+			expectedErrorString: `spec.datacenters[1]: Forbidden: datacenter "dc2" can't be added because it's still being removed`,
+		},
+		{
+			name: "rebuild set on a datacenter being added",
+			old:  newValidScyllaDBCluster(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				dc2 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc2.Name = "dc2"
+				dc2.RemoteKubernetesClusterName = "rkc2"
+				dc2.Racks[0].ScyllaDB = nil
+				dc2.Rebuild = &scyllav1alpha1.RebuildOptions{
+					SourceDatacenter: "dc",
+				}
+				sc.Spec.Datacenters = append(sc.Spec.Datacenters, dc2)
+				return sc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "rebuild set on an existing datacenter",
+			old: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				dc2 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc2.Name = "dc2"
+				dc2.RemoteKubernetesClusterName = "rkc2"
+				sc.Spec.Datacenters = append(sc.Spec.Datacenters, dc2)
+				return sc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				dc2 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc2.Name = "dc2"
+				dc2.RemoteKubernetesClusterName = "rkc2"
+				dc2.Rebuild = &scyllav1alpha1.RebuildOptions{
+					SourceDatacenter: "dc",
+				}
+				sc.Spec.Datacenters = append(sc.Spec.Datacenters, dc2)
+				return sc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.datacenters[1].rebuild", BadValue: "", Detail: "rebuild can't be set or changed once the datacenter is added"},
+			},
+			expectedErrorString: `spec.datacenters[1].rebuild: Forbidden: rebuild can't be set or changed once the datacenter is added`,
+		},
+		{
+			name: "rebuild unset on an existing datacenter",
+			old: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				dc2 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc2.Name = "dc2"
+				dc2.RemoteKubernetesClusterName = "rkc2"
+				dc2.Rebuild = &scyllav1alpha1.RebuildOptions{
+					SourceDatacenter: "dc",
+				}
+				sc.Spec.Datacenters = append(sc.Spec.Datacenters, dc2)
+				return sc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBCluster {
+				sc := newValidScyllaDBCluster()
+				dc2 := *sc.Spec.Datacenters[0].DeepCopy()
+				dc2.Name = "dc2"
+				dc2.RemoteKubernetesClusterName = "rkc2"
+				sc.Spec.Datacenters = append(sc.Spec.Datacenters, dc2)
+				return sc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
 		{
 			name: "empty rack removed",
 			old: func() *scyllav1alpha1.ScyllaDBCluster {
@@ -5,14 +5,17 @@ package validation
 import (
 	"fmt"
 	"reflect"
-	"sort"
+	"slices"
 	"strings"
 
+	"github.com/blang/semver"
 	imgreference "github.com/containers/image/v5/docker/reference"
+	"github.com/robfig/cron/v3"
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/helpers"
 	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
 	"github.com/scylladb/scylla-operator/pkg/pointer"
+	scyllasemver "github.com/scylladb/scylla-operator/pkg/semver"
 	corevalidation "github.com/scylladb/scylla-operator/pkg/thirdparty/k8s.io/kubernetes/pkg/apis/core/validation"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -50,6 +53,11 @@ var (
 		scyllav1alpha1.NodeServiceTypeClusterIP,
 		scyllav1alpha1.NodeServiceTypeLoadBalancer,
 	}
+
+	supportedUpgradeFailureActions = []scyllav1alpha1.UpgradeFailureAction{
+		scyllav1alpha1.HaltUpgradeFailureAction,
+		scyllav1alpha1.RollbackUpgradeFailureAction,
+	}
 )
 
 func ValidateScyllaDBDatacenter(sdc *scyllav1alpha1.ScyllaDBDatacenter) field.ErrorList {
@@ -100,6 +108,229 @@ func ValidateScyllaDBDatacenterSpec(spec scyllav1alpha1.ScyllaDBDatacenterSpec,
 		allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(*spec.MinReadySeconds), fldPath.Child("minReadySeconds"))...)
 	}
 
+	if spec.RolloutStrategy != nil {
+		allErrs = append(allErrs, ValidateRolloutStrategy(spec.RolloutStrategy, fldPath.Child("rolloutStrategy"))...)
+	}
+
+	allErrs = append(allErrs, ValidateMaintenanceWindows(spec.MaintenanceWindows, fldPath.Child("maintenanceWindows"))...)
+
+	if spec.RestoreFromBackup != nil {
+		allErrs = append(allErrs, ValidateRestoreFromBackupOptions(spec.RestoreFromBackup, fldPath.Child("restoreFromBackup"))...)
+	}
+
+	if spec.Rebuild != nil {
+		allErrs = append(allErrs, ValidateRebuildOptions(spec.Rebuild, fldPath.Child("rebuild"))...)
+	}
+
+	if spec.UpgradeSnapshots != nil {
+		allErrs = append(allErrs, ValidateUpgradeSnapshotsOptions(spec.UpgradeSnapshots, fldPath.Child("upgradeSnapshots"))...)
+	}
+
+	if spec.UpgradeFailurePolicy != nil {
+		allErrs = append(allErrs, ValidateUpgradeFailurePolicy(spec.UpgradeFailurePolicy, fldPath.Child("upgradeFailurePolicy"))...)
+	}
+
+	allErrs = append(allErrs, ValidateUpgradePath(spec.UpgradePath, spec.ScyllaDB.Image, fldPath.Child("upgradePath"))...)
+
+	return allErrs
+}
+
+func ValidateRestoreFromBackupOptions(options *scyllav1alpha1.RestoreFromBackupOptions, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(options.Location) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("location"), "location must not be empty"))
+	} else {
+		for i := range options.Location {
+			allErrs = append(allErrs, validateLocation(options.Location[i], fldPath.Child("location").Index(i))...)
+		}
+	}
+
+	if len(options.SnapshotTag) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("snapshotTag"), ""))
+	} else if !restoreTaskSpecOptionsSnapshotTagRe.MatchString(options.SnapshotTag) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("snapshotTag"), options.SnapshotTag, "must be in sm_<YYYYMMDDhhmmss>UTC format"))
+	}
+
+	for i := range options.Keyspace {
+		allErrs = append(allErrs, validateKeyspaceFilter(options.Keyspace[i], fldPath.Child("keyspace").Index(i))...)
+	}
+
+	return allErrs
+}
+
+func ValidateRebuildOptions(options *scyllav1alpha1.RebuildOptions, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(options.SourceDatacenter) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("sourceDatacenter"), ""))
+	}
+
+	return allErrs
+}
+
+func ValidateUpgradeSnapshotsOptions(options *scyllav1alpha1.UpgradeSnapshotsOptions, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if options.RetainedUpgrades != nil {
+		allErrs = append(allErrs, apimachineryvalidation.ValidateNonnegativeField(int64(*options.RetainedUpgrades), fldPath.Child("retainedUpgrades"))...)
+	}
+
+	if options.TTL != nil && options.TTL.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ttl"), options.TTL.Duration.String(), "must be greater than zero"))
+	}
+
+	return allErrs
+}
+
+func ValidateUpgradeFailurePolicy(policy *scyllav1alpha1.UpgradeFailurePolicy, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if policy.NodeReadinessTimeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("nodeReadinessTimeout"), policy.NodeReadinessTimeout.Duration.String(), "must be greater than zero"))
+	}
+
+	if len(policy.Action) != 0 && !slices.Contains(supportedUpgradeFailureActions, policy.Action) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("action"), policy.Action, oslices.ConvertSlice(supportedUpgradeFailureActions, oslices.ToString[scyllav1alpha1.UpgradeFailureAction])))
+	}
+
+	return allErrs
+}
+
+func ValidateUpgradePath(upgradePath []string, image string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	var previousVersion *semver.Version
+	for i, pathImage := range upgradePath {
+		version, err := scyllasemver.ImageToVersion(pathImage)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), pathImage, fmt.Sprintf("must be an image tagged with a semantic version: %v", err)))
+			continue
+		}
+
+		if previousVersion != nil && !version.GT(*previousVersion) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), pathImage, "must have a greater version than the preceding image"))
+		}
+		previousVersion = &version
+	}
+
+	if previousVersion == nil {
+		return allErrs
+	}
+
+	// Images without semantic versions are validated elsewhere.
+	version, err := scyllasemver.ImageToVersion(image)
+	if err == nil && !version.GT(*previousVersion) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Index(len(upgradePath)-1), upgradePath[len(upgradePath)-1], "must have a lower version than spec.scyllaDB.image"))
+	}
+
+	return allErrs
+}
+
+// validateScyllaDBUpgrade rejects ScyllaDB image changes that downgrade across major versions
+// or skip required intermediate releases not listed in the upgrade path.
+func validateScyllaDBUpgrade(new, old *scyllav1alpha1.ScyllaDBDatacenter, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	oldVersion, err := scyllasemver.ImageToVersion(old.Spec.ScyllaDB.Image)
+	if err != nil {
+		return allErrs
+	}
+
+	newVersion, err := scyllasemver.ImageToVersion(new.Spec.ScyllaDB.Image)
+	if err != nil {
+		return allErrs
+	}
+
+	// Reverting an upgrade in progress is allowed, so failed upgrades can be rolled back.
+	if old.Status.Upgrade != nil && old.Status.Upgrade.FromVersion == newVersion.String() {
+		return allErrs
+	}
+
+	if newVersion.Major < oldVersion.Major {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("scyllaDB", "image"), fmt.Sprintf("downgrading ScyllaDB from %q to %q across major versions isn't supported", oldVersion, newVersion)))
+		return allErrs
+	}
+
+	for _, hop := range getScyllaDBUpgradeHops(oldVersion, newVersion, new.Spec.UpgradePath) {
+		releases, ok := scyllasemver.GetScyllaDBUpgradePath(hop[0], hop[1])
+		if ok && len(releases) != 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("scyllaDB", "image"), fmt.Sprintf("upgrading ScyllaDB from %q to %q skips required intermediate release(s) %s, upgrade through them first or list them in spec.upgradePath", hop[0], hop[1], strings.Join(releases, ", "))))
+		}
+	}
+
+	return allErrs
+}
+
+// getScyllaDBUpgradeHops returns the consecutive pairs of versions an upgrade goes through, taking the upgrade path into account.
+func getScyllaDBUpgradeHops(oldVersion, newVersion semver.Version, upgradePath []string) [][2]semver.Version {
+	if !newVersion.GT(oldVersion) {
+		return nil
+	}
+
+	versions := []semver.Version{oldVersion}
+	for _, pathImage := range upgradePath {
+		version, err := scyllasemver.ImageToVersion(pathImage)
+		if err != nil {
+			continue
+		}
+
+		if version.GT(versions[len(versions)-1]) && version.LT(newVersion) {
+			versions = append(versions, version)
+		}
+	}
+	versions = append(versions, newVersion)
+
+	hops := make([][2]semver.Version, 0, len(versions)-1)
+	for i := 1; i < len(versions); i++ {
+		hops = append(hops, [2]semver.Version{versions[i-1], versions[i]})
+	}
+
+	return hops
+}
+
+func ValidateMaintenanceWindows(maintenanceWindows []scyllav1alpha1.MaintenanceWindow, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, mw := range maintenanceWindows {
+		mwFldPath := fldPath.Index(i)
+
+		if len(mw.Cron) == 0 {
+			allErrs = append(allErrs, field.Required(mwFldPath.Child("cron"), ""))
+		} else {
+			_, err := cron.NewParser(schedulerTaskSpecCronParseOptions).Parse(mw.Cron)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(mwFldPath.Child("cron"), mw.Cron, err.Error()))
+			}
+
+			if strings.Contains(mw.Cron, "TZ") {
+				allErrs = append(allErrs, field.Invalid(mwFldPath.Child("cron"), mw.Cron, "can't use TZ or CRON_TZ in cron, maintenance windows are evaluated in UTC"))
+			}
+		}
+
+		if mw.Duration.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(mwFldPath.Child("duration"), mw.Duration.Duration.String(), "must be greater than zero"))
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateRolloutStrategy(rolloutStrategy *scyllav1alpha1.RolloutStrategy, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if rolloutStrategy.Canary != nil {
+		canaryFldPath := fldPath.Child("canary")
+
+		if rolloutStrategy.Canary.Nodes < 1 {
+			allErrs = append(allErrs, field.Invalid(canaryFldPath.Child("nodes"), rolloutStrategy.Canary.Nodes, "must be greater than zero"))
+		}
+
+		if rolloutStrategy.Canary.SoakDuration != nil && rolloutStrategy.Canary.SoakDuration.Duration < 0 {
+			allErrs = append(allErrs, field.Invalid(canaryFldPath.Child("soakDuration"), rolloutStrategy.Canary.SoakDuration.Duration.String(), "can't be negative"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -139,6 +370,24 @@ func ValidateScyllaDBDatacenterScyllaDBManagerAgentTemplate(scyllaDBManagerAgent
 	return allErrs
 }
 
+func ValidateStorageDataSource(dataSource *scyllav1alpha1.StorageDataSource, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(dataSource.ScyllaDBVolumeSnapshotRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("scyllaDBVolumeSnapshotRef", "name"), ""))
+	} else {
+		for _, msg := range apimachineryvalidation.NameIsDNSSubdomain(dataSource.ScyllaDBVolumeSnapshotRef.Name, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("scyllaDBVolumeSnapshotRef", "name"), dataSource.ScyllaDBVolumeSnapshotRef.Name, msg))
+		}
+	}
+
+	if dataSource.RackName != nil && len(*dataSource.RackName) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("rackName"), *dataSource.RackName, "must not be empty"))
+	}
+
+	return allErrs
+}
+
 func ValidateScyllaDBDatacenterScyllaDBTemplate(scyllaDBTemplate *scyllav1alpha1.ScyllaDBTemplate, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -160,6 +409,10 @@ func ValidateScyllaDBDatacenterScyllaDBTemplate(scyllaDBTemplate *scyllav1alpha1
 				allErrs = append(allErrs, field.Invalid(fldPath.Child("storage", "storageClassName"), *scyllaDBTemplate.Storage.StorageClassName, msg))
 			}
 		}
+
+		if scyllaDBTemplate.Storage.DataSource != nil {
+			allErrs = append(allErrs, ValidateStorageDataSource(scyllaDBTemplate.Storage.DataSource, fldPath.Child("storage", "dataSource"))...)
+		}
 	}
 
 	if scyllaDBTemplate.CustomConfigMapRef != nil {
@@ -446,6 +699,7 @@ func ValidateScyllaDBDatacenterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBDatac
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(new.Spec.ClusterName, old.Spec.ClusterName, fldPath.Child("clusterName"))...)
+	allErrs = append(allErrs, validateScyllaDBUpgrade(new, old, fldPath)...)
 
 	oldRackNames := oslices.ConvertSlice(old.Spec.Racks, func(rackSpec scyllav1alpha1.RackSpec) string {
 		return rackSpec.Name
@@ -454,47 +708,32 @@ func ValidateScyllaDBDatacenterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBDatac
 		return rackSpec.Name
 	})
 
-	removedRackNames := apimachineryutilsets.New(oldRackNames...).Difference(apimachineryutilsets.New(newRackNames...)).UnsortedList()
-	sort.Strings(removedRackNames)
+	addedRackNames := apimachineryutilsets.New(newRackNames...).Difference(apimachineryutilsets.New(oldRackNames...))
 
 	isRackStatusUpToDate := func(sdc *scyllav1alpha1.ScyllaDBDatacenter, rackStatus scyllav1alpha1.RackStatus) bool {
 		return sdc.Status.ObservedGeneration != nil && *sdc.Status.ObservedGeneration >= sdc.Generation && rackStatus.Stale != nil && !*rackStatus.Stale
 	}
 
-	for _, removedRackName := range removedRackNames {
-		for i, oldRack := range old.Spec.Racks {
-			if oldRack.Name != removedRackName {
-				continue
-			}
-
-			oldRackNodeCount := int32(0)
-			if old.Spec.RackTemplate != nil && old.Spec.RackTemplate.Nodes != nil {
-				oldRackNodeCount = *old.Spec.RackTemplate.Nodes
-			}
-			if oldRack.Nodes != nil {
-				oldRackNodeCount = *oldRack.Nodes
-			}
-
-			if oldRackNodeCount != 0 {
-				allErrs = append(allErrs, field.Forbidden(fldPath.Child("racks").Index(i), fmt.Sprintf("rack %q can't be removed because it still has members that have to be scaled down to zero first", removedRackName)))
-				continue
-			}
+	// Nodes of removed racks are decommissioned, the rack can't be added back until its removal finishes.
+	for i, newRack := range new.Spec.Racks {
+		if !addedRackNames.Has(newRack.Name) {
+			continue
+		}
 
-			oldRackStatus, _, ok := oslices.Find(old.Status.Racks, func(rackStatus scyllav1alpha1.RackStatus) bool {
-				return rackStatus.Name == removedRackName
-			})
-			if !ok {
-				continue
-			}
+		oldRackStatus, _, ok := oslices.Find(old.Status.Racks, func(rackStatus scyllav1alpha1.RackStatus) bool {
+			return rackStatus.Name == newRack.Name
+		})
+		if !ok {
+			continue
+		}
 
-			if oldRackStatus.Nodes != nil && *oldRackStatus.Nodes != 0 {
-				allErrs = append(allErrs, field.Forbidden(fldPath.Child("racks").Index(i), fmt.Sprintf("rack %q can't be removed because the members are being scaled down", removedRackName)))
-				continue
-			}
+		if oldRackStatus.Removal != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("racks").Index(i), fmt.Sprintf("rack %q can't be added because it's still being removed", newRack.Name)))
+			continue
+		}
 
-			if !isRackStatusUpToDate(old, oldRackStatus) {
-				allErrs = append(allErrs, field.InternalError(fldPath.Child("racks").Index(i), fmt.Errorf("rack %q can't be removed because its status, that's used to determine members count, is not yet up to date with the generation of this resource; please retry later", removedRackName)))
-			}
+		if !isRackStatusUpToDate(old, oldRackStatus) {
+			allErrs = append(allErrs, field.InternalError(fldPath.Child("racks").Index(i), fmt.Errorf("rack %q can't be added because its status, that's used to determine whether it's still being removed, is not yet up to date with the generation of this resource; please retry later", newRack.Name)))
 		}
 	}
 
@@ -522,8 +761,36 @@ func ValidateScyllaDBDatacenterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBDatac
 			oldRackStorage = *oldRack.ScyllaDB.Storage
 		}
 
+		// Changing the storage class migrates the rack by replacing its nodes one by one.
+		if !reflect.DeepEqual(oldRackStorage.StorageClassName, newRackStorage.StorageClassName) {
+			if newRackStorage.StorageClassName == nil || len(*newRackStorage.StorageClassName) == 0 {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("racks").Index(i).Child("scyllaDB", "storage", "storageClassName"), "storage class can't be unset once the rack is created"))
+			}
+			if oldRackStorage.Capacity != newRackStorage.Capacity {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("racks").Index(i).Child("scyllaDB", "storage"), "storage capacity and storage class can't be changed at the same time"))
+			}
+			oldRackStorage.StorageClassName = newRackStorage.StorageClassName
+		}
+
+		// Data sources are only used when the rack is created.
+		if !reflect.DeepEqual(oldRackStorage.DataSource, newRackStorage.DataSource) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("racks").Index(i).Child("scyllaDB", "storage", "dataSource"), "data source can't be changed once the rack is created"))
+			oldRackStorage.DataSource = newRackStorage.DataSource
+		}
+
+		// Capacity can only grow, the corresponding PVCs are expanded by the controller.
+		// Invalid quantities are reported by the spec validation.
+		oldRackStorageCapacity, oldErr := resource.ParseQuantity(oldRackStorage.Capacity)
+		newRackStorageCapacity, newErr := resource.ParseQuantity(newRackStorage.Capacity)
+		if oldErr == nil && newErr == nil {
+			if newRackStorageCapacity.Cmp(oldRackStorageCapacity) < 0 {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("racks").Index(i).Child("scyllaDB", "storage", "capacity"), "storage capacity can't be decreased"))
+			}
+			oldRackStorage.Capacity = newRackStorage.Capacity
+		}
+
 		if !reflect.DeepEqual(oldRackStorage, newRackStorage) {
-			allErrs = append(allErrs, field.Forbidden(fldPath.Child("racks").Index(i).Child("scyllaDB", "storage"), "changes in storage are currently not supported"))
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("racks").Index(i).Child("scyllaDB", "storage"), "changes in storage other than a capacity increase or a storage class change are currently not supported"))
 		}
 	}
 
@@ -554,6 +821,15 @@ func ValidateScyllaDBDatacenterSpecUpdate(new, old *scyllav1alpha1.ScyllaDBDatac
 	}
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newNodeServiceType, oldNodeServiceType, fldPath.Child("exposeOptions", "nodeService", "type"))...)
 
+	if !reflect.DeepEqual(new.Spec.RestoreFromBackup, old.Spec.RestoreFromBackup) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("restoreFromBackup"), "restoreFromBackup can't be changed once the ScyllaDBDatacenter is created"))
+	}
+
+	// Rebuild can be unset once it's no longer needed.
+	if new.Spec.Rebuild != nil && !reflect.DeepEqual(new.Spec.Rebuild, old.Spec.Rebuild) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("rebuild"), "rebuild can't be set or changed once the ScyllaDBDatacenter is created"))
+	}
+
 	return allErrs
 }
 
@@ -643,5 +919,33 @@ func GetWarningsOnScyllaDBDatacenterCreate(sdc *scyllav1alpha1.ScyllaDBDatacente
 }
 
 func GetWarningsOnScyllaDBDatacenterUpdate(new, old *scyllav1alpha1.ScyllaDBDatacenter) []string {
-	return nil
+	var warnings []string
+
+	if new.Spec.ScyllaDB.Image == old.Spec.ScyllaDB.Image {
+		return warnings
+	}
+
+	oldVersion, oldErr := scyllasemver.ImageToVersion(old.Spec.ScyllaDB.Image)
+	newVersion, newErr := scyllasemver.ImageToVersion(new.Spec.ScyllaDB.Image)
+	if oldErr != nil || newErr != nil {
+		warnings = append(warnings, fmt.Sprintf("Can't verify the ScyllaDB upgrade path from %q to %q because the images aren't tagged with semantic versions.", old.Spec.ScyllaDB.Image, new.Spec.ScyllaDB.Image))
+		return warnings
+	}
+
+	if newVersion.LT(oldVersion) {
+		isRevert := old.Status.Upgrade != nil && old.Status.Upgrade.FromVersion == newVersion.String()
+		if !isRevert && newVersion.Major == oldVersion.Major {
+			warnings = append(warnings, fmt.Sprintf("Downgrading ScyllaDB from %q to %q isn't supported, consider restoring from a backup instead.", oldVersion, newVersion))
+		}
+		return warnings
+	}
+
+	for _, hop := range getScyllaDBUpgradeHops(oldVersion, newVersion, new.Spec.UpgradePath) {
+		_, ok := scyllasemver.GetScyllaDBUpgradePath(hop[0], hop[1])
+		if !ok {
+			warnings = append(warnings, fmt.Sprintf("Can't verify the ScyllaDB upgrade path from %q to %q because they aren't known ScyllaDB releases.", hop[0], hop[1]))
+		}
+	}
+
+	return warnings
 }
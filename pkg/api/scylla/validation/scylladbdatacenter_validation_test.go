@@ -5,6 +5,7 @@ package validation_test
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
@@ -377,6 +378,199 @@ func TestValidateScyllaDBDatacenter(t *testing.T) {
 			},
 			expectedErrorString: `spec.minReadySeconds: Invalid value: -42: must be greater than or equal to 0`,
 		},
+		{
+			name: "valid canary rollout strategy",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RolloutStrategy = &scyllav1alpha1.RolloutStrategy{
+					Canary: &scyllav1alpha1.CanaryRolloutStrategy{
+						Nodes:        1,
+						SoakDuration: &metav1.Duration{Duration: time.Hour},
+					},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "invalid canary rollout strategy",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RolloutStrategy = &scyllav1alpha1.RolloutStrategy{
+					Canary: &scyllav1alpha1.CanaryRolloutStrategy{
+						Nodes:        0,
+						SoakDuration: &metav1.Duration{Duration: -time.Hour},
+					},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rolloutStrategy.canary.nodes", BadValue: int32(0), Detail: "must be greater than zero"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rolloutStrategy.canary.soakDuration", BadValue: "-1h0m0s", Detail: "can't be negative"},
+			},
+			expectedErrorString: `[spec.rolloutStrategy.canary.nodes: Invalid value: 0: must be greater than zero, spec.rolloutStrategy.canary.soakDuration: Invalid value: "-1h0m0s": can't be negative]`,
+		},
+		{
+			name: "valid upgrade snapshots",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.UpgradeSnapshots = &scyllav1alpha1.UpgradeSnapshotsOptions{
+					DataSnapshots:    pointer.Ptr(false),
+					RetainedUpgrades: pointer.Ptr[int32](2),
+					TTL:              &metav1.Duration{Duration: 24 * time.Hour},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "invalid upgrade snapshots",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.UpgradeSnapshots = &scyllav1alpha1.UpgradeSnapshotsOptions{
+					RetainedUpgrades: pointer.Ptr[int32](-1),
+					TTL:              &metav1.Duration{Duration: 0},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.upgradeSnapshots.retainedUpgrades", BadValue: int64(-1), Detail: "must be greater than or equal to 0", Origin: "minimum"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.upgradeSnapshots.ttl", BadValue: "0s", Detail: "must be greater than zero"},
+			},
+			expectedErrorString: `[spec.upgradeSnapshots.retainedUpgrades: Invalid value: -1: must be greater than or equal to 0, spec.upgradeSnapshots.ttl: Invalid value: "0s": must be greater than zero]`,
+		},
+		{
+			name: "valid upgrade failure policy",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.UpgradeFailurePolicy = &scyllav1alpha1.UpgradeFailurePolicy{
+					NodeReadinessTimeout: metav1.Duration{Duration: 30 * time.Minute},
+					Action:               scyllav1alpha1.RollbackUpgradeFailureAction,
+				}
+
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "invalid upgrade failure policy",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.UpgradeFailurePolicy = &scyllav1alpha1.UpgradeFailurePolicy{
+					NodeReadinessTimeout: metav1.Duration{Duration: 0},
+					Action:               "Retry",
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.upgradeFailurePolicy.nodeReadinessTimeout", BadValue: "0s", Detail: "must be greater than zero"},
+				&field.Error{Type: field.ErrorTypeNotSupported, Field: "spec.upgradeFailurePolicy.action", BadValue: scyllav1alpha1.UpgradeFailureAction("Retry"), Detail: `supported values: "Halt", "Rollback"`},
+			},
+			expectedErrorString: `[spec.upgradeFailurePolicy.nodeReadinessTimeout: Invalid value: "0s": must be greater than zero, spec.upgradeFailurePolicy.action: Unsupported value: "Retry": supported values: "Halt", "Rollback"]`,
+		},
+		{
+			name: "valid upgrade path",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Image = "scylladb/scylla:6.2.0"
+				sdc.Spec.UpgradePath = []string{
+					"scylladb/scylla:6.0.0",
+					"scylladb/scylla:6.1.0",
+				}
+
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "invalid upgrade path",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Image = "scylladb/scylla:6.1.0"
+				sdc.Spec.UpgradePath = []string{
+					"scylladb/scylla:latest",
+					"scylladb/scylla:6.1.0",
+					"scylladb/scylla:6.0.0",
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.upgradePath[0]", BadValue: "scylladb/scylla:latest", Detail: `must be an image tagged with a semantic version: No Major.Minor.Patch elements found`},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.upgradePath[2]", BadValue: "scylladb/scylla:6.0.0", Detail: "must have a greater version than the preceding image"},
+			},
+			expectedErrorString: `[spec.upgradePath[0]: Invalid value: "scylladb/scylla:latest": must be an image tagged with a semantic version: No Major.Minor.Patch elements found, spec.upgradePath[2]: Invalid value: "scylladb/scylla:6.0.0": must have a greater version than the preceding image]`,
+		},
+		{
+			name: "upgrade path not lower than the image",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Image = "scylladb/scylla:6.1.0"
+				sdc.Spec.UpgradePath = []string{
+					"scylladb/scylla:6.0.0",
+					"scylladb/scylla:6.1.0",
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.upgradePath[1]", BadValue: "scylladb/scylla:6.1.0", Detail: "must have a lower version than spec.scyllaDB.image"},
+			},
+			expectedErrorString: `spec.upgradePath[1]: Invalid value: "scylladb/scylla:6.1.0": must have a lower version than spec.scyllaDB.image`,
+		},
+		{
+			name: "valid maintenance windows",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.MaintenanceWindows = []scyllav1alpha1.MaintenanceWindow{
+					{
+						Cron:     "0 2 * * SAT",
+						Duration: metav1.Duration{Duration: 4 * time.Hour},
+					},
+					{
+						Cron:     "@daily",
+						Duration: metav1.Duration{Duration: time.Hour},
+					},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "invalid maintenance windows",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.MaintenanceWindows = []scyllav1alpha1.MaintenanceWindow{
+					{
+						Cron:     "",
+						Duration: metav1.Duration{Duration: time.Hour},
+					},
+					{
+						Cron:     "CRON_TZ=Europe/Warsaw 0 2 * * *",
+						Duration: metav1.Duration{},
+					},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.maintenanceWindows[0].cron", BadValue: ""},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.maintenanceWindows[1].cron", BadValue: "CRON_TZ=Europe/Warsaw 0 2 * * *", Detail: "can't use TZ or CRON_TZ in cron, maintenance windows are evaluated in UTC"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.maintenanceWindows[1].duration", BadValue: "0s", Detail: "must be greater than zero"},
+			},
+			expectedErrorString: `[spec.maintenanceWindows[0].cron: Required value, spec.maintenanceWindows[1].cron: Invalid value: "CRON_TZ=Europe/Warsaw 0 2 * * *": can't use TZ or CRON_TZ in cron, maintenance windows are evaluated in UTC, spec.maintenanceWindows[1].duration: Invalid value: "0s": must be greater than zero]`,
+		},
 		{
 			name: "minimal alternator cluster passes",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
@@ -632,6 +826,64 @@ func TestValidateScyllaDBDatacenter(t *testing.T) {
 			},
 			expectedErrorString: `spec.rackTemplate.scyllaDB.storage.storageClassName: Invalid value: "-hello": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`,
 		},
+		{
+			name: "invalid restoreFromBackup",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RestoreFromBackup = &scyllav1alpha1.RestoreFromBackupOptions{
+					Location:    nil,
+					SnapshotTag: "invalid",
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.restoreFromBackup.location", BadValue: "", Detail: "location must not be empty"},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.restoreFromBackup.snapshotTag", BadValue: "invalid", Detail: "must be in sm_<YYYYMMDDhhmmss>UTC format"},
+			},
+			expectedErrorString: `[spec.restoreFromBackup.location: Required value: location must not be empty, spec.restoreFromBackup.snapshotTag: Invalid value: "invalid": must be in sm_<YYYYMMDDhhmmss>UTC format]`,
+		},
+		{
+			name: "valid restoreFromBackup",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RestoreFromBackup = &scyllav1alpha1.RestoreFromBackupOptions{
+					Location:    []string{"gcs:backups"},
+					SnapshotTag: "sm_20250101000000UTC",
+					Keyspace:    []string{"keyspace", "!keyspace.table"},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "invalid dataSource in rackTemplate scyllaDB storage",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+						Storage: &scyllav1alpha1.StorageOptions{
+							Capacity: "1Gi",
+							DataSource: &scyllav1alpha1.StorageDataSource{
+								ScyllaDBVolumeSnapshotRef: scyllav1alpha1.LocalObjectReference{
+									Name: "",
+								},
+								RackName: pointer.Ptr(""),
+							},
+						},
+					},
+				}
+
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.rackTemplate.scyllaDB.storage.dataSource.scyllaDBVolumeSnapshotRef.name", BadValue: "", Detail: ""},
+				&field.Error{Type: field.ErrorTypeInvalid, Field: "spec.rackTemplate.scyllaDB.storage.dataSource.rackName", BadValue: "", Detail: "must not be empty"},
+			},
+			expectedErrorString: `[spec.rackTemplate.scyllaDB.storage.dataSource.scyllaDBVolumeSnapshotRef.name: Required value, spec.rackTemplate.scyllaDB.storage.dataSource.rackName: Invalid value: "": must not be empty]`,
+		},
 		{
 			name: "invalid customConfigMapRef in scyllaDB template of rackTemplate",
 			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
@@ -666,6 +918,18 @@ func TestValidateScyllaDBDatacenter(t *testing.T) {
 			},
 			expectedErrorString: `spec.rackTemplate.scyllaDBManagerAgent.customConfigSecretRef: Invalid value: "-hello": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`,
 		},
+		{
+			name: "rebuild with empty source datacenter",
+			datacenter: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Rebuild = &scyllav1alpha1.RebuildOptions{}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeRequired, Field: "spec.rebuild.sourceDatacenter", BadValue: ""},
+			},
+			expectedErrorString: `spec.rebuild.sourceDatacenter: Required value`,
+		},
 	}
 
 	for _, test := range tests {
@@ -759,17 +1023,77 @@ func TestValidateScyllaDBDatacenterUpdate(t *testing.T) {
 			expectedErrorString: `spec.clusterName: Invalid value: "foo": field is immutable`,
 		},
 		{
-			name: "rackStorage changed",
+			name: "rack storage capacity increased",
+			old:  newValidScyllaDBDatacenter(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage.Capacity = "123Gi"
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "rack storage capacity changed to an equal quantity",
 			old:  newValidScyllaDBDatacenter(),
 			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage.Capacity = "1024Mi"
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "rack storage capacity decreased",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
 				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage.Capacity = "123Gi"
 				return sdc
 			}(),
+			new: newValidScyllaDBDatacenter(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage.capacity", BadValue: "", Detail: "storage capacity can't be decreased"},
+			},
+			expectedErrorString: "spec.racks[0].scyllaDB.storage.capacity: Forbidden: storage capacity can't be decreased",
+		},
+		{
+			name: "rack storage capacity increased and storageClassName changed",
+			old:  newValidScyllaDBDatacenter(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage.Capacity = "123Gi"
+				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage.StorageClassName = pointer.Ptr("new-class")
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage", BadValue: "", Detail: "storage capacity and storage class can't be changed at the same time"},
+			},
+			expectedErrorString: "spec.racks[0].scyllaDB.storage: Forbidden: storage capacity and storage class can't be changed at the same time",
+		},
+		{
+			name: "rack storage storageClassName set",
+			old:  newValidScyllaDBDatacenter(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage.StorageClassName = pointer.Ptr("new-class")
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "rack storage storageClassName unset",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage.StorageClassName = pointer.Ptr("old-class")
+				return sdc
+			}(),
+			new: newValidScyllaDBDatacenter(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage", BadValue: "", Detail: "changes in storage are currently not supported"},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage.storageClassName", BadValue: "", Detail: "storage class can't be unset once the rack is created"},
 			},
-			expectedErrorString: "spec.racks[0].scyllaDB.storage: Forbidden: changes in storage are currently not supported",
+			expectedErrorString: "spec.racks[0].scyllaDB.storage.storageClassName: Forbidden: storage class can't be unset once the rack is created",
 		},
 		{
 			name: "rack storage storageClassName changed",
@@ -783,10 +1107,41 @@ func TestValidateScyllaDBDatacenterUpdate(t *testing.T) {
 				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage.StorageClassName = pointer.Ptr("new-class")
 				return sdc
 			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "restoreFromBackup changed",
+			old:  newValidScyllaDBDatacenter(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.RestoreFromBackup = &scyllav1alpha1.RestoreFromBackupOptions{
+					Location:    []string{"gcs:backups"},
+					SnapshotTag: "sm_20250101000000UTC",
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.restoreFromBackup", BadValue: "", Detail: "restoreFromBackup can't be changed once the ScyllaDBDatacenter is created"},
+			},
+			expectedErrorString: "spec.restoreFromBackup: Forbidden: restoreFromBackup can't be changed once the ScyllaDBDatacenter is created",
+		},
+		{
+			name: "rack storage dataSource changed",
+			old:  newValidScyllaDBDatacenter(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage.DataSource = &scyllav1alpha1.StorageDataSource{
+					ScyllaDBVolumeSnapshotRef: scyllav1alpha1.LocalObjectReference{
+						Name: "snapshot",
+					},
+				}
+				return sdc
+			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage", BadValue: "", Detail: "changes in storage are currently not supported"},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage.dataSource", BadValue: "", Detail: "data source can't be changed once the rack is created"},
 			},
-			expectedErrorString: "spec.racks[0].scyllaDB.storage: Forbidden: changes in storage are currently not supported",
+			expectedErrorString: "spec.racks[0].scyllaDB.storage.dataSource: Forbidden: data source can't be changed once the rack is created",
 		},
 		{
 			name: "rack storage metadata labels changed",
@@ -805,9 +1160,9 @@ func TestValidateScyllaDBDatacenterUpdate(t *testing.T) {
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage", BadValue: "", Detail: "changes in storage are currently not supported"},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage", BadValue: "", Detail: "changes in storage other than a capacity increase or a storage class change are currently not supported"},
 			},
-			expectedErrorString: "spec.racks[0].scyllaDB.storage: Forbidden: changes in storage are currently not supported",
+			expectedErrorString: "spec.racks[0].scyllaDB.storage: Forbidden: changes in storage other than a capacity increase or a storage class change are currently not supported",
 		},
 		{
 			name: "rack storage metadata annotations changed",
@@ -826,12 +1181,12 @@ func TestValidateScyllaDBDatacenterUpdate(t *testing.T) {
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage", BadValue: "", Detail: "changes in storage are currently not supported"},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage", BadValue: "", Detail: "changes in storage other than a capacity increase or a storage class change are currently not supported"},
 			},
-			expectedErrorString: "spec.racks[0].scyllaDB.storage: Forbidden: changes in storage are currently not supported",
+			expectedErrorString: "spec.racks[0].scyllaDB.storage: Forbidden: changes in storage other than a capacity increase or a storage class change are currently not supported",
 		},
 		{
-			name: "rackTemplate storage capacity changed",
+			name: "rackTemplate storage capacity increased",
 			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
 				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage = nil
@@ -856,10 +1211,39 @@ func TestValidateScyllaDBDatacenterUpdate(t *testing.T) {
 				}
 				return sdc
 			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "rackTemplate storage capacity decreased",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage = nil
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+						Storage: &scyllav1alpha1.StorageOptions{
+							Capacity: "123Gi",
+						},
+					},
+				}
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks[0].RackTemplate.ScyllaDB.Storage = nil
+				sdc.Spec.RackTemplate = &scyllav1alpha1.RackTemplate{
+					ScyllaDB: &scyllav1alpha1.ScyllaDBTemplate{
+						Storage: &scyllav1alpha1.StorageOptions{
+							Capacity: "1Gi",
+						},
+					},
+				}
+				return sdc
+			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage", BadValue: "", Detail: "changes in storage are currently not supported"},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage.capacity", BadValue: "", Detail: "storage capacity can't be decreased"},
 			},
-			expectedErrorString: "spec.racks[0].scyllaDB.storage: Forbidden: changes in storage are currently not supported",
+			expectedErrorString: "spec.racks[0].scyllaDB.storage.capacity: Forbidden: storage capacity can't be decreased",
 		},
 		{
 			name: "rackTemplate storage storageClassName changed",
@@ -889,10 +1273,8 @@ func TestValidateScyllaDBDatacenterUpdate(t *testing.T) {
 				}
 				return sdc
 			}(),
-			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage", BadValue: "", Detail: "changes in storage are currently not supported"},
-			},
-			expectedErrorString: "spec.racks[0].scyllaDB.storage: Forbidden: changes in storage are currently not supported",
+			expectedErrorList:   nil,
+			expectedErrorString: "",
 		},
 		{
 			name: "rackTemplate storage metadata changed",
@@ -927,9 +1309,9 @@ func TestValidateScyllaDBDatacenterUpdate(t *testing.T) {
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage", BadValue: "", Detail: "changes in storage are currently not supported"},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0].scyllaDB.storage", BadValue: "", Detail: "changes in storage other than a capacity increase or a storage class change are currently not supported"},
 			},
-			expectedErrorString: "spec.racks[0].scyllaDB.storage: Forbidden: changes in storage are currently not supported",
+			expectedErrorString: "spec.racks[0].scyllaDB.storage: Forbidden: changes in storage other than a capacity increase or a storage class change are currently not supported",
 		},
 		{
 			name: "rackTemplate storage changed but rack overrides storage",
@@ -974,7 +1356,7 @@ func TestValidateScyllaDBDatacenterUpdate(t *testing.T) {
 			expectedErrorString: "",
 		},
 		{
-			name: "empty rack with members under decommission",
+			name: "rack with members under decommission removed",
 			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
 				sdc.Status.Racks = []scyllav1alpha1.RackStatus{
@@ -990,20 +1372,48 @@ func TestValidateScyllaDBDatacenterUpdate(t *testing.T) {
 				sdc.Spec.Racks = []scyllav1alpha1.RackSpec{}
 				return sdc
 			}(),
-			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0]", BadValue: "", Detail: `rack "rack" can't be removed because the members are being scaled down`},
-			},
-			expectedErrorString: `spec.racks[0]: Forbidden: rack "rack" can't be removed because the members are being scaled down`,
+			expectedErrorList:   nil,
+			expectedErrorString: "",
 		},
 		{
-			name: "empty rack with stale status",
+			name: "non-empty racks removed",
 			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks = []scyllav1alpha1.RackSpec{
+					func() scyllav1alpha1.RackSpec {
+						rackSpec := *sdc.Spec.Racks[0].DeepCopy()
+						rackSpec.Name = "rack-0"
+						rackSpec.Nodes = pointer.Ptr[int32](3)
+						return rackSpec
+					}(),
+					func() scyllav1alpha1.RackSpec {
+						rackSpec := *sdc.Spec.Racks[0].DeepCopy()
+						rackSpec.Name = "rack-1"
+						rackSpec.Nodes = pointer.Ptr[int32](2)
+						return rackSpec
+					}(),
+					func() scyllav1alpha1.RackSpec {
+						rackSpec := *sdc.Spec.Racks[0].DeepCopy()
+						rackSpec.Name = "rack-2"
+						rackSpec.Nodes = pointer.Ptr[int32](1)
+						return rackSpec
+					}(),
+				}
 				sdc.Status.Racks = []scyllav1alpha1.RackStatus{
 					{
 						Name:  sdc.Spec.Racks[0].Name,
-						Nodes: pointer.Ptr[int32](0),
-						Stale: pointer.Ptr(true),
+						Nodes: pointer.Ptr[int32](3),
+						Stale: pointer.Ptr(false),
+					},
+					{
+						Name:  sdc.Spec.Racks[1].Name,
+						Nodes: pointer.Ptr[int32](2),
+						Stale: pointer.Ptr(false),
+					},
+					{
+						Name:  sdc.Spec.Racks[2].Name,
+						Nodes: pointer.Ptr[int32](1),
+						Stale: pointer.Ptr(false),
 					},
 				}
 				return sdc
@@ -1013,73 +1423,91 @@ func TestValidateScyllaDBDatacenterUpdate(t *testing.T) {
 				sdc.Spec.Racks = []scyllav1alpha1.RackSpec{}
 				return sdc
 			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "rack can't be added back while it's being removed",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks = []scyllav1alpha1.RackSpec{}
+				sdc.Status.Racks = []scyllav1alpha1.RackStatus{
+					{
+						Name:  "rack",
+						Nodes: pointer.Ptr[int32](2),
+						Removal: &scyllav1alpha1.RackRemovalStatus{
+							RemainingNodes:      2,
+							DecommissioningNode: pointer.Ptr("basic-dc-rack-1"),
+						},
+					},
+				}
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				return sdc
+			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeInternal, Field: "spec.racks[0]", Detail: `rack "rack" can't be removed because its status, that's used to determine members count, is not yet up to date with the generation of this resource; please retry later`},
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0]", BadValue: "", Detail: `rack "rack" can't be added because it's still being removed`},
 			},
-			expectedErrorString: `spec.racks[0]: Internal error: rack "rack" can't be removed because its status, that's used to determine members count, is not yet up to date with the generation of this resource; please retry later`,
+			expectedErrorString: `spec.racks[0]: Forbidden: rack "rack" can't be added because it's still being removed`,
 		},
 		{
-			name: "empty rack with not reconciled generation",
+			name: "rack added back with stale status",
 			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Generation = 2
-				sdc.Status.ObservedGeneration = pointer.Ptr[int64](1)
+				sdc.Spec.Racks = []scyllav1alpha1.RackSpec{}
 				sdc.Status.Racks = []scyllav1alpha1.RackStatus{
 					{
-						Name:  sdc.Spec.Racks[0].Name,
+						Name:  "rack",
 						Nodes: pointer.Ptr[int32](0),
+						Stale: pointer.Ptr(true),
 					},
 				}
 				return sdc
 			}(),
 			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.Racks = []scyllav1alpha1.RackSpec{}
 				return sdc
 			}(),
 			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeInternal, Field: "spec.racks[0]", Detail: `rack "rack" can't be removed because its status, that's used to determine members count, is not yet up to date with the generation of this resource; please retry later`},
+				&field.Error{Type: field.ErrorTypeInternal, Field: "spec.racks[0]", Detail: `rack "rack" can't be added because its status, that's used to determine whether it's still being removed, is not yet up to date with the generation of this resource; please retry later`},
 			},
-			expectedErrorString: `spec.racks[0]: Internal error: rack "rack" can't be removed because its status, that's used to determine members count, is not yet up to date with the generation of this resource; please retry later`,
+			expectedErrorString: `spec.racks[0]: Internal error: rack "rack" can't be added because its status, that's used to determine whether it's still being removed, is not yet up to date with the generation of this resource; please retry later`,
 		},
 		{
-			name: "non-empty racks deleted",
+			name: "rack added back with not reconciled generation",
 			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.Racks = []scyllav1alpha1.RackSpec{
-					func() scyllav1alpha1.RackSpec {
-						rackSpec := *sdc.Spec.Racks[0].DeepCopy()
-						rackSpec.Name = "rack-0"
-						rackSpec.Nodes = pointer.Ptr[int32](3)
-						return rackSpec
-					}(),
-					func() scyllav1alpha1.RackSpec {
-						rackSpec := *sdc.Spec.Racks[0].DeepCopy()
-						rackSpec.Name = "rack-1"
-						rackSpec.Nodes = pointer.Ptr[int32](2)
-						return rackSpec
-					}(),
-					func() scyllav1alpha1.RackSpec {
-						rackSpec := *sdc.Spec.Racks[0].DeepCopy()
-						rackSpec.Name = "rack-2"
-						rackSpec.Nodes = pointer.Ptr[int32](1)
-						return rackSpec
-					}(),
-				}
+				sdc.Generation = 2
+				sdc.Spec.Racks = []scyllav1alpha1.RackSpec{}
 				sdc.Status.Racks = []scyllav1alpha1.RackStatus{
 					{
-						Name:  sdc.Spec.Racks[0].Name,
-						Nodes: pointer.Ptr[int32](3),
-						Stale: pointer.Ptr(false),
-					},
-					{
-						Name:  sdc.Spec.Racks[1].Name,
-						Nodes: pointer.Ptr[int32](2),
+						Name:  "rack",
+						Nodes: pointer.Ptr[int32](0),
 						Stale: pointer.Ptr(false),
 					},
+				}
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeInternal, Field: "spec.racks[0]", Detail: `rack "rack" can't be added because its status, that's used to determine whether it's still being removed, is not yet up to date with the generation of this resource; please retry later`},
+			},
+			expectedErrorString: `spec.racks[0]: Internal error: rack "rack" can't be added because its status, that's used to determine whether it's still being removed, is not yet up to date with the generation of this resource; please retry later`,
+		},
+		{
+			name: "rack added back once it's removed",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Racks = []scyllav1alpha1.RackSpec{}
+				sdc.Status.Racks = []scyllav1alpha1.RackStatus{
 					{
-						Name:  sdc.Spec.Racks[2].Name,
-						Nodes: pointer.Ptr[int32](1),
+						Name:  "rack",
+						Nodes: pointer.Ptr[int32](0),
 						Stale: pointer.Ptr(false),
 					},
 				}
@@ -1087,15 +1515,10 @@ func TestValidateScyllaDBDatacenterUpdate(t *testing.T) {
 			}(),
 			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
 				sdc := newValidScyllaDBDatacenter()
-				sdc.Spec.Racks = []scyllav1alpha1.RackSpec{}
 				return sdc
 			}(),
-			expectedErrorList: field.ErrorList{
-				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[0]", BadValue: "", Detail: `rack "rack-0" can't be removed because it still has members that have to be scaled down to zero first`},
-				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[1]", BadValue: "", Detail: `rack "rack-1" can't be removed because it still has members that have to be scaled down to zero first`},
-				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.racks[2]", BadValue: "", Detail: `rack "rack-2" can't be removed because it still has members that have to be scaled down to zero first`},
-			},
-			expectedErrorString: `[spec.racks[0]: Forbidden: rack "rack-0" can't be removed because it still has members that have to be scaled down to zero first, spec.racks[1]: Forbidden: rack "rack-1" can't be removed because it still has members that have to be scaled down to zero first, spec.racks[2]: Forbidden: rack "rack-2" can't be removed because it still has members that have to be scaled down to zero first]`,
+			expectedErrorList:   nil,
+			expectedErrorString: "",
 		},
 		{
 			name: "node service type cannot be unset",
@@ -1213,6 +1636,122 @@ func TestValidateScyllaDBDatacenterUpdate(t *testing.T) {
 			},
 			expectedErrorString: `spec.exposeOptions.broadcastOptions.nodes.type: Invalid value: "PodIP": field is immutable`,
 		},
+		{
+			name: "direct upgrade",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Image = "scylladb/scylla:6.1.0"
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Image = "scylladb/scylla:6.2.0"
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "upgrade skipping required releases",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Image = "scylladb/scylla:6.0.0"
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Image = "scylladb/scylla:2025.1.0"
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.scyllaDB.image", BadValue: "", Detail: `upgrading ScyllaDB from "6.0.0" to "2025.1.0" skips required intermediate release(s) 6.1, 6.2, upgrade through them first or list them in spec.upgradePath`},
+			},
+			expectedErrorString: `spec.scyllaDB.image: Forbidden: upgrading ScyllaDB from "6.0.0" to "2025.1.0" skips required intermediate release(s) 6.1, 6.2, upgrade through them first or list them in spec.upgradePath`,
+		},
+		{
+			name: "upgrade through upgrade path",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Image = "scylladb/scylla:6.0.0"
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Image = "scylladb/scylla:2025.1.0"
+				sdc.Spec.UpgradePath = []string{
+					"scylladb/scylla:6.1.0",
+					"scylladb/scylla:6.2.0",
+				}
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "reverting an upgrade in progress",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Image = "scylladb/scylla:2025.1.0"
+				sdc.Status.Upgrade = &scyllav1alpha1.UpgradeStatus{
+					Phase:       scyllav1alpha1.FailedUpgradePhase,
+					FromVersion: "6.2.0",
+					ToVersion:   "2025.1.0",
+				}
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Image = "scylladb/scylla:6.2.0"
+				return sdc
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
+		{
+			name: "downgrade across major versions",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Image = "scylladb/scylla:2025.1.0"
+				return sdc
+			}(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.ScyllaDB.Image = "scylladb/scylla:6.2.0"
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.scyllaDB.image", BadValue: "", Detail: `downgrading ScyllaDB from "2025.1.0" to "6.2.0" across major versions isn't supported`},
+			},
+			expectedErrorString: `spec.scyllaDB.image: Forbidden: downgrading ScyllaDB from "2025.1.0" to "6.2.0" across major versions isn't supported`,
+		},
+		{
+			name: "rebuild set on an existing ScyllaDBDatacenter",
+			old:  newValidScyllaDBDatacenter(),
+			new: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Rebuild = &scyllav1alpha1.RebuildOptions{
+					SourceDatacenter: "dc1",
+				}
+				return sdc
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{Type: field.ErrorTypeForbidden, Field: "spec.rebuild", BadValue: "", Detail: "rebuild can't be set or changed once the ScyllaDBDatacenter is created"},
+			},
+			expectedErrorString: `spec.rebuild: Forbidden: rebuild can't be set or changed once the ScyllaDBDatacenter is created`,
+		},
+		{
+			name: "rebuild unset",
+			old: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newValidScyllaDBDatacenter()
+				sdc.Spec.Rebuild = &scyllav1alpha1.RebuildOptions{
+					SourceDatacenter: "dc1",
+				}
+				return sdc
+			}(),
+			new:                 newValidScyllaDBDatacenter(),
+			expectedErrorList:   nil,
+			expectedErrorString: "",
+		},
 	}
 
 	for _, test := range tests {
@@ -28,6 +28,9 @@ var (
 
 	// https://github.com/scylladb/scylla-manager/blob/c599d2025d98c13fa3bc943a5456df7c527c5de3/pkg/service/backup/dclimit.go
 	backupTaskSpecOptionsDCLimitRe = regexp.MustCompile(`^(([a-zA-Z0-9\-\_\.]+):)?([0-9]+)$`)
+
+	// https://github.com/scylladb/scylla-manager/blob/c599d2025d98c13fa3bc943a5456df7c527c5de3/backupspec/snapshot.go
+	restoreTaskSpecOptionsSnapshotTagRe = regexp.MustCompile(`^sm_([0-9]{14})UTC$`)
 )
 
 var (
@@ -39,6 +42,15 @@ var (
 	supportedScyllaDBManagerTaskTypes = []scyllav1alpha1.ScyllaDBManagerTaskType{
 		scyllav1alpha1.ScyllaDBManagerTaskTypeBackup,
 		scyllav1alpha1.ScyllaDBManagerTaskTypeRepair,
+		scyllav1alpha1.ScyllaDBManagerTaskTypeRestore,
+		scyllav1alpha1.ScyllaDBManagerTaskTypeValidateBackup,
+		scyllav1alpha1.ScyllaDBManagerTaskTypeHealthcheck,
+	}
+
+	supportedScyllaDBManagerHealthcheckModes = []scyllav1alpha1.ScyllaDBManagerHealthcheckMode{
+		scyllav1alpha1.ScyllaDBManagerHealthcheckModeCQL,
+		scyllav1alpha1.ScyllaDBManagerHealthcheckModeREST,
+		scyllav1alpha1.ScyllaDBManagerHealthcheckModeAlternator,
 	}
 
 	// https://github.com/scylladb/scylla-manager/blob/c599d2025d98c13fa3bc943a5456df7c527c5de3/backupspec/location.go
@@ -54,7 +66,9 @@ type validateScyllaDBManagerTaskObjectMetaFlags struct {
 }
 
 type validateScyllaDBManagerTaskObjectMetaAnnotationsFlags struct {
+	isScyllaDBManagerTaskTypeHealthcheck              bool
 	isScyllaDBManagerTaskScheduleCronNil              bool
+	isScyllaDBManagerTaskScheduleTimezoneNil          bool
 	isScyllaDBManagerTaskRepairIntensityNil           bool
 	isScyllaDBManagerTaskRepairSmallTableThresholdNil bool
 }
@@ -93,13 +107,16 @@ func ValidateScyllaDBManagerTask(smt *scyllav1alpha1.ScyllaDBManagerTask) field.
 }
 
 func makeValidateScyllaDBManagerTaskObjectMetaFlags(smt *scyllav1alpha1.ScyllaDBManagerTask) *validateScyllaDBManagerTaskObjectMetaFlags {
-	isScheduleCronNil := (smt.Spec.Backup == nil || smt.Spec.Backup.Cron == nil) && (smt.Spec.Repair == nil || smt.Spec.Repair.Cron == nil)
+	isScheduleCronNil := (smt.Spec.Backup == nil || smt.Spec.Backup.Cron == nil) && (smt.Spec.Repair == nil || smt.Spec.Repair.Cron == nil) && (smt.Spec.ValidateBackup == nil || smt.Spec.ValidateBackup.Cron == nil)
+	isScheduleTimezoneNil := (smt.Spec.Backup == nil || smt.Spec.Backup.Timezone == nil) && (smt.Spec.Repair == nil || smt.Spec.Repair.Timezone == nil) && (smt.Spec.ValidateBackup == nil || smt.Spec.ValidateBackup.Timezone == nil)
 	isRepairIntensityNil := smt.Spec.Repair == nil || smt.Spec.Repair.Intensity == nil
 	isRepairSmallTableThresholdNil := smt.Spec.Repair == nil || smt.Spec.Repair.SmallTableThreshold == nil
 
 	return &validateScyllaDBManagerTaskObjectMetaFlags{
 		validateScyllaDBManagerTaskObjectMetaAnnotationsFlags: validateScyllaDBManagerTaskObjectMetaAnnotationsFlags{
+			isScyllaDBManagerTaskTypeHealthcheck:              smt.Spec.Type == scyllav1alpha1.ScyllaDBManagerTaskTypeHealthcheck,
 			isScyllaDBManagerTaskScheduleCronNil:              isScheduleCronNil,
+			isScyllaDBManagerTaskScheduleTimezoneNil:          isScheduleTimezoneNil,
 			isScyllaDBManagerTaskRepairIntensityNil:           isRepairIntensityNil,
 			isScyllaDBManagerTaskRepairSmallTableThresholdNil: isRepairSmallTableThresholdNil,
 		},
@@ -143,6 +160,21 @@ func validateScyllaDBManagerTaskObjectMetaAnnotations(annotations map[string]str
 		}
 	}
 
+	adoptAnnotation, hasAdoptAnnotation := annotations[naming.ScyllaDBManagerTaskAdoptAnnotation]
+	if hasAdoptAnnotation {
+		if len(adoptAnnotation) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Key(naming.ScyllaDBManagerTaskAdoptAnnotation), "must be a name of a task in ScyllaDB Manager state"))
+		}
+
+		if hasNameOverrideAnnotation {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(naming.ScyllaDBManagerTaskAdoptAnnotation), fmt.Sprintf("can't be used together with %q annotation", naming.ScyllaDBManagerTaskNameOverrideAnnotation)))
+		}
+
+		if flags.isScyllaDBManagerTaskTypeHealthcheck {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(naming.ScyllaDBManagerTaskAdoptAnnotation), "can't be used with Healthcheck tasks"))
+		}
+	}
+
 	intervalOverrideAnnotation, hasIntervalOverrideAnnotation := annotations[naming.ScyllaDBManagerTaskScheduleIntervalOverrideAnnotation]
 	// Due to backwards compatibility guarantees with v1.ScyllaCluster we can only validate the interval override annotation when cron is set.
 	if hasIntervalOverrideAnnotation && !flags.isScyllaDBManagerTaskScheduleCronNil {
@@ -164,6 +196,10 @@ func validateScyllaDBManagerTaskObjectMetaAnnotations(annotations map[string]str
 		if flags.isScyllaDBManagerTaskScheduleCronNil {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Key(naming.ScyllaDBManagerTaskScheduleTimezoneOverrideAnnotation), "can't be set when cron is not specified"))
 		}
+
+		if !flags.isScyllaDBManagerTaskScheduleTimezoneNil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Key(naming.ScyllaDBManagerTaskScheduleTimezoneOverrideAnnotation), "can't be used together with schedule's timezone"))
+		}
 	}
 
 	repairIntensityOverrideAnnotation, hasRepairIntensityOverrideAnnotation := annotations[naming.ScyllaDBManagerTaskRepairIntensityOverrideAnnotation]
@@ -208,6 +244,30 @@ func validateScyllaDBManagerTaskSpec(spec *scyllav1alpha1.ScyllaDBManagerTaskSpe
 
 		allErrs = append(allErrs, validateScyllaDBManagerRepairTaskOptions(spec.Repair, &flags.validateScyllaDBManagerRepairTaskOptionsFlags, fldPath.Child("repair"))...)
 
+	case scyllav1alpha1.ScyllaDBManagerTaskTypeRestore:
+		if spec.Restore == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("restore"), fmt.Sprintf("restore options are required when task type is %q", scyllav1alpha1.ScyllaDBManagerTaskTypeRestore)))
+			break
+		}
+
+		allErrs = append(allErrs, validateScyllaDBManagerRestoreTaskOptions(spec.Restore, fldPath.Child("restore"))...)
+
+	case scyllav1alpha1.ScyllaDBManagerTaskTypeValidateBackup:
+		if spec.ValidateBackup == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("validateBackup"), fmt.Sprintf("validate backup options are required when task type is %q", scyllav1alpha1.ScyllaDBManagerTaskTypeValidateBackup)))
+			break
+		}
+
+		allErrs = append(allErrs, validateScyllaDBManagerValidateBackupTaskOptions(spec.ValidateBackup, fldPath.Child("validateBackup"))...)
+
+	case scyllav1alpha1.ScyllaDBManagerTaskTypeHealthcheck:
+		if spec.Healthcheck == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("healthcheck"), fmt.Sprintf("healthcheck options are required when task type is %q", scyllav1alpha1.ScyllaDBManagerTaskTypeHealthcheck)))
+			break
+		}
+
+		allErrs = append(allErrs, validateScyllaDBManagerHealthcheckTaskOptions(spec.Healthcheck, fldPath.Child("healthcheck"))...)
+
 	default:
 		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), spec.Type, oslices.ConvertSlice(supportedScyllaDBManagerTaskTypes, oslices.ToString)))
 
@@ -221,6 +281,18 @@ func validateScyllaDBManagerTaskSpec(spec *scyllav1alpha1.ScyllaDBManagerTaskSpe
 		allErrs = append(allErrs, field.Forbidden(fldPath.Child("repair"), fmt.Sprintf("repair options are forbidden when task type is not %q", scyllav1alpha1.ScyllaDBManagerTaskTypeRepair)))
 	}
 
+	if spec.Type != scyllav1alpha1.ScyllaDBManagerTaskTypeRestore && spec.Restore != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("restore"), fmt.Sprintf("restore options are forbidden when task type is not %q", scyllav1alpha1.ScyllaDBManagerTaskTypeRestore)))
+	}
+
+	if spec.Type != scyllav1alpha1.ScyllaDBManagerTaskTypeValidateBackup && spec.ValidateBackup != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("validateBackup"), fmt.Sprintf("validate backup options are forbidden when task type is not %q", scyllav1alpha1.ScyllaDBManagerTaskTypeValidateBackup)))
+	}
+
+	if spec.Type != scyllav1alpha1.ScyllaDBManagerTaskTypeHealthcheck && spec.Healthcheck != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("healthcheck"), fmt.Sprintf("healthcheck options are forbidden when task type is not %q", scyllav1alpha1.ScyllaDBManagerTaskTypeHealthcheck)))
+	}
+
 	return allErrs
 }
 
@@ -312,6 +384,87 @@ func validateScyllaDBManagerRepairTaskOptions(repairOptions *scyllav1alpha1.Scyl
 	return allErrs
 }
 
+func validateScyllaDBManagerRestoreTaskOptions(restoreOptions *scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateScyllaDBManagerTaskSchedule(&restoreOptions.ScyllaDBManagerTaskSchedule, fldPath)...)
+
+	if restoreOptions.Cron != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("cron"), "restore tasks can't be recurring"))
+	}
+
+	if len(restoreOptions.Location) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("location"), "location must not be empty"))
+	} else {
+		for i := range restoreOptions.Location {
+			allErrs = append(allErrs, validateLocation(restoreOptions.Location[i], fldPath.Child("location").Index(i))...)
+		}
+	}
+
+	if len(restoreOptions.SnapshotTag) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("snapshotTag"), ""))
+	} else if !restoreTaskSpecOptionsSnapshotTagRe.MatchString(restoreOptions.SnapshotTag) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("snapshotTag"), restoreOptions.SnapshotTag, "must be in sm_<YYYYMMDDhhmmss>UTC format"))
+	}
+
+	for i := range restoreOptions.Keyspace {
+		allErrs = append(allErrs, validateKeyspaceFilter(restoreOptions.Keyspace[i], fldPath.Child("keyspace").Index(i))...)
+	}
+
+	restoreSchema := restoreOptions.RestoreSchema != nil && *restoreOptions.RestoreSchema
+	restoreTables := restoreOptions.RestoreTables != nil && *restoreOptions.RestoreTables
+	if restoreSchema == restoreTables {
+		allErrs = append(allErrs, field.Invalid(fldPath, fmt.Sprintf("restoreSchema=%t, restoreTables=%t", restoreSchema, restoreTables), "exactly one of restoreSchema and restoreTables must be enabled"))
+	}
+
+	if restoreOptions.BatchSize != nil && *restoreOptions.BatchSize < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("batchSize"), *restoreOptions.BatchSize, "can't be negative"))
+	}
+
+	if restoreOptions.Parallel != nil && *restoreOptions.Parallel < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("parallel"), *restoreOptions.Parallel, "can't be negative"))
+	}
+
+	return allErrs
+}
+
+func validateScyllaDBManagerValidateBackupTaskOptions(validateBackupOptions *scyllav1alpha1.ScyllaDBManagerValidateBackupTaskOptions, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateScyllaDBManagerTaskSchedule(&validateBackupOptions.ScyllaDBManagerTaskSchedule, fldPath)...)
+
+	if len(validateBackupOptions.Location) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("location"), "location must not be empty"))
+	} else {
+		for i := range validateBackupOptions.Location {
+			allErrs = append(allErrs, validateLocation(validateBackupOptions.Location[i], fldPath.Child("location").Index(i))...)
+		}
+	}
+
+	if validateBackupOptions.Parallel != nil && *validateBackupOptions.Parallel < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("parallel"), *validateBackupOptions.Parallel, "can't be negative"))
+	}
+
+	return allErrs
+}
+
+func validateScyllaDBManagerHealthcheckTaskOptions(healthcheckOptions *scyllav1alpha1.ScyllaDBManagerHealthcheckTaskOptions, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(healthcheckOptions.Mode) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("mode"), ""))
+	} else if !slices.Contains(supportedScyllaDBManagerHealthcheckModes, healthcheckOptions.Mode) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("mode"), healthcheckOptions.Mode, oslices.ConvertSlice(supportedScyllaDBManagerHealthcheckModes, oslices.ToString)))
+	}
+
+	// ScyllaDB Manager schedules healthchecks with a second precision.
+	if healthcheckOptions.Interval.Duration < time.Second {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("interval"), healthcheckOptions.Interval.Duration.String(), "must be at least 1s"))
+	}
+
+	return allErrs
+}
+
 func validateScyllaDBManagerTaskSchedule(schedule *scyllav1alpha1.ScyllaDBManagerTaskSchedule, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
@@ -326,6 +479,22 @@ func validateScyllaDBManagerTaskSchedule(schedule *scyllav1alpha1.ScyllaDBManage
 		}
 	}
 
+	if schedule.Timezone != nil {
+		// Empty and "Local" names are accepted by time.LoadLocation, but they don't denote an IANA time zone.
+		if len(*schedule.Timezone) == 0 || *schedule.Timezone == "Local" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timezone"), *schedule.Timezone, "must be an IANA time zone name"))
+		} else {
+			_, err := time.LoadLocation(*schedule.Timezone)
+			if err != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("timezone"), *schedule.Timezone, err.Error()))
+			}
+		}
+
+		if schedule.Cron == nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("timezone"), "can't be set when cron is not specified"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -351,6 +520,7 @@ func validateScyllaDBManagerTaskObjectMetaAnnotationsUpdate(newAnnotations, oldA
 	var allErrs field.ErrorList
 
 	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newAnnotations[naming.ScyllaDBManagerTaskNameOverrideAnnotation], oldAnnotations[naming.ScyllaDBManagerTaskNameOverrideAnnotation], fldPath.Key(naming.ScyllaDBManagerTaskNameOverrideAnnotation))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newAnnotations[naming.ScyllaDBManagerTaskAdoptAnnotation], oldAnnotations[naming.ScyllaDBManagerTaskAdoptAnnotation], fldPath.Key(naming.ScyllaDBManagerTaskAdoptAnnotation))...)
 
 	return allErrs
 }
@@ -5,6 +5,7 @@ package validation
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
@@ -83,10 +84,10 @@ func TestValidateScyllaDBManagerTask(t *testing.T) {
 					Type:     field.ErrorTypeNotSupported,
 					Field:    "spec.type",
 					BadValue: scyllav1alpha1.ScyllaDBManagerTaskType("Unsupported"),
-					Detail:   `supported values: "Backup", "Repair"`,
+					Detail:   `supported values: "Backup", "Repair", "Restore", "ValidateBackup", "Healthcheck"`,
 				},
 			},
-			expectedErrorString: `spec.type: Unsupported value: "Unsupported": supported values: "Backup", "Repair"`,
+			expectedErrorString: `spec.type: Unsupported value: "Unsupported": supported values: "Backup", "Repair", "Restore", "ValidateBackup", "Healthcheck"`,
 		},
 		{
 			name: "missing required options for repair type",
@@ -1284,6 +1285,93 @@ func TestValidateScyllaDBManagerTask(t *testing.T) {
 			},
 			expectedErrorString: `metadata.annotations[internal.scylla-operator.scylladb.com/scylladb-manager-task-name-override]: Invalid value: "invalid-with-trailing-dash-": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`,
 		},
+		{
+			name: "valid adopt annotation",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "repair",
+					Annotations: map[string]string{
+						naming.ScyllaDBManagerTaskAdoptAnnotation: "Weekly repair",
+					},
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type:   scyllav1alpha1.ScyllaDBManagerTaskTypeRepair,
+					Repair: &scyllav1alpha1.ScyllaDBManagerRepairTaskOptions{},
+				},
+			},
+			expectedErrorList:   nil,
+			expectedErrorString: ``,
+		},
+		{
+			name: "invalid empty adopt annotation with name override annotation",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "repair",
+					Annotations: map[string]string{
+						naming.ScyllaDBManagerTaskAdoptAnnotation:        "",
+						naming.ScyllaDBManagerTaskNameOverrideAnnotation: "valid-name",
+					},
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type:   scyllav1alpha1.ScyllaDBManagerTaskTypeRepair,
+					Repair: &scyllav1alpha1.ScyllaDBManagerRepairTaskOptions{},
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeRequired,
+					Field:    "metadata.annotations[scylla-operator.scylladb.com/scylladb-manager-task-adopt]",
+					BadValue: "",
+					Detail:   "must be a name of a task in ScyllaDB Manager state",
+				},
+				&field.Error{
+					Type:     field.ErrorTypeForbidden,
+					Field:    "metadata.annotations[scylla-operator.scylladb.com/scylladb-manager-task-adopt]",
+					BadValue: "",
+					Detail:   `can't be used together with "internal.scylla-operator.scylladb.com/scylladb-manager-task-name-override" annotation`,
+				},
+			},
+			expectedErrorString: `[metadata.annotations[scylla-operator.scylladb.com/scylladb-manager-task-adopt]: Required value: must be a name of a task in ScyllaDB Manager state, metadata.annotations[scylla-operator.scylladb.com/scylladb-manager-task-adopt]: Forbidden: can't be used together with "internal.scylla-operator.scylladb.com/scylladb-manager-task-name-override" annotation]`,
+		},
+		{
+			name: "invalid adopt annotation with healthcheck",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "healthcheck",
+					Annotations: map[string]string{
+						naming.ScyllaDBManagerTaskAdoptAnnotation: "cql",
+					},
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeHealthcheck,
+					Healthcheck: &scyllav1alpha1.ScyllaDBManagerHealthcheckTaskOptions{
+						Mode:     scyllav1alpha1.ScyllaDBManagerHealthcheckModeCQL,
+						Interval: metav1.Duration{Duration: 30 * time.Second},
+					},
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeForbidden,
+					Field:    "metadata.annotations[scylla-operator.scylladb.com/scylladb-manager-task-adopt]",
+					BadValue: "",
+					Detail:   "can't be used with Healthcheck tasks",
+				},
+			},
+			expectedErrorString: `metadata.annotations[scylla-operator.scylladb.com/scylladb-manager-task-adopt]: Forbidden: can't be used with Healthcheck tasks`,
+		},
 		{
 			name: "invalid interval override without cron",
 			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
@@ -1612,6 +1700,130 @@ func TestValidateScyllaDBManagerTask(t *testing.T) {
 			},
 			expectedErrorString: `metadata.annotations[internal.scylla-operator.scylladb.com/scylladb-manager-task-schedule-timezone-override]: Invalid value: "invalid": unknown time zone invalid`,
 		},
+		{
+			name: "valid timezone with repair cron",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "repair",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeRepair,
+					Repair: &scyllav1alpha1.ScyllaDBManagerRepairTaskOptions{
+						ScyllaDBManagerTaskSchedule: scyllav1alpha1.ScyllaDBManagerTaskSchedule{
+							Cron:     pointer.Ptr("0 2 * * *"),
+							Timezone: pointer.Ptr("Europe/Warsaw"),
+						},
+					},
+				},
+			},
+			expectedErrorList:   nil,
+			expectedErrorString: ``,
+		},
+		{
+			name: "invalid timezone without cron",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "backup",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeBackup,
+					Backup: &scyllav1alpha1.ScyllaDBManagerBackupTaskOptions{
+						ScyllaDBManagerTaskSchedule: scyllav1alpha1.ScyllaDBManagerTaskSchedule{
+							Timezone: pointer.Ptr("invalid"),
+						},
+						Location: []string{
+							"gcs:test",
+						},
+					},
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.backup.timezone",
+					BadValue: "invalid",
+					Detail:   "unknown time zone invalid",
+				},
+				&field.Error{
+					Type:     field.ErrorTypeForbidden,
+					Field:    "spec.backup.timezone",
+					BadValue: "",
+					Detail:   "can't be set when cron is not specified",
+				},
+			},
+			expectedErrorString: `[spec.backup.timezone: Invalid value: "invalid": unknown time zone invalid, spec.backup.timezone: Forbidden: can't be set when cron is not specified]`,
+		},
+		{
+			name: "local timezone isn't an IANA time zone name",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "repair",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeRepair,
+					Repair: &scyllav1alpha1.ScyllaDBManagerRepairTaskOptions{
+						ScyllaDBManagerTaskSchedule: scyllav1alpha1.ScyllaDBManagerTaskSchedule{
+							Cron:     pointer.Ptr("0 2 * * *"),
+							Timezone: pointer.Ptr("Local"),
+						},
+					},
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.repair.timezone",
+					BadValue: "Local",
+					Detail:   "must be an IANA time zone name",
+				},
+			},
+			expectedErrorString: `spec.repair.timezone: Invalid value: "Local": must be an IANA time zone name`,
+		},
+		{
+			name: "timezone override together with timezone",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "repair",
+					Annotations: map[string]string{
+						naming.ScyllaDBManagerTaskScheduleTimezoneOverrideAnnotation: "Europe/Warsaw",
+					},
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeRepair,
+					Repair: &scyllav1alpha1.ScyllaDBManagerRepairTaskOptions{
+						ScyllaDBManagerTaskSchedule: scyllav1alpha1.ScyllaDBManagerTaskSchedule{
+							Cron:     pointer.Ptr("0 2 * * *"),
+							Timezone: pointer.Ptr("Europe/Warsaw"),
+						},
+					},
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeForbidden,
+					Field:    "metadata.annotations[internal.scylla-operator.scylladb.com/scylladb-manager-task-schedule-timezone-override]",
+					BadValue: "",
+					Detail:   "can't be used together with schedule's timezone",
+				},
+			},
+			expectedErrorString: `metadata.annotations[internal.scylla-operator.scylladb.com/scylladb-manager-task-schedule-timezone-override]: Forbidden: can't be used together with schedule's timezone`,
+		},
 		{
 			name: "unallowed TZ in repair cron",
 			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
@@ -1842,6 +2054,325 @@ func TestValidateScyllaDBManagerTask(t *testing.T) {
 			},
 			expectedErrorString: `spec.scyllaDBClusterRef.kind: Unsupported value: "ScyllaCluster": supported values: "ScyllaDBDatacenter", "ScyllaDBCluster"`,
 		},
+		{
+			name: "valid restore",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "restore",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeRestore,
+					Restore: &scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions{
+						Location: []string{
+							"gcs:test",
+						},
+						SnapshotTag:   "sm_20250101000000UTC",
+						Keyspace:      []string{"keyspace.*"},
+						RestoreTables: pointer.Ptr(true),
+						BatchSize:     pointer.Ptr[int64](2),
+						Parallel:      pointer.Ptr[int64](0),
+					},
+				},
+			},
+			expectedErrorList:   nil,
+			expectedErrorString: ``,
+		},
+		{
+			name: "missing required options for restore type",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "restore",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeRestore,
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeRequired,
+					Field:    "spec.restore",
+					BadValue: "",
+					Detail:   `restore options are required when task type is "Restore"`,
+				},
+			},
+			expectedErrorString: `spec.restore: Required value: restore options are required when task type is "Restore"`,
+		},
+		{
+			name: "restore options for backup task type",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "backup",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeBackup,
+					Backup: &scyllav1alpha1.ScyllaDBManagerBackupTaskOptions{
+						Location: []string{
+							"gcs:test",
+						},
+					},
+					Restore: &scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions{
+						Location: []string{
+							"gcs:test",
+						},
+						SnapshotTag:   "sm_20250101000000UTC",
+						RestoreSchema: pointer.Ptr(true),
+					},
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeForbidden,
+					Field:    "spec.restore",
+					BadValue: "",
+					Detail:   `restore options are forbidden when task type is not "Restore"`,
+				},
+			},
+			expectedErrorString: `spec.restore: Forbidden: restore options are forbidden when task type is not "Restore"`,
+		},
+		{
+			name: "invalid restore",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "restore",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeRestore,
+					Restore: &scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions{
+						ScyllaDBManagerTaskSchedule: scyllav1alpha1.ScyllaDBManagerTaskSchedule{
+							Cron: pointer.Ptr("0 23 * * SAT"),
+						},
+						SnapshotTag:   "snapshot",
+						RestoreSchema: pointer.Ptr(true),
+						RestoreTables: pointer.Ptr(true),
+						BatchSize:     pointer.Ptr[int64](-1),
+						Parallel:      pointer.Ptr[int64](-1),
+					},
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeForbidden,
+					Field:    "spec.restore.cron",
+					BadValue: "",
+					Detail:   "restore tasks can't be recurring",
+				},
+				&field.Error{
+					Type:     field.ErrorTypeRequired,
+					Field:    "spec.restore.location",
+					BadValue: "",
+					Detail:   "location must not be empty",
+				},
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.restore.snapshotTag",
+					BadValue: "snapshot",
+					Detail:   "must be in sm_<YYYYMMDDhhmmss>UTC format",
+				},
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.restore",
+					BadValue: "restoreSchema=true, restoreTables=true",
+					Detail:   "exactly one of restoreSchema and restoreTables must be enabled",
+				},
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.restore.batchSize",
+					BadValue: int64(-1),
+					Detail:   "can't be negative",
+				},
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.restore.parallel",
+					BadValue: int64(-1),
+					Detail:   "can't be negative",
+				},
+			},
+			expectedErrorString: `[spec.restore.cron: Forbidden: restore tasks can't be recurring, spec.restore.location: Required value: location must not be empty, spec.restore.snapshotTag: Invalid value: "snapshot": must be in sm_<YYYYMMDDhhmmss>UTC format, spec.restore: Invalid value: "restoreSchema=true, restoreTables=true": exactly one of restoreSchema and restoreTables must be enabled, spec.restore.batchSize: Invalid value: -1: can't be negative, spec.restore.parallel: Invalid value: -1: can't be negative]`,
+		},
+		{
+			name: "valid validate backup",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "validate-backup",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeValidateBackup,
+					ValidateBackup: &scyllav1alpha1.ScyllaDBManagerValidateBackupTaskOptions{
+						ScyllaDBManagerTaskSchedule: scyllav1alpha1.ScyllaDBManagerTaskSchedule{
+							Cron: pointer.Ptr("0 23 * * SAT"),
+						},
+						Location: []string{
+							"gcs:test",
+						},
+						DeleteOrphanedFiles: pointer.Ptr(true),
+						Parallel:            pointer.Ptr[int64](0),
+					},
+				},
+			},
+			expectedErrorList:   nil,
+			expectedErrorString: ``,
+		},
+		{
+			name: "invalid validate backup",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "validate-backup",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeValidateBackup,
+					ValidateBackup: &scyllav1alpha1.ScyllaDBManagerValidateBackupTaskOptions{
+						Parallel: pointer.Ptr[int64](-1),
+					},
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeRequired,
+					Field:    "spec.validateBackup.location",
+					BadValue: "",
+					Detail:   "location must not be empty",
+				},
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.validateBackup.parallel",
+					BadValue: int64(-1),
+					Detail:   "can't be negative",
+				},
+			},
+			expectedErrorString: `[spec.validateBackup.location: Required value: location must not be empty, spec.validateBackup.parallel: Invalid value: -1: can't be negative]`,
+		},
+		{
+			name: "missing required options for validate backup type",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "validate-backup",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeValidateBackup,
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeRequired,
+					Field:    "spec.validateBackup",
+					BadValue: "",
+					Detail:   `validate backup options are required when task type is "ValidateBackup"`,
+				},
+			},
+			expectedErrorString: `spec.validateBackup: Required value: validate backup options are required when task type is "ValidateBackup"`,
+		},
+		{
+			name: "valid healthcheck",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "healthcheck",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeHealthcheck,
+					Healthcheck: &scyllav1alpha1.ScyllaDBManagerHealthcheckTaskOptions{
+						Mode:     scyllav1alpha1.ScyllaDBManagerHealthcheckModeCQL,
+						Interval: metav1.Duration{Duration: 30 * time.Second},
+					},
+				},
+			},
+			expectedErrorList:   nil,
+			expectedErrorString: ``,
+		},
+		{
+			name: "invalid healthcheck",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "healthcheck",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type: scyllav1alpha1.ScyllaDBManagerTaskTypeHealthcheck,
+					Healthcheck: &scyllav1alpha1.ScyllaDBManagerHealthcheckTaskOptions{
+						Mode:     "Unsupported",
+						Interval: metav1.Duration{Duration: 500 * time.Millisecond},
+					},
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeNotSupported,
+					Field:    "spec.healthcheck.mode",
+					BadValue: scyllav1alpha1.ScyllaDBManagerHealthcheckMode("Unsupported"),
+					Detail:   `supported values: "CQL", "REST", "Alternator"`,
+				},
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.healthcheck.interval",
+					BadValue: "500ms",
+					Detail:   "must be at least 1s",
+				},
+			},
+			expectedErrorString: `[spec.healthcheck.mode: Unsupported value: "Unsupported": supported values: "CQL", "REST", "Alternator", spec.healthcheck.interval: Invalid value: "500ms": must be at least 1s]`,
+		},
+		{
+			name: "healthcheck options for repair task type",
+			scyllaDBManagerTask: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "repair",
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type:   scyllav1alpha1.ScyllaDBManagerTaskTypeRepair,
+					Repair: &scyllav1alpha1.ScyllaDBManagerRepairTaskOptions{},
+					Healthcheck: &scyllav1alpha1.ScyllaDBManagerHealthcheckTaskOptions{
+						Mode:     scyllav1alpha1.ScyllaDBManagerHealthcheckModeREST,
+						Interval: metav1.Duration{Duration: time.Minute},
+					},
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeForbidden,
+					Field:    "spec.healthcheck",
+					BadValue: "",
+					Detail:   `healthcheck options are forbidden when task type is not "Healthcheck"`,
+				},
+			},
+			expectedErrorString: `spec.healthcheck: Forbidden: healthcheck options are forbidden when task type is not "Healthcheck"`,
+		},
 	}
 
 	for _, tc := range tt {
@@ -2030,6 +2561,50 @@ func TestValidateScyllaDBManagerTaskUpdate(t *testing.T) {
 			},
 			expectedErrorString: `metadata.annotations[internal.scylla-operator.scylladb.com/scylladb-manager-task-name-override]: Invalid value: "different-valid-name": field is immutable`,
 		},
+		{
+			name: "invalid change in immutable adopt annotation",
+			old: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "repair",
+					Annotations: map[string]string{
+						naming.ScyllaDBManagerTaskAdoptAnnotation: "Weekly repair",
+					},
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type:   scyllav1alpha1.ScyllaDBManagerTaskTypeRepair,
+					Repair: &scyllav1alpha1.ScyllaDBManagerRepairTaskOptions{},
+				},
+			},
+			new: &scyllav1alpha1.ScyllaDBManagerTask{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "repair",
+					Annotations: map[string]string{
+						naming.ScyllaDBManagerTaskAdoptAnnotation: "Daily repair",
+					},
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+						Name: "basic",
+						Kind: "ScyllaDBDatacenter",
+					},
+					Type:   scyllav1alpha1.ScyllaDBManagerTaskTypeRepair,
+					Repair: &scyllav1alpha1.ScyllaDBManagerRepairTaskOptions{},
+				},
+			},
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "metadata.annotations[scylla-operator.scylladb.com/scylladb-manager-task-adopt]",
+					BadValue: "Daily repair",
+					Detail:   "field is immutable",
+				},
+			},
+			expectedErrorString: `metadata.annotations[scylla-operator.scylladb.com/scylladb-manager-task-adopt]: Invalid value: "Daily repair": field is immutable`,
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,97 @@
+// Copyright (C) 2025 ScyllaDB
+
+package validation
+
+import (
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	apimachineryutilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func ValidateScyllaDBSnapshot(ss *scyllav1alpha1.ScyllaDBSnapshot) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, ValidateScyllaDBSnapshotSpec(&ss.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidateScyllaDBSnapshotSpec(spec *scyllav1alpha1.ScyllaDBSnapshotSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(spec.ScyllaDBDatacenterRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("scyllaDBDatacenterRef", "name"), ""))
+	} else {
+		for _, msg := range apimachineryutilvalidation.IsDNS1123Subdomain(spec.ScyllaDBDatacenterRef.Name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("scyllaDBDatacenterRef", "name"), spec.ScyllaDBDatacenterRef.Name, msg))
+		}
+	}
+
+	keyspaceNames := sets.New[string]()
+	for i, keyspace := range spec.Keyspaces {
+		allErrs = append(allErrs, validateScyllaDBSnapshotKeyspace(&keyspace, fldPath.Child("keyspaces").Index(i))...)
+
+		if keyspaceNames.Has(keyspace.Name) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("keyspaces").Index(i).Child("name"), keyspace.Name))
+		}
+		keyspaceNames.Insert(keyspace.Name)
+	}
+
+	if spec.TTL != nil && spec.TTL.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ttl"), spec.TTL.Duration.String(), "must be greater than zero"))
+	}
+
+	return allErrs
+}
+
+func validateScyllaDBSnapshotKeyspace(keyspace *scyllav1alpha1.ScyllaDBSnapshotKeyspace, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(keyspace.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), ""))
+	}
+
+	tableNames := sets.New[string]()
+	for i, table := range keyspace.Tables {
+		if len(table) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("tables").Index(i), ""))
+			continue
+		}
+
+		if tableNames.Has(table) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("tables").Index(i), table))
+		}
+		tableNames.Insert(table)
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBSnapshotUpdate(new, old *scyllav1alpha1.ScyllaDBSnapshot) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, ValidateScyllaDBSnapshot(new)...)
+	allErrs = append(allErrs, ValidateScyllaDBSnapshotSpecUpdate(&new.Spec, &old.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidateScyllaDBSnapshotSpecUpdate(newSpec, oldSpec *scyllav1alpha1.ScyllaDBSnapshotSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	// A ScyllaDBSnapshot represents a single point-in-time snapshot, hence only its retention can be changed.
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newSpec.ScyllaDBDatacenterRef.Name, oldSpec.ScyllaDBDatacenterRef.Name, fldPath.Child("scyllaDBDatacenterRef", "name"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newSpec.Keyspaces, oldSpec.Keyspaces, fldPath.Child("keyspaces"))...)
+
+	return allErrs
+}
+
+func GetWarningsOnScyllaDBSnapshotCreate(ss *scyllav1alpha1.ScyllaDBSnapshot) []string {
+	return nil
+}
+
+func GetWarningsOnScyllaDBSnapshotUpdate(new, old *scyllav1alpha1.ScyllaDBSnapshot) []string {
+	return nil
+}
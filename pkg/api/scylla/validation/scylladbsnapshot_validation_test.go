@@ -0,0 +1,272 @@
+// Copyright (C) 2025 ScyllaDB
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateScyllaDBSnapshot(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name                string
+		scyllaDBSnapshot    *scyllav1alpha1.ScyllaDBSnapshot
+		expectedErrorList   field.ErrorList
+		expectedErrorString string
+	}{
+		{
+			name:                "valid",
+			scyllaDBSnapshot:    newValidScyllaDBSnapshot(),
+			expectedErrorList:   nil,
+			expectedErrorString: ``,
+		},
+		{
+			name: "valid with keyspaces and ttl",
+			scyllaDBSnapshot: func() *scyllav1alpha1.ScyllaDBSnapshot {
+				ss := newValidScyllaDBSnapshot()
+
+				ss.Spec.Keyspaces = []scyllav1alpha1.ScyllaDBSnapshotKeyspace{
+					{
+						Name: "ks1",
+					},
+					{
+						Name:   "ks2",
+						Tables: []string{"t1", "t2"},
+					},
+				}
+				ss.Spec.TTL = &metav1.Duration{Duration: 24 * time.Hour}
+
+				return ss
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: ``,
+		},
+		{
+			name: "empty scyllaDBDatacenterRef name",
+			scyllaDBSnapshot: func() *scyllav1alpha1.ScyllaDBSnapshot {
+				ss := newValidScyllaDBSnapshot()
+
+				ss.Spec.ScyllaDBDatacenterRef.Name = ""
+
+				return ss
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeRequired,
+					Field:    "spec.scyllaDBDatacenterRef.name",
+					BadValue: ``,
+					Detail:   ``,
+				},
+			},
+			expectedErrorString: `spec.scyllaDBDatacenterRef.name: Required value`,
+		},
+		{
+			name: "invalid keyspaces",
+			scyllaDBSnapshot: func() *scyllav1alpha1.ScyllaDBSnapshot {
+				ss := newValidScyllaDBSnapshot()
+
+				ss.Spec.Keyspaces = []scyllav1alpha1.ScyllaDBSnapshotKeyspace{
+					{
+						Name:   "",
+						Tables: []string{"t1", "", "t1"},
+					},
+					{
+						Name: "ks",
+					},
+					{
+						Name: "ks",
+					},
+				}
+
+				return ss
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeRequired,
+					Field:    "spec.keyspaces[0].name",
+					BadValue: ``,
+					Detail:   ``,
+				},
+				&field.Error{
+					Type:     field.ErrorTypeRequired,
+					Field:    "spec.keyspaces[0].tables[1]",
+					BadValue: ``,
+					Detail:   ``,
+				},
+				&field.Error{
+					Type:     field.ErrorTypeDuplicate,
+					Field:    "spec.keyspaces[0].tables[2]",
+					BadValue: `t1`,
+					Detail:   ``,
+				},
+				&field.Error{
+					Type:     field.ErrorTypeDuplicate,
+					Field:    "spec.keyspaces[2].name",
+					BadValue: `ks`,
+					Detail:   ``,
+				},
+			},
+			expectedErrorString: `[spec.keyspaces[0].name: Required value, spec.keyspaces[0].tables[1]: Required value, spec.keyspaces[0].tables[2]: Duplicate value: "t1", spec.keyspaces[2].name: Duplicate value: "ks"]`,
+		},
+		{
+			name: "non-positive ttl",
+			scyllaDBSnapshot: func() *scyllav1alpha1.ScyllaDBSnapshot {
+				ss := newValidScyllaDBSnapshot()
+
+				ss.Spec.TTL = &metav1.Duration{Duration: 0}
+
+				return ss
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.ttl",
+					BadValue: `0s`,
+					Detail:   `must be greater than zero`,
+				},
+			},
+			expectedErrorString: `spec.ttl: Invalid value: "0s": must be greater than zero`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			errList := ValidateScyllaDBSnapshot(tc.scyllaDBSnapshot)
+			if !reflect.DeepEqual(errList, tc.expectedErrorList) {
+				t.Errorf("expected and actual error lists differ: %s", cmp.Diff(tc.expectedErrorList, errList))
+			}
+
+			var errStr string
+			if agg := errList.ToAggregate(); agg != nil {
+				errStr = agg.Error()
+			}
+			if !reflect.DeepEqual(errStr, tc.expectedErrorString) {
+				t.Errorf("expected and actual error strings differ: %s", cmp.Diff(tc.expectedErrorString, errStr))
+			}
+		})
+	}
+}
+
+func TestValidateScyllaDBSnapshotUpdate(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name                string
+		old                 *scyllav1alpha1.ScyllaDBSnapshot
+		new                 *scyllav1alpha1.ScyllaDBSnapshot
+		expectedErrorList   field.ErrorList
+		expectedErrorString string
+	}{
+		{
+			name:                "identity",
+			old:                 newValidScyllaDBSnapshot(),
+			new:                 newValidScyllaDBSnapshot(),
+			expectedErrorList:   nil,
+			expectedErrorString: ``,
+		},
+		{
+			name: "ttl changed",
+			old:  newValidScyllaDBSnapshot(),
+			new: func() *scyllav1alpha1.ScyllaDBSnapshot {
+				ss := newValidScyllaDBSnapshot()
+
+				ss.Spec.TTL = &metav1.Duration{Duration: time.Hour}
+
+				return ss
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: ``,
+		},
+		{
+			name: "keyspaces changed",
+			old:  newValidScyllaDBSnapshot(),
+			new: func() *scyllav1alpha1.ScyllaDBSnapshot {
+				ss := newValidScyllaDBSnapshot()
+
+				ss.Spec.Keyspaces = []scyllav1alpha1.ScyllaDBSnapshotKeyspace{
+					{
+						Name: "ks",
+					},
+				}
+
+				return ss
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:  field.ErrorTypeInvalid,
+					Field: "spec.keyspaces",
+					BadValue: []scyllav1alpha1.ScyllaDBSnapshotKeyspace{
+						{
+							Name: "ks",
+						},
+					},
+					Detail: `field is immutable`,
+				},
+			},
+			expectedErrorString: `spec.keyspaces: Invalid value: [{"name":"ks"}]: field is immutable`,
+		},
+		{
+			name: "scyllaDBDatacenterRef name changed",
+			old:  newValidScyllaDBSnapshot(),
+			new: func() *scyllav1alpha1.ScyllaDBSnapshot {
+				ss := newValidScyllaDBSnapshot()
+
+				ss.Spec.ScyllaDBDatacenterRef.Name = "other"
+
+				return ss
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.scyllaDBDatacenterRef.name",
+					BadValue: "other",
+					Detail:   `field is immutable`,
+				},
+			},
+			expectedErrorString: `spec.scyllaDBDatacenterRef.name: Invalid value: "other": field is immutable`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			errList := ValidateScyllaDBSnapshotUpdate(tc.new, tc.old)
+			if !reflect.DeepEqual(errList, tc.expectedErrorList) {
+				t.Errorf("expected and actual error lists differ: %s", cmp.Diff(tc.expectedErrorList, errList))
+			}
+
+			var errStr string
+			if agg := errList.ToAggregate(); agg != nil {
+				errStr = agg.Error()
+			}
+			if !reflect.DeepEqual(errStr, tc.expectedErrorString) {
+				t.Errorf("expected and actual error strings differ: %s", cmp.Diff(tc.expectedErrorString, errStr))
+			}
+		})
+	}
+}
+
+func newValidScyllaDBSnapshot() *scyllav1alpha1.ScyllaDBSnapshot {
+	return &scyllav1alpha1.ScyllaDBSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic-snapshot",
+			Namespace: "scylla",
+		},
+		Spec: scyllav1alpha1.ScyllaDBSnapshotSpec{
+			ScyllaDBDatacenterRef: scyllav1alpha1.LocalObjectReference{
+				Name: "basic",
+			},
+		},
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2025 ScyllaDB
+
+package validation
+
+import (
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	apimachineryutilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func ValidateScyllaDBVolumeSnapshot(svs *scyllav1alpha1.ScyllaDBVolumeSnapshot) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, ValidateScyllaDBVolumeSnapshotSpec(&svs.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidateScyllaDBVolumeSnapshotSpec(spec *scyllav1alpha1.ScyllaDBVolumeSnapshotSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(spec.ScyllaDBDatacenterRef.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("scyllaDBDatacenterRef", "name"), ""))
+	} else {
+		for _, msg := range apimachineryutilvalidation.IsDNS1123Subdomain(spec.ScyllaDBDatacenterRef.Name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("scyllaDBDatacenterRef", "name"), spec.ScyllaDBDatacenterRef.Name, msg))
+		}
+	}
+
+	if spec.VolumeSnapshotClassName != nil {
+		for _, msg := range apimachineryutilvalidation.IsDNS1123Subdomain(*spec.VolumeSnapshotClassName) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("volumeSnapshotClassName"), *spec.VolumeSnapshotClassName, msg))
+		}
+	}
+
+	return allErrs
+}
+
+func ValidateScyllaDBVolumeSnapshotUpdate(new, old *scyllav1alpha1.ScyllaDBVolumeSnapshot) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, ValidateScyllaDBVolumeSnapshot(new)...)
+	allErrs = append(allErrs, ValidateScyllaDBVolumeSnapshotSpecUpdate(&new.Spec, &old.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+func ValidateScyllaDBVolumeSnapshotSpecUpdate(newSpec, oldSpec *scyllav1alpha1.ScyllaDBVolumeSnapshotSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	// A ScyllaDBVolumeSnapshot represents a single point-in-time snapshot, hence its spec can't be changed.
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newSpec.ScyllaDBDatacenterRef.Name, oldSpec.ScyllaDBDatacenterRef.Name, fldPath.Child("scyllaDBDatacenterRef", "name"))...)
+	allErrs = append(allErrs, apimachineryvalidation.ValidateImmutableField(newSpec.VolumeSnapshotClassName, oldSpec.VolumeSnapshotClassName, fldPath.Child("volumeSnapshotClassName"))...)
+
+	return allErrs
+}
+
+func GetWarningsOnScyllaDBVolumeSnapshotCreate(svs *scyllav1alpha1.ScyllaDBVolumeSnapshot) []string {
+	return nil
+}
+
+func GetWarningsOnScyllaDBVolumeSnapshotUpdate(new, old *scyllav1alpha1.ScyllaDBVolumeSnapshot) []string {
+	return nil
+}
@@ -0,0 +1,196 @@
+// Copyright (C) 2025 ScyllaDB
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateScyllaDBVolumeSnapshot(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name                   string
+		scyllaDBVolumeSnapshot *scyllav1alpha1.ScyllaDBVolumeSnapshot
+		expectedErrorList      field.ErrorList
+		expectedErrorString    string
+	}{
+		{
+			name:                   "valid",
+			scyllaDBVolumeSnapshot: newValidScyllaDBVolumeSnapshot(),
+			expectedErrorList:      nil,
+			expectedErrorString:    ``,
+		},
+		{
+			name: "valid with volumeSnapshotClassName",
+			scyllaDBVolumeSnapshot: func() *scyllav1alpha1.ScyllaDBVolumeSnapshot {
+				svs := newValidScyllaDBVolumeSnapshot()
+
+				svs.Spec.VolumeSnapshotClassName = pointer.Ptr("csi-hostpath-snapclass")
+
+				return svs
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: ``,
+		},
+		{
+			name: "empty scyllaDBDatacenterRef name",
+			scyllaDBVolumeSnapshot: func() *scyllav1alpha1.ScyllaDBVolumeSnapshot {
+				svs := newValidScyllaDBVolumeSnapshot()
+
+				svs.Spec.ScyllaDBDatacenterRef.Name = ""
+
+				return svs
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeRequired,
+					Field:    "spec.scyllaDBDatacenterRef.name",
+					BadValue: ``,
+					Detail:   ``,
+				},
+			},
+			expectedErrorString: `spec.scyllaDBDatacenterRef.name: Required value`,
+		},
+		{
+			name: "invalid scyllaDBDatacenterRef name and volumeSnapshotClassName",
+			scyllaDBVolumeSnapshot: func() *scyllav1alpha1.ScyllaDBVolumeSnapshot {
+				svs := newValidScyllaDBVolumeSnapshot()
+
+				svs.Spec.ScyllaDBDatacenterRef.Name = "-invalid"
+				svs.Spec.VolumeSnapshotClassName = pointer.Ptr("Invalid")
+
+				return svs
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.scyllaDBDatacenterRef.name",
+					BadValue: `-invalid`,
+					Detail:   `a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`,
+				},
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.volumeSnapshotClassName",
+					BadValue: `Invalid`,
+					Detail:   `a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')`,
+				},
+			},
+			expectedErrorString: `[spec.scyllaDBDatacenterRef.name: Invalid value: "-invalid": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*'), spec.volumeSnapshotClassName: Invalid value: "Invalid": a lowercase RFC 1123 subdomain must consist of lower case alphanumeric characters, '-' or '.', and must start and end with an alphanumeric character (e.g. 'example.com', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*')]`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			errList := ValidateScyllaDBVolumeSnapshot(tc.scyllaDBVolumeSnapshot)
+			if !reflect.DeepEqual(errList, tc.expectedErrorList) {
+				t.Errorf("expected and actual error lists differ: %s", cmp.Diff(tc.expectedErrorList, errList))
+			}
+
+			var errStr string
+			if agg := errList.ToAggregate(); agg != nil {
+				errStr = agg.Error()
+			}
+			if !reflect.DeepEqual(errStr, tc.expectedErrorString) {
+				t.Errorf("expected and actual error strings differ: %s", cmp.Diff(tc.expectedErrorString, errStr))
+			}
+		})
+	}
+}
+
+func TestValidateScyllaDBVolumeSnapshotUpdate(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name                string
+		old                 *scyllav1alpha1.ScyllaDBVolumeSnapshot
+		new                 *scyllav1alpha1.ScyllaDBVolumeSnapshot
+		expectedErrorList   field.ErrorList
+		expectedErrorString string
+	}{
+		{
+			name:                "identity",
+			old:                 newValidScyllaDBVolumeSnapshot(),
+			new:                 newValidScyllaDBVolumeSnapshot(),
+			expectedErrorList:   nil,
+			expectedErrorString: ``,
+		},
+		{
+			name: "labels changed",
+			old:  newValidScyllaDBVolumeSnapshot(),
+			new: func() *scyllav1alpha1.ScyllaDBVolumeSnapshot {
+				svs := newValidScyllaDBVolumeSnapshot()
+
+				svs.Labels = map[string]string{
+					"foo": "bar",
+				}
+
+				return svs
+			}(),
+			expectedErrorList:   nil,
+			expectedErrorString: ``,
+		},
+		{
+			name: "scyllaDBDatacenterRef name changed",
+			old:  newValidScyllaDBVolumeSnapshot(),
+			new: func() *scyllav1alpha1.ScyllaDBVolumeSnapshot {
+				svs := newValidScyllaDBVolumeSnapshot()
+
+				svs.Spec.ScyllaDBDatacenterRef.Name = "other"
+
+				return svs
+			}(),
+			expectedErrorList: field.ErrorList{
+				&field.Error{
+					Type:     field.ErrorTypeInvalid,
+					Field:    "spec.scyllaDBDatacenterRef.name",
+					BadValue: "other",
+					Detail:   `field is immutable`,
+				},
+			},
+			expectedErrorString: `spec.scyllaDBDatacenterRef.name: Invalid value: "other": field is immutable`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			errList := ValidateScyllaDBVolumeSnapshotUpdate(tc.new, tc.old)
+			if !reflect.DeepEqual(errList, tc.expectedErrorList) {
+				t.Errorf("expected and actual error lists differ: %s", cmp.Diff(tc.expectedErrorList, errList))
+			}
+
+			var errStr string
+			if agg := errList.ToAggregate(); agg != nil {
+				errStr = agg.Error()
+			}
+			if !reflect.DeepEqual(errStr, tc.expectedErrorString) {
+				t.Errorf("expected and actual error strings differ: %s", cmp.Diff(tc.expectedErrorString, errStr))
+			}
+		})
+	}
+}
+
+func newValidScyllaDBVolumeSnapshot() *scyllav1alpha1.ScyllaDBVolumeSnapshot {
+	return &scyllav1alpha1.ScyllaDBVolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic-snapshot",
+			Namespace: "scylla",
+		},
+		Spec: scyllav1alpha1.ScyllaDBVolumeSnapshotSpec{
+			ScyllaDBDatacenterRef: scyllav1alpha1.LocalObjectReference{
+				Name: "basic",
+			},
+		},
+	}
+}
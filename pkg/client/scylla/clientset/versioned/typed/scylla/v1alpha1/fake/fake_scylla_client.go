@@ -48,6 +48,14 @@ func (c *FakeScyllaV1alpha1) ScyllaDBMonitorings(namespace string) v1alpha1.Scyl
 	return newFakeScyllaDBMonitorings(c, namespace)
 }
 
+func (c *FakeScyllaV1alpha1) ScyllaDBSnapshots(namespace string) v1alpha1.ScyllaDBSnapshotInterface {
+	return newFakeScyllaDBSnapshots(c, namespace)
+}
+
+func (c *FakeScyllaV1alpha1) ScyllaDBVolumeSnapshots(namespace string) v1alpha1.ScyllaDBVolumeSnapshotInterface {
+	return newFakeScyllaDBVolumeSnapshots(c, namespace)
+}
+
 func (c *FakeScyllaV1alpha1) ScyllaOperatorConfigs() v1alpha1.ScyllaOperatorConfigInterface {
 	return newFakeScyllaOperatorConfigs(c)
 }
@@ -20,4 +20,8 @@ type ScyllaDBManagerTaskExpansion interface{}
 
 type ScyllaDBMonitoringExpansion interface{}
 
+type ScyllaDBSnapshotExpansion interface{}
+
+type ScyllaDBVolumeSnapshotExpansion interface{}
+
 type ScyllaOperatorConfigExpansion interface{}
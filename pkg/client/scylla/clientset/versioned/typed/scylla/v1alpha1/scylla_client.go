@@ -21,6 +21,8 @@ type ScyllaV1alpha1Interface interface {
 	ScyllaDBManagerClusterRegistrationsGetter
 	ScyllaDBManagerTasksGetter
 	ScyllaDBMonitoringsGetter
+	ScyllaDBSnapshotsGetter
+	ScyllaDBVolumeSnapshotsGetter
 	ScyllaOperatorConfigsGetter
 }
 
@@ -65,6 +67,14 @@ func (c *ScyllaV1alpha1Client) ScyllaDBMonitorings(namespace string) ScyllaDBMon
 	return newScyllaDBMonitorings(c, namespace)
 }
 
+func (c *ScyllaV1alpha1Client) ScyllaDBSnapshots(namespace string) ScyllaDBSnapshotInterface {
+	return newScyllaDBSnapshots(c, namespace)
+}
+
+func (c *ScyllaV1alpha1Client) ScyllaDBVolumeSnapshots(namespace string) ScyllaDBVolumeSnapshotInterface {
+	return newScyllaDBVolumeSnapshots(c, namespace)
+}
+
 func (c *ScyllaV1alpha1Client) ScyllaOperatorConfigs() ScyllaOperatorConfigInterface {
 	return newScyllaOperatorConfigs(c)
 }
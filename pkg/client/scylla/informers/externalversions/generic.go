@@ -60,6 +60,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Scylla().V1alpha1().ScyllaDBManagerTasks().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("scylladbmonitorings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Scylla().V1alpha1().ScyllaDBMonitorings().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("scylladbsnapshots"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Scylla().V1alpha1().ScyllaDBSnapshots().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("scylladbvolumesnapshots"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Scylla().V1alpha1().ScyllaDBVolumeSnapshots().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("scyllaoperatorconfigs"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Scylla().V1alpha1().ScyllaOperatorConfigs().Informer()}, nil
 
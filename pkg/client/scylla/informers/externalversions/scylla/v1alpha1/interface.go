@@ -26,6 +26,10 @@ type Interface interface {
 	ScyllaDBManagerTasks() ScyllaDBManagerTaskInformer
 	// ScyllaDBMonitorings returns a ScyllaDBMonitoringInformer.
 	ScyllaDBMonitorings() ScyllaDBMonitoringInformer
+	// ScyllaDBSnapshots returns a ScyllaDBSnapshotInformer.
+	ScyllaDBSnapshots() ScyllaDBSnapshotInformer
+	// ScyllaDBVolumeSnapshots returns a ScyllaDBVolumeSnapshotInformer.
+	ScyllaDBVolumeSnapshots() ScyllaDBVolumeSnapshotInformer
 	// ScyllaOperatorConfigs returns a ScyllaOperatorConfigInformer.
 	ScyllaOperatorConfigs() ScyllaOperatorConfigInformer
 }
@@ -86,6 +90,16 @@ func (v *version) ScyllaDBMonitorings() ScyllaDBMonitoringInformer {
 	return &scyllaDBMonitoringInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ScyllaDBSnapshots returns a ScyllaDBSnapshotInformer.
+func (v *version) ScyllaDBSnapshots() ScyllaDBSnapshotInformer {
+	return &scyllaDBSnapshotInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ScyllaDBVolumeSnapshots returns a ScyllaDBVolumeSnapshotInformer.
+func (v *version) ScyllaDBVolumeSnapshots() ScyllaDBVolumeSnapshotInformer {
+	return &scyllaDBVolumeSnapshotInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // ScyllaOperatorConfigs returns a ScyllaOperatorConfigInformer.
 func (v *version) ScyllaOperatorConfigs() ScyllaOperatorConfigInformer {
 	return &scyllaOperatorConfigInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
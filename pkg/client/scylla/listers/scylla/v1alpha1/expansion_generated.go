@@ -66,6 +66,22 @@ type ScyllaDBMonitoringListerExpansion interface{}
 // ScyllaDBMonitoringNamespaceLister.
 type ScyllaDBMonitoringNamespaceListerExpansion interface{}
 
+// ScyllaDBSnapshotListerExpansion allows custom methods to be added to
+// ScyllaDBSnapshotLister.
+type ScyllaDBSnapshotListerExpansion interface{}
+
+// ScyllaDBSnapshotNamespaceListerExpansion allows custom methods to be added to
+// ScyllaDBSnapshotNamespaceLister.
+type ScyllaDBSnapshotNamespaceListerExpansion interface{}
+
+// ScyllaDBVolumeSnapshotListerExpansion allows custom methods to be added to
+// ScyllaDBVolumeSnapshotLister.
+type ScyllaDBVolumeSnapshotListerExpansion interface{}
+
+// ScyllaDBVolumeSnapshotNamespaceListerExpansion allows custom methods to be added to
+// ScyllaDBVolumeSnapshotNamespaceLister.
+type ScyllaDBVolumeSnapshotNamespaceListerExpansion interface{}
+
 // ScyllaOperatorConfigListerExpansion allows custom methods to be added to
 // ScyllaOperatorConfigLister.
 type ScyllaOperatorConfigListerExpansion interface{}
@@ -41,11 +41,15 @@ func NewOperatorCommand(streams genericclioptions.IOStreams) *cobra.Command {
 	cmd.AddCommand(sidecar.NewCmd(streams))
 	cmd.AddCommand(NewNodeSetupCmd(streams))
 	cmd.AddCommand(NewCleanupJobCmd(streams))
+	cmd.AddCommand(NewUpgradeSSTablesJobCmd(streams))
+	cmd.AddCommand(NewRemoveNodeJobCmd(streams))
+	cmd.AddCommand(NewRebuildJobCmd(streams))
 	cmd.AddCommand(NewMustGatherCmd(streams))
 	cmd.AddCommand(probeserver.NewServeProbesCmd(streams))
 	cmd.AddCommand(NewIgnitionCmd(streams))
 	cmd.AddCommand(NewRlimitsJobCmd(streams))
 	cmd.AddCommand(bootstrapbarrier.NewCmd(streams))
+	cmd.AddCommand(NewGenerateScyllaDBManagerTasksCmd(streams))
 
 	// TODO: wrap help func for the root command and every subcommand to add a line about automatic env vars and the prefix.
 
@@ -0,0 +1,183 @@
+// Copyright (C) 2025 ScyllaDB
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/cmdutil"
+	"github.com/scylladb/scylla-operator/pkg/controller/scylladbmanagertask"
+	"github.com/scylladb/scylla-operator/pkg/genericclioptions"
+	"github.com/scylladb/scylla-operator/pkg/helpers/managerclienterrors"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/signals"
+	"github.com/spf13/cobra"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+type GenerateScyllaDBManagerTasksOptions struct {
+	ScyllaDBManagerURL       string
+	ScyllaDBManagerClusterID string
+	Namespace                string
+	ScyllaDBClusterRefKind   string
+	ScyllaDBClusterRefName   string
+}
+
+func NewGenerateScyllaDBManagerTasksOptions(streams genericclioptions.IOStreams) *GenerateScyllaDBManagerTasksOptions {
+	return &GenerateScyllaDBManagerTasksOptions{
+		ScyllaDBManagerURL:     fmt.Sprintf("http://%s.%s.svc/api/v1", naming.ScyllaManagerServiceName, naming.ScyllaManagerNamespace),
+		ScyllaDBClusterRefKind: scyllav1alpha1.ScyllaDBDatacenterGVK.Kind,
+	}
+}
+
+func NewGenerateScyllaDBManagerTasksCmd(streams genericclioptions.IOStreams) *cobra.Command {
+	o := NewGenerateScyllaDBManagerTasksOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "generate-scylladb-manager-tasks",
+		Short: "Generates ScyllaDBManagerTask manifests adopting tasks registered in ScyllaDB Manager.",
+		Long: `Generates ScyllaDBManagerTask manifests adopting tasks registered in ScyllaDB Manager.
+
+The manifests are printed to the standard output. Once applied, each ScyllaDBManagerTask takes over
+the matching task in ScyllaDB Manager state instead of creating a new one. Tasks already owned by ScyllaDBManagerTasks,
+disabled tasks, healthcheck tasks and restore tasks are skipped.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := o.Validate()
+			if err != nil {
+				return err
+			}
+
+			err = o.Complete()
+			if err != nil {
+				return err
+			}
+
+			err = o.Run(streams, cmd)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+
+	cmd.Flags().StringVarP(&o.ScyllaDBManagerURL, "scylladb-manager-url", "", o.ScyllaDBManagerURL, "URL of ScyllaDB Manager API.")
+	cmd.Flags().StringVarP(&o.ScyllaDBManagerClusterID, "scylladb-manager-cluster-id", "", o.ScyllaDBManagerClusterID, "ID of the cluster in ScyllaDB Manager state to generate the tasks for.")
+	cmd.Flags().StringVarP(&o.Namespace, "namespace", "", o.Namespace, "Namespace of the generated ScyllaDBManagerTasks.")
+	cmd.Flags().StringVarP(&o.ScyllaDBClusterRefKind, "scylladb-cluster-ref-kind", "", o.ScyllaDBClusterRefKind, "Kind of the ScyllaDB cluster referenced by the generated ScyllaDBManagerTasks.")
+	cmd.Flags().StringVarP(&o.ScyllaDBClusterRefName, "scylladb-cluster-ref-name", "", o.ScyllaDBClusterRefName, "Name of the ScyllaDB cluster referenced by the generated ScyllaDBManagerTasks.")
+
+	return cmd
+}
+
+func (o *GenerateScyllaDBManagerTasksOptions) Validate() error {
+	var errs []error
+
+	if len(o.ScyllaDBManagerURL) == 0 {
+		errs = append(errs, fmt.Errorf("scylladb-manager-url can't be empty"))
+	}
+
+	if len(o.ScyllaDBManagerClusterID) == 0 {
+		errs = append(errs, fmt.Errorf("scylladb-manager-cluster-id can't be empty"))
+	}
+
+	if len(o.Namespace) == 0 {
+		errs = append(errs, fmt.Errorf("namespace can't be empty"))
+	} else {
+		for _, msg := range apimachineryvalidation.IsDNS1123Label(o.Namespace) {
+			errs = append(errs, fmt.Errorf("invalid namespace %q: %s", o.Namespace, msg))
+		}
+	}
+
+	switch o.ScyllaDBClusterRefKind {
+	case scyllav1alpha1.ScyllaDBDatacenterGVK.Kind, scyllav1alpha1.ScyllaDBClusterGVK.Kind:
+	default:
+		errs = append(errs, fmt.Errorf("unsupported scylladb-cluster-ref-kind %q", o.ScyllaDBClusterRefKind))
+	}
+
+	if len(o.ScyllaDBClusterRefName) == 0 {
+		errs = append(errs, fmt.Errorf("scylladb-cluster-ref-name can't be empty"))
+	}
+
+	return apimachineryutilerrors.NewAggregate(errs)
+}
+
+func (o *GenerateScyllaDBManagerTasksOptions) Complete() error {
+	return nil
+}
+
+func (o *GenerateScyllaDBManagerTasksOptions) Run(streams genericclioptions.IOStreams, cmd *cobra.Command) error {
+	cmdutil.LogCommandStarting(cmd)
+
+	stopCh := signals.StopChannel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	managerClient, err := managerclient.NewClient(o.ScyllaDBManagerURL, func(httpClient *http.Client) {
+		httpClient.Timeout = 15 * time.Second
+	})
+	if err != nil {
+		return fmt.Errorf("can't build ScyllaDB Manager client: %w", err)
+	}
+
+	// Only enabled tasks are listed.
+	tasks, err := managerClient.ListTasks(ctx, o.ScyllaDBManagerClusterID, "", false, "", "")
+	if err != nil {
+		return fmt.Errorf("can't list ScyllaDB Manager client tasks: %s", managerclienterrors.GetPayloadMessage(err))
+	}
+
+	scyllaDBClusterRef := scyllav1alpha1.LocalScyllaDBReference{
+		Kind: o.ScyllaDBClusterRefKind,
+		Name: o.ScyllaDBClusterRefName,
+	}
+
+	var smts []*scyllav1alpha1.ScyllaDBManagerTask
+	for _, managerTask := range tasks.TaskListItemSlice {
+		if !scylladbmanagertask.IsAdoptableScyllaDBManagerClientTask(managerTask) {
+			klog.V(2).InfoS("Skipping ScyllaDB Manager client task that can't be adopted.", "ScyllaDBManagerClientTaskType", managerTask.Type, "ScyllaDBManagerClientTaskName", managerTask.Name, "ScyllaDBManagerClientTaskID", managerTask.ID)
+			continue
+		}
+
+		smt, err := scylladbmanagertask.MakeAdoptingScyllaDBManagerTask(o.Namespace, scyllaDBClusterRef, managerTask)
+		if err != nil {
+			return fmt.Errorf("can't make ScyllaDBManagerTask for ScyllaDB Manager client task %q (%s): %w", managerTask.Name, managerTask.ID, err)
+		}
+
+		smts = append(smts, smt)
+	}
+
+	return printScyllaDBManagerTasks(streams.Out, smts)
+}
+
+func printScyllaDBManagerTasks(w io.Writer, smts []*scyllav1alpha1.ScyllaDBManagerTask) error {
+	for _, smt := range smts {
+		data, err := yaml.Marshal(smt)
+		if err != nil {
+			return fmt.Errorf("can't marshal ScyllaDBManagerTask %q: %w", naming.ObjRef(smt), err)
+		}
+
+		_, err = fmt.Fprintf(w, "---\n%s", data)
+		if err != nil {
+			return fmt.Errorf("can't write ScyllaDBManagerTask %q: %w", naming.ObjRef(smt), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+// Copyright (C) 2025 ScyllaDB
+
+package operator
+
+import (
+	"testing"
+
+	"github.com/scylladb/scylla-operator/pkg/genericclioptions"
+)
+
+func TestGenerateScyllaDBManagerTasksOptions_Validate(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name          string
+		modifyOptions func(*GenerateScyllaDBManagerTasksOptions)
+		expectedErr   string
+	}{
+		{
+			name:          "valid options",
+			modifyOptions: func(o *GenerateScyllaDBManagerTasksOptions) {},
+			expectedErr:   "",
+		},
+		{
+			name: "missing cluster ID and ScyllaDB cluster name",
+			modifyOptions: func(o *GenerateScyllaDBManagerTasksOptions) {
+				o.ScyllaDBManagerClusterID = ""
+				o.ScyllaDBClusterRefName = ""
+			},
+			expectedErr: "[scylladb-manager-cluster-id can't be empty, scylladb-cluster-ref-name can't be empty]",
+		},
+		{
+			name: "invalid namespace and unsupported kind",
+			modifyOptions: func(o *GenerateScyllaDBManagerTasksOptions) {
+				o.Namespace = "Invalid"
+				o.ScyllaDBClusterRefKind = "ScyllaCluster"
+			},
+			expectedErr: `[invalid namespace "Invalid": a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', and must start and end with an alphanumeric character (e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?'), unsupported scylladb-cluster-ref-kind "ScyllaCluster"]`,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			o := NewGenerateScyllaDBManagerTasksOptions(genericclioptions.IOStreams{})
+			o.ScyllaDBManagerClusterID = "cluster-id"
+			o.Namespace = "scylla"
+			o.ScyllaDBClusterRefName = "basic"
+			tc.modifyOptions(o)
+
+			var errStr string
+			err := o.Validate()
+			if err != nil {
+				errStr = err.Error()
+			}
+
+			if errStr != tc.expectedErr {
+				t.Errorf("expected error %q, got %q", tc.expectedErr, errStr)
+			}
+		})
+	}
+}
@@ -29,6 +29,8 @@ import (
 	"github.com/scylladb/scylla-operator/pkg/controller/scylladbmanagerclusterregistration"
 	"github.com/scylladb/scylla-operator/pkg/controller/scylladbmanagertask"
 	"github.com/scylladb/scylla-operator/pkg/controller/scylladbmonitoring"
+	"github.com/scylladb/scylla-operator/pkg/controller/scylladbsnapshot"
+	"github.com/scylladb/scylla-operator/pkg/controller/scylladbvolumesnapshot"
 	"github.com/scylladb/scylla-operator/pkg/controller/scyllaoperatorconfig"
 	"github.com/scylladb/scylla-operator/pkg/crypto"
 	"github.com/scylladb/scylla-operator/pkg/genericclioptions"
@@ -48,6 +50,7 @@ import (
 	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
 	apimachineryutilvalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
@@ -72,6 +75,7 @@ type OperatorOptions struct {
 	kubeClient                 kubernetes.Interface
 	scyllaClient               scyllaversionedclient.Interface
 	monitoringClient           monitoringversionedclient.Interface
+	dynamicClient              dynamic.Interface
 	dynamicClusterDomainGetter *clusterdomain.DynamicClusterDomain
 
 	clusterKubeClient   remoteclient.ClusterClient[kubernetes.Interface]
@@ -231,6 +235,11 @@ func (o *OperatorOptions) Complete(cmd *cobra.Command) error {
 		return fmt.Errorf("can't build monitoring clientset: %w", err)
 	}
 
+	o.dynamicClient, err = dynamic.NewForConfig(o.RestConfig)
+	if err != nil {
+		return fmt.Errorf("can't build dynamic client: %w", err)
+	}
+
 	o.dynamicClusterDomainGetter = clusterdomain.NewDynamicClusterDomain(net.DefaultResolver)
 
 	o.clusterKubeClient = *remoteclient.NewClusterClient(func(config []byte) (kubernetes.Interface, error) {
@@ -366,6 +375,8 @@ func (o *OperatorOptions) run(ctx context.Context, streams genericclioptions.IOS
 		o.kubeClient,
 		o.scyllaClient.ScyllaV1alpha1(),
 		kubeInformers.Core().V1().Pods(),
+		kubeInformers.Core().V1().PersistentVolumeClaims(),
+		kubeInformers.Storage().V1().StorageClasses(),
 		kubeInformers.Core().V1().Services(),
 		kubeInformers.Core().V1().Secrets(),
 		kubeInformers.Core().V1().ConfigMaps(),
@@ -377,6 +388,8 @@ func (o *OperatorOptions) run(ctx context.Context, streams genericclioptions.IOS
 		kubeInformers.Batch().V1().Jobs(),
 		scyllaInformers.Scylla().V1alpha1().ScyllaDBDatacenters(),
 		scyllaInformers.Scylla().V1alpha1().ScyllaDBDatacenterNodesStatusReports(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBVolumeSnapshots(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBManagerTasks(),
 		o.OperatorImage,
 		o.CQLSIngressPort,
 		rsaKeyGenerator,
@@ -514,6 +527,8 @@ func (o *OperatorOptions) run(ctx context.Context, streams genericclioptions.IOS
 		kubeInformers.Core().V1().Services(),
 		kubeInformers.Discovery().V1().EndpointSlices(),
 		kubeInformers.Core().V1().Endpoints(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBManagerTasks(),
+		scyllaInformers.Scylla().V1alpha1().RemoteKubernetesClusters(),
 		remoteScyllaInformer.ForResource(&scyllav1alpha1.RemoteOwner{}, remoteinformers.ClusterListWatch[scyllaversionedclient.Interface]{
 			ListFunc: func(client remoteclient.ClusterClientInterface[scyllaversionedclient.Interface], cluster, ns string) cache.ListFunc {
 				return func(options metav1.ListOptions) (runtime.Object, error) {
@@ -754,6 +769,33 @@ func (o *OperatorOptions) run(ctx context.Context, streams genericclioptions.IOS
 		return fmt.Errorf("can't create ScyllaDBManagerTask controller: %w", err)
 	}
 
+	svsc, err := scylladbvolumesnapshot.NewController(
+		o.kubeClient,
+		o.scyllaClient.ScyllaV1alpha1(),
+		o.dynamicClient,
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBVolumeSnapshots(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBDatacenters(),
+		kubeInformers.Core().V1().Services(),
+		kubeInformers.Core().V1().Pods(),
+		kubeInformers.Core().V1().Secrets(),
+	)
+	if err != nil {
+		return fmt.Errorf("can't create ScyllaDBVolumeSnapshot controller: %w", err)
+	}
+
+	ssc, err := scylladbsnapshot.NewController(
+		o.kubeClient,
+		o.scyllaClient.ScyllaV1alpha1(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBSnapshots(),
+		scyllaInformers.Scylla().V1alpha1().ScyllaDBDatacenters(),
+		kubeInformers.Core().V1().Services(),
+		kubeInformers.Core().V1().Pods(),
+		kubeInformers.Core().V1().Secrets(),
+	)
+	if err != nil {
+		return fmt.Errorf("can't create ScyllaDBSnapshot controller: %w", err)
+	}
+
 	var wg sync.WaitGroup
 	defer wg.Wait()
 
@@ -883,6 +925,18 @@ func (o *OperatorOptions) run(ctx context.Context, streams genericclioptions.IOS
 		smtc.Run(ctx, o.ConcurrentSyncs)
 	}()
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		svsc.Run(ctx, o.ConcurrentSyncs)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ssc.Run(ctx, o.ConcurrentSyncs)
+	}()
+
 	<-ctx.Done()
 
 	return nil
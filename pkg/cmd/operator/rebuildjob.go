@@ -0,0 +1,270 @@
+// Copyright (C) 2025 ScyllaDB
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gocql/gocql"
+	"github.com/scylladb/scylla-operator/pkg/cmdutil"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/genericclioptions"
+	"github.com/scylladb/scylla-operator/pkg/helpers"
+	"github.com/scylladb/scylla-operator/pkg/scylla"
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+	"github.com/scylladb/scylla-operator/pkg/signals"
+	"github.com/spf13/cobra"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	cliflag "k8s.io/component-base/cli/flag"
+	"k8s.io/klog/v2"
+)
+
+const (
+	cqlTimeout = 30 * time.Second
+)
+
+type RebuildJobOptions struct {
+	ManagerAuthConfigPath    string
+	NodeAddress              string
+	SourceDatacenter         string
+	AlterKeyspaceReplication bool
+	Datacenter               string
+	TerminationMessagePath   string
+
+	scyllaClient *scyllaclient.Client
+}
+
+func NewRebuildJobOptions(streams genericclioptions.IOStreams) *RebuildJobOptions {
+	return &RebuildJobOptions{}
+}
+
+func NewRebuildJobCmd(streams genericclioptions.IOStreams) *cobra.Command {
+	o := NewRebuildJobOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "rebuild-job",
+		Short: "Streams data to a node from another datacenter.",
+		Long:  "Streams data to a node from another datacenter, optionally altering replication of keyspaces to include the datacenter of the node first.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := o.Validate()
+			if err != nil {
+				return err
+			}
+
+			err = o.Complete()
+			if err != nil {
+				return err
+			}
+
+			err = o.Run(streams, cmd)
+			if err != nil {
+				o.writeTerminationMessage(err)
+				return err
+			}
+
+			return nil
+		},
+
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+
+	cmd.Flags().StringVarP(&o.ManagerAuthConfigPath, "manager-auth-config-path", "", o.ManagerAuthConfigPath, "Path to a file containing Scylla Manager config containing auth token.")
+	cmd.Flags().StringVarP(&o.NodeAddress, "node-address", "", o.NodeAddress, "Address of the node to rebuild.")
+	cmd.Flags().StringVarP(&o.SourceDatacenter, "source-datacenter", "", o.SourceDatacenter, "Name of the datacenter to stream the data from.")
+	cmd.Flags().BoolVarP(&o.AlterKeyspaceReplication, "alter-keyspace-replication", "", o.AlterKeyspaceReplication, "Alter keyspaces replicated to the source datacenter to be replicated to the datacenter of the node before rebuilding it. Requires ScyllaDB to accept unencrypted CQL connections without credentials.")
+	cmd.Flags().StringVarP(&o.Datacenter, "datacenter", "", o.Datacenter, "Name of the datacenter of the node. Required when altering keyspace replication.")
+	cmd.Flags().StringVarP(&o.TerminationMessagePath, "termination-message-path", "", o.TerminationMessagePath, "Path to write the error message to when the rebuild fails.")
+
+	return cmd
+}
+
+func (o *RebuildJobOptions) Validate() error {
+	var errs []error
+
+	if len(o.ManagerAuthConfigPath) == 0 {
+		errs = append(errs, fmt.Errorf("manager-auth-config-path cannot be empty"))
+	}
+
+	if len(o.NodeAddress) == 0 {
+		errs = append(errs, fmt.Errorf("node-address cannot be empty"))
+	}
+
+	if len(o.SourceDatacenter) == 0 {
+		errs = append(errs, fmt.Errorf("source-datacenter cannot be empty"))
+	}
+
+	if o.AlterKeyspaceReplication && len(o.Datacenter) == 0 {
+		errs = append(errs, fmt.Errorf("datacenter cannot be empty when altering keyspace replication"))
+	}
+
+	return apimachineryutilerrors.NewAggregate(errs)
+}
+
+func (o *RebuildJobOptions) Complete() error {
+	var err error
+
+	buf, err := os.ReadFile(o.ManagerAuthConfigPath)
+	if err != nil {
+		return fmt.Errorf("can't read auth token file at %q: %w", o.ManagerAuthConfigPath, err)
+	}
+
+	authToken, err := helpers.ParseTokenFromConfig(buf)
+	if err != nil {
+		return fmt.Errorf("can't parse auth token file at %q: %w", o.ManagerAuthConfigPath, err)
+	}
+
+	if len(authToken) == 0 {
+		return fmt.Errorf("manager agent auth token cannot be empty")
+	}
+
+	o.scyllaClient, err = controllerhelpers.NewScyllaClientFromToken([]string{o.NodeAddress}, authToken)
+	if err != nil {
+		return fmt.Errorf("can't create scylla client: %w", err)
+	}
+
+	return nil
+}
+
+// writeTerminationMessage writes the error to the termination message path, so the reason of the failure
+// is reported in the status of the Pod.
+func (o *RebuildJobOptions) writeTerminationMessage(err error) {
+	if len(o.TerminationMessagePath) == 0 {
+		return
+	}
+
+	writeErr := os.WriteFile(o.TerminationMessagePath, []byte(err.Error()), 0644)
+	if writeErr != nil {
+		klog.ErrorS(writeErr, "Can't write termination message", "Path", o.TerminationMessagePath)
+	}
+}
+
+func (o *RebuildJobOptions) Run(streams genericclioptions.IOStreams, cmd *cobra.Command) error {
+	cmdutil.LogCommandStarting(cmd)
+
+	defer func(startTime time.Time) {
+		klog.InfoS("Rebuild completed", "duration", time.Since(startTime))
+	}(time.Now())
+
+	cliflag.PrintFlags(cmd.Flags())
+
+	stopCh := signals.StopChannel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	if o.AlterKeyspaceReplication {
+		err := o.alterKeyspaceReplication(ctx)
+		if err != nil {
+			return fmt.Errorf("can't alter keyspace replication: %w", err)
+		}
+	}
+
+	klog.InfoS("Rebuilding node", "SourceDatacenter", o.SourceDatacenter)
+	err := o.scyllaClient.Rebuild(ctx, o.NodeAddress, o.SourceDatacenter)
+	if err != nil {
+		return fmt.Errorf("can't rebuild node from datacenter %q: %w", o.SourceDatacenter, err)
+	}
+
+	klog.InfoS("Node rebuilt successfully", "SourceDatacenter", o.SourceDatacenter)
+
+	return nil
+}
+
+// alterKeyspaceReplication extends replication of keyspaces replicated to the source datacenter to the datacenter of the node.
+// Keyspaces that were already altered, e.g. by a rebuild of another node, are left untouched.
+// The keyspaces are altered over the unencrypted CQL port without credentials, which fails when ScyllaDB requires them.
+func (o *RebuildJobOptions) alterKeyspaceReplication(ctx context.Context) error {
+	cluster := gocql.NewCluster(o.NodeAddress)
+	cluster.Port = scylla.DefaultNativeTransportPort
+	cluster.Timeout = cqlTimeout
+	cluster.ConnectTimeout = cqlTimeout
+	cluster.HostFilter = gocql.WhiteListHostFilter(o.NodeAddress)
+
+	session, err := cluster.CreateSession()
+	if err != nil {
+		return fmt.Errorf("can't create CQL session, keyspace replication can only be altered when ScyllaDB doesn't require authentication or client encryption: %w", err)
+	}
+	defer session.Close()
+
+	keyspaceReplications := map[string]map[string]string{}
+	var keyspace string
+	var replication map[string]string
+	iter := session.Query("SELECT keyspace_name, replication FROM system_schema.keyspaces").WithContext(ctx).Iter()
+	for iter.Scan(&keyspace, &replication) {
+		keyspaceReplications[keyspace] = replication
+		replication = nil
+	}
+	err = iter.Close()
+	if err != nil {
+		return fmt.Errorf("can't get keyspace replication: %w", err)
+	}
+
+	for _, keyspace := range slices.Sorted(maps.Keys(keyspaceReplications)) {
+		alteredReplication, ok := makeKeyspaceReplicationWithDatacenter(keyspaceReplications[keyspace], o.SourceDatacenter, o.Datacenter)
+		if !ok {
+			continue
+		}
+
+		klog.InfoS("Altering keyspace replication", "Keyspace", keyspace, "Datacenter", o.Datacenter, "ReplicationFactor", alteredReplication[o.Datacenter])
+		err = session.Query(makeAlterKeyspaceReplicationStatement(keyspace, alteredReplication)).WithContext(ctx).Exec()
+		if err != nil {
+			return fmt.Errorf("can't alter replication of keyspace %q: %w", keyspace, err)
+		}
+	}
+
+	return nil
+}
+
+// makeKeyspaceReplicationWithDatacenter returns the replication options of a keyspace extended to the datacenter
+// with the replication factor of the source datacenter.
+// It returns false when the keyspace doesn't use NetworkTopologyStrategy, isn't replicated to the source datacenter
+// or already specifies a replication factor for the datacenter.
+func makeKeyspaceReplicationWithDatacenter(replication map[string]string, sourceDatacenter, datacenter string) (map[string]string, bool) {
+	if !strings.HasSuffix(replication["class"], "NetworkTopologyStrategy") {
+		return nil, false
+	}
+
+	rf, ok := replication[sourceDatacenter]
+	if !ok || rf == "0" {
+		return nil, false
+	}
+
+	_, ok = replication[datacenter]
+	if ok {
+		return nil, false
+	}
+
+	alteredReplication := maps.Clone(replication)
+	alteredReplication[datacenter] = rf
+
+	return alteredReplication, true
+}
+
+func quoteCQLIdentifier(identifier string) string {
+	return `"` + strings.ReplaceAll(identifier, `"`, `""`) + `"`
+}
+
+func quoteCQLString(s string) string {
+	return `'` + strings.ReplaceAll(s, `'`, `''`) + `'`
+}
+
+// makeAlterKeyspaceReplicationStatement returns the CQL statement setting replication of the keyspace.
+func makeAlterKeyspaceReplicationStatement(keyspace string, replication map[string]string) string {
+	options := make([]string, 0, len(replication))
+	for _, k := range slices.Sorted(maps.Keys(replication)) {
+		options = append(options, fmt.Sprintf("%s: %s", quoteCQLString(k), quoteCQLString(replication[k])))
+	}
+
+	return fmt.Sprintf("ALTER KEYSPACE %s WITH replication = {%s}", quoteCQLIdentifier(keyspace), strings.Join(options, ", "))
+}
@@ -0,0 +1,102 @@
+// Copyright (C) 2025 ScyllaDB
+
+package operator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMakeKeyspaceReplicationWithDatacenter(t *testing.T) {
+	t.Parallel()
+
+	const networkTopologyStrategy = "org.apache.cassandra.locator.NetworkTopologyStrategy"
+
+	tt := []struct {
+		name                string
+		replication         map[string]string
+		expectedReplication map[string]string
+		expectedOK          bool
+	}{
+		{
+			name: "local keyspace isn't altered",
+			replication: map[string]string{
+				"class": "org.apache.cassandra.locator.LocalStrategy",
+			},
+			expectedReplication: nil,
+			expectedOK:          false,
+		},
+		{
+			name: "keyspace using SimpleStrategy isn't altered",
+			replication: map[string]string{
+				"class":              "org.apache.cassandra.locator.SimpleStrategy",
+				"replication_factor": "3",
+			},
+			expectedReplication: nil,
+			expectedOK:          false,
+		},
+		{
+			name: "keyspace not replicated to the source datacenter isn't altered",
+			replication: map[string]string{
+				"class": networkTopologyStrategy,
+				"dc1":   "0",
+				"dc2":   "3",
+			},
+			expectedReplication: nil,
+			expectedOK:          false,
+		},
+		{
+			name: "keyspace already replicated to the datacenter isn't altered",
+			replication: map[string]string{
+				"class": networkTopologyStrategy,
+				"dc1":   "3",
+				"dc3":   "1",
+			},
+			expectedReplication: nil,
+			expectedOK:          false,
+		},
+		{
+			name: "keyspace is replicated to the datacenter with replication factor of the source datacenter",
+			replication: map[string]string{
+				"class": networkTopologyStrategy,
+				"dc1":   "3",
+				"dc2":   "2",
+			},
+			expectedReplication: map[string]string{
+				"class": networkTopologyStrategy,
+				"dc1":   "3",
+				"dc2":   "2",
+				"dc3":   "3",
+			},
+			expectedOK: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			replication, ok := makeKeyspaceReplicationWithDatacenter(tc.replication, "dc1", "dc3")
+			if ok != tc.expectedOK {
+				t.Errorf("expected %v, got %v", tc.expectedOK, ok)
+			}
+			if !reflect.DeepEqual(replication, tc.expectedReplication) {
+				t.Errorf("expected replication %v, got %v", tc.expectedReplication, replication)
+			}
+		})
+	}
+}
+
+func TestMakeAlterKeyspaceReplicationStatement(t *testing.T) {
+	t.Parallel()
+
+	got := makeAlterKeyspaceReplicationStatement(`my"ks`, map[string]string{
+		"dc1":   "3",
+		"class": "org.apache.cassandra.locator.NetworkTopologyStrategy",
+		"d'c2":  "1",
+	})
+	expected := `ALTER KEYSPACE "my""ks" WITH replication = {'class': 'org.apache.cassandra.locator.NetworkTopologyStrategy', 'd''c2': '1', 'dc1': '3'}`
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (C) 2025 ScyllaDB
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/scylladb/scylla-operator/pkg/cmdutil"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/genericclioptions"
+	"github.com/scylladb/scylla-operator/pkg/helpers"
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+	"github.com/scylladb/scylla-operator/pkg/signals"
+	"github.com/spf13/cobra"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	cliflag "k8s.io/component-base/cli/flag"
+	"k8s.io/klog/v2"
+)
+
+type RemoveNodeJobOptions struct {
+	ManagerAuthConfigPath string
+	NodeAddress           string
+	HostID                string
+
+	scyllaClient *scyllaclient.Client
+}
+
+func NewRemoveNodeJobOptions(streams genericclioptions.IOStreams) *RemoveNodeJobOptions {
+	return &RemoveNodeJobOptions{}
+}
+
+func NewRemoveNodeJobCmd(streams genericclioptions.IOStreams) *cobra.Command {
+	o := NewRemoveNodeJobOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "removenode-job",
+		Short: "Removes an unreachable node from the cluster.",
+		Long:  "Removes an unreachable node from the cluster.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := o.Validate()
+			if err != nil {
+				return err
+			}
+
+			err = o.Complete()
+			if err != nil {
+				return err
+			}
+
+			err = o.Run(streams, cmd)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+
+	cmd.Flags().StringVarP(&o.ManagerAuthConfigPath, "manager-auth-config-path", "", o.ManagerAuthConfigPath, "Path to a file containing Scylla Manager config containing auth token.")
+	cmd.Flags().StringVarP(&o.NodeAddress, "node-address", "", o.NodeAddress, "Address of a live node coordinating the node removal.")
+	cmd.Flags().StringVarP(&o.HostID, "host-id", "", o.HostID, "Host ID of the node to remove.")
+
+	return cmd
+}
+
+func (o *RemoveNodeJobOptions) Validate() error {
+	var errs []error
+
+	if len(o.ManagerAuthConfigPath) == 0 {
+		errs = append(errs, fmt.Errorf("manager-auth-config-path cannot be empty"))
+	}
+
+	if len(o.NodeAddress) == 0 {
+		errs = append(errs, fmt.Errorf("node-address cannot be empty"))
+	}
+
+	if len(o.HostID) == 0 {
+		errs = append(errs, fmt.Errorf("host-id cannot be empty"))
+	}
+
+	return apimachineryutilerrors.NewAggregate(errs)
+}
+
+func (o *RemoveNodeJobOptions) Complete() error {
+	var err error
+
+	buf, err := os.ReadFile(o.ManagerAuthConfigPath)
+	if err != nil {
+		return fmt.Errorf("can't read auth token file at %q: %w", o.ManagerAuthConfigPath, err)
+	}
+
+	authToken, err := helpers.ParseTokenFromConfig(buf)
+	if err != nil {
+		return fmt.Errorf("can't parse auth token file at %q: %w", o.ManagerAuthConfigPath, err)
+	}
+
+	if len(authToken) == 0 {
+		return fmt.Errorf("manager agent auth token cannot be empty")
+	}
+
+	o.scyllaClient, err = controllerhelpers.NewScyllaClientFromToken([]string{o.NodeAddress}, authToken)
+	if err != nil {
+		return fmt.Errorf("can't create scylla client: %w", err)
+	}
+
+	return nil
+}
+
+func (o *RemoveNodeJobOptions) Run(streams genericclioptions.IOStreams, cmd *cobra.Command) error {
+	cmdutil.LogCommandStarting(cmd)
+
+	defer func(startTime time.Time) {
+		klog.InfoS("Node removal completed", "duration", time.Since(startTime))
+	}(time.Now())
+
+	cliflag.PrintFlags(cmd.Flags())
+
+	stopCh := signals.StopChannel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	// A previous attempt might have removed the node already.
+	ipToHostID, err := o.scyllaClient.GetIPToHostIDMap(ctx, o.NodeAddress)
+	if err != nil {
+		return fmt.Errorf("can't get host IDs: %w", err)
+	}
+
+	if !slices.Contains(slices.Collect(maps.Values(ipToHostID)), o.HostID) {
+		klog.InfoS("Node is not part of the cluster, nothing to remove", "HostID", o.HostID)
+		return nil
+	}
+
+	klog.InfoS("Removing node", "HostID", o.HostID)
+	err = o.scyllaClient.RemoveNode(ctx, o.NodeAddress, o.HostID)
+	if err != nil {
+		return fmt.Errorf("can't remove node with host ID %q: %w", o.HostID, err)
+	}
+
+	klog.InfoS("Node removed successfully", "HostID", o.HostID)
+
+	return nil
+}
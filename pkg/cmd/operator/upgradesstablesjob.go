@@ -0,0 +1,205 @@
+// Copyright (C) 2025 ScyllaDB
+
+package operator
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/scylladb/scylla-operator/pkg/cmdutil"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/genericclioptions"
+	"github.com/scylladb/scylla-operator/pkg/helpers"
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+	"github.com/scylladb/scylla-operator/pkg/signals"
+	"github.com/spf13/cobra"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	apimachineryutilwait "k8s.io/apimachinery/pkg/util/wait"
+	cliflag "k8s.io/component-base/cli/flag"
+	"k8s.io/klog/v2"
+)
+
+const (
+	stopUpgradeSSTablesOperationTimeout = 10 * time.Second
+)
+
+type UpgradeSSTablesJobOptions struct {
+	ManagerAuthConfigPath string
+	NodeAddress           string
+
+	scyllaClient *scyllaclient.Client
+}
+
+func NewUpgradeSSTablesJobOptions(streams genericclioptions.IOStreams) *UpgradeSSTablesJobOptions {
+	return &UpgradeSSTablesJobOptions{}
+}
+
+func NewUpgradeSSTablesJobCmd(streams genericclioptions.IOStreams) *cobra.Command {
+	o := NewUpgradeSSTablesJobOptions(streams)
+
+	cmd := &cobra.Command{
+		Use:   "upgradesstables-job",
+		Short: "Rewrites SSTables of a node in the current format.",
+		Long:  "Rewrites SSTables of a node in the current format.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			err := o.Validate()
+			if err != nil {
+				return err
+			}
+
+			err = o.Complete()
+			if err != nil {
+				return err
+			}
+
+			err = o.Run(streams, cmd)
+			if err != nil {
+				return err
+			}
+
+			return nil
+		},
+
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+
+	cmd.Flags().StringVarP(&o.ManagerAuthConfigPath, "manager-auth-config-path", "", o.ManagerAuthConfigPath, "Path to a file containing Scylla Manager config containing auth token.")
+	cmd.Flags().StringVarP(&o.NodeAddress, "node-address", "", o.NodeAddress, "Address of a node where SSTables will be upgraded.")
+
+	return cmd
+}
+
+func (o *UpgradeSSTablesJobOptions) Validate() error {
+	var errs []error
+
+	if len(o.ManagerAuthConfigPath) == 0 {
+		errs = append(errs, fmt.Errorf("manager-auth-config-path cannot be empty"))
+	}
+
+	if len(o.NodeAddress) == 0 {
+		errs = append(errs, fmt.Errorf("node-address cannot be empty"))
+	}
+
+	return apimachineryutilerrors.NewAggregate(errs)
+}
+
+func (o *UpgradeSSTablesJobOptions) Complete() error {
+	var err error
+
+	buf, err := os.ReadFile(o.ManagerAuthConfigPath)
+	if err != nil {
+		return fmt.Errorf("can't read auth token file at %q: %w", o.ManagerAuthConfigPath, err)
+	}
+
+	authToken, err := helpers.ParseTokenFromConfig(buf)
+	if err != nil {
+		return fmt.Errorf("can't parse auth token file at %q: %w", o.ManagerAuthConfigPath, err)
+	}
+
+	if len(authToken) == 0 {
+		return fmt.Errorf("manager agent auth token cannot be empty")
+	}
+
+	o.scyllaClient, err = controllerhelpers.NewScyllaClientFromToken([]string{o.NodeAddress}, authToken)
+	if err != nil {
+		return fmt.Errorf("can't create scylla client: %w", err)
+	}
+
+	return nil
+}
+
+func (o *UpgradeSSTablesJobOptions) Run(streams genericclioptions.IOStreams, cmd *cobra.Command) error {
+	cmdutil.LogCommandStarting(cmd)
+
+	defer func(startTime time.Time) {
+		klog.InfoS("Node SSTables upgrade completed", "duration", time.Since(startTime))
+	}(time.Now())
+
+	cliflag.PrintFlags(cmd.Flags())
+
+	stopCh := signals.StopChannel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go func() {
+		<-stopCh
+		cancel()
+	}()
+
+	var errs []error
+
+	upgradeErr := o.runUpgradeSSTables(ctx)
+	if upgradeErr != nil {
+		errs = append(errs, fmt.Errorf("can't run the SSTables upgrade: %w", upgradeErr))
+	} else {
+		klog.InfoS("Node SSTables upgrade finished successfully")
+	}
+
+	select {
+	case <-stopCh:
+		if upgradeErr == nil {
+			break
+		}
+
+		klog.InfoS("Stopping any ongoing SSTables upgrade due to stop signal")
+		stopCtx, stopCtxCancel := context.WithTimeout(context.Background(), stopUpgradeSSTablesOperationTimeout)
+		defer stopCtxCancel()
+
+		apimachineryutilwait.UntilWithContext(stopCtx, func(ctx context.Context) {
+			err := o.scyllaClient.StopUpgradeSSTables(ctx, o.NodeAddress)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("can't stop the SSTables upgrade: %w", err))
+			} else {
+				stopCtxCancel()
+			}
+		}, time.Second)
+	default:
+	}
+
+	err := apimachineryutilerrors.NewAggregate(errs)
+	if err != nil {
+		return fmt.Errorf("can't upgrade SSTables: %w", err)
+	}
+
+	return nil
+}
+
+func (o *UpgradeSSTablesJobOptions) runUpgradeSSTables(ctx context.Context) error {
+	klog.InfoS("Stopping any ongoing SSTables upgrade")
+	err := o.scyllaClient.StopUpgradeSSTables(ctx, o.NodeAddress)
+	if err != nil {
+		return fmt.Errorf("can't stop the SSTables upgrade: %w", err)
+	}
+
+	keyspaces, err := o.scyllaClient.Keyspaces(ctx)
+	if err != nil {
+		return fmt.Errorf("can't get list of keyspaces: %w", err)
+	}
+
+	klog.InfoS("Discovered keyspaces for SSTables upgrade", "keyspaces", keyspaces)
+
+	var errs []error
+	for _, keyspace := range keyspaces {
+		klog.InfoS("Starting a keyspace SSTables upgrade", "keyspace", keyspace)
+		startTime := time.Now()
+
+		err = o.scyllaClient.UpgradeSSTables(ctx, o.NodeAddress, keyspace)
+		if err != nil {
+			klog.Warningf("Can't upgrade SSTables of keyspace %q: %s", keyspace, err)
+			errs = append(errs, fmt.Errorf("can't upgrade SSTables of keyspace %q: %w", keyspace, err))
+			continue
+		}
+
+		klog.InfoS("Finished keyspace SSTables upgrade", "keyspace", keyspace, "duration", time.Since(startTime))
+	}
+
+	err = apimachineryutilerrors.NewAggregate(errs)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -81,6 +81,18 @@ var (
 			GetWarningsOnCreateFunc: validation.GetWarningsOnScyllaDBManagerTaskCreate,
 			GetWarningsOnUpdateFunc: validation.GetWarningsOnScyllaDBManagerTaskUpdate,
 		},
+		scyllav1alpha1.GroupVersion.WithResource("scylladbvolumesnapshots"): &GenericValidator[*scyllav1alpha1.ScyllaDBVolumeSnapshot]{
+			ValidateCreateFunc:      validation.ValidateScyllaDBVolumeSnapshot,
+			ValidateUpdateFunc:      validation.ValidateScyllaDBVolumeSnapshotUpdate,
+			GetWarningsOnCreateFunc: validation.GetWarningsOnScyllaDBVolumeSnapshotCreate,
+			GetWarningsOnUpdateFunc: validation.GetWarningsOnScyllaDBVolumeSnapshotUpdate,
+		},
+		scyllav1alpha1.GroupVersion.WithResource("scylladbsnapshots"): &GenericValidator[*scyllav1alpha1.ScyllaDBSnapshot]{
+			ValidateCreateFunc:      validation.ValidateScyllaDBSnapshot,
+			ValidateUpdateFunc:      validation.ValidateScyllaDBSnapshotUpdate,
+			GetWarningsOnCreateFunc: validation.GetWarningsOnScyllaDBSnapshotCreate,
+			GetWarningsOnUpdateFunc: validation.GetWarningsOnScyllaDBSnapshotUpdate,
+		},
 		scyllav1alpha1.GroupVersion.WithResource("scylladbmonitorings"): &GenericValidator[*scyllav1alpha1.ScyllaDBMonitoring]{
 			ValidateCreateFunc:      validation.ValidateScyllaDBMonitoring,
 			ValidateUpdateFunc:      validation.ValidateScyllaDBMonitoringUpdate,
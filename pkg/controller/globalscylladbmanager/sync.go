@@ -29,18 +29,24 @@ func (gsmc *Controller) sync(ctx context.Context) error {
 		klog.V(4).InfoS("Finished syncing observer", "Name", gsmc.Observer.Name(), "duration", time.Since(startTime))
 	}()
 
-	scyllaDBDatacenters, err := gsmc.scyllaDBDatacenterLister.ScyllaDBDatacenters(corev1.NamespaceAll).List(globalScyllaDBManagerSelector)
+	scyllaDBDatacenters, err := gsmc.scyllaDBDatacenterLister.ScyllaDBDatacenters(corev1.NamespaceAll).List(labels.Everything())
 	if err != nil {
 		return fmt.Errorf("can't list ScyllaDBDatacenters: %w", err)
 	}
 
+	scyllaDBDatacenters = oslices.Filter(scyllaDBDatacenters, func(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+		return shouldRegisterWithGlobalScyllaDBManager(sdc, sdc.Spec.RestoreFromBackup, sdc.Status.RestoreFromBackup)
+	})
 	scyllaDBDatacenters = oslices.FilterOut(scyllaDBDatacenters, isObjectBeingDeleted)
 
-	scyllaDBClusters, err := gsmc.scyllaDBClusterLister.ScyllaDBClusters(corev1.NamespaceAll).List(globalScyllaDBManagerSelector)
+	scyllaDBClusters, err := gsmc.scyllaDBClusterLister.ScyllaDBClusters(corev1.NamespaceAll).List(labels.Everything())
 	if err != nil {
 		return fmt.Errorf("can't list ScyllaDBClusters: %w", err)
 	}
 
+	scyllaDBClusters = oslices.Filter(scyllaDBClusters, func(sc *scyllav1alpha1.ScyllaDBCluster) bool {
+		return shouldRegisterWithGlobalScyllaDBManager(sc, sc.Spec.RestoreFromBackup, sc.Status.RestoreFromBackup)
+	})
 	scyllaDBClusters = oslices.FilterOut(scyllaDBClusters, isObjectBeingDeleted)
 
 	scyllaDBManagerClusterRegistrations, err := gsmc.getScyllaDBManagerClusterRegistrations()
@@ -79,6 +85,16 @@ func (gsmc *Controller) getScyllaDBManagerClusterRegistrations() (map[string]map
 	return smcrMap, nil
 }
 
+// shouldRegisterWithGlobalScyllaDBManager returns whether the object should be registered with the global ScyllaDB Manager instance.
+// Objects initialized from a backup are registered until the restore completes, because ScyllaDB Manager runs the restore.
+func shouldRegisterWithGlobalScyllaDBManager(obj metav1.Object, restoreFromBackup *scyllav1alpha1.RestoreFromBackupOptions, restoreFromBackupStatus *scyllav1alpha1.RestoreFromBackupStatus) bool {
+	if globalScyllaDBManagerSelector.Matches(labels.Set(obj.GetLabels())) {
+		return true
+	}
+
+	return restoreFromBackup != nil && (restoreFromBackupStatus == nil || restoreFromBackupStatus.CompletionTime == nil)
+}
+
 func isObjectBeingDeleted[T metav1.Object](obj T) bool {
 	return obj.GetDeletionTimestamp() != nil
 }
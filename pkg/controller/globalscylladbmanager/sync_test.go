@@ -0,0 +1,93 @@
+// Copyright (C) 2025 ScyllaDB
+
+package globalscylladbmanager
+
+import (
+	"testing"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_shouldRegisterWithGlobalScyllaDBManager(t *testing.T) {
+	t.Parallel()
+
+	completionTime := metav1.Now()
+
+	tt := []struct {
+		name                    string
+		labels                  map[string]string
+		restoreFromBackup       *scyllav1alpha1.RestoreFromBackupOptions
+		restoreFromBackupStatus *scyllav1alpha1.RestoreFromBackupStatus
+		expected                bool
+	}{
+		{
+			name:     "no registration label",
+			expected: false,
+		},
+		{
+			name: "registration label",
+			labels: map[string]string{
+				naming.GlobalScyllaDBManagerRegistrationLabel: naming.LabelValueTrue,
+			},
+			expected: true,
+		},
+		{
+			name: "registration label set to false",
+			labels: map[string]string{
+				naming.GlobalScyllaDBManagerRegistrationLabel: naming.LabelValueFalse,
+			},
+			expected: false,
+		},
+		{
+			name:              "restore from backup without status",
+			restoreFromBackup: &scyllav1alpha1.RestoreFromBackupOptions{},
+			expected:          true,
+		},
+		{
+			name:              "restore from backup in progress",
+			restoreFromBackup: &scyllav1alpha1.RestoreFromBackupOptions{},
+			restoreFromBackupStatus: &scyllav1alpha1.RestoreFromBackupStatus{
+				SchemaRestored: true,
+			},
+			expected: true,
+		},
+		{
+			name:              "restore from backup completed",
+			restoreFromBackup: &scyllav1alpha1.RestoreFromBackupOptions{},
+			restoreFromBackupStatus: &scyllav1alpha1.RestoreFromBackupStatus{
+				SchemaRestored: true,
+				CompletionTime: &completionTime,
+			},
+			expected: false,
+		},
+		{
+			name: "restore from backup completed with registration label",
+			labels: map[string]string{
+				naming.GlobalScyllaDBManagerRegistrationLabel: naming.LabelValueTrue,
+			},
+			restoreFromBackup: &scyllav1alpha1.RestoreFromBackupOptions{},
+			restoreFromBackupStatus: &scyllav1alpha1.RestoreFromBackupStatus{
+				SchemaRestored: true,
+				CompletionTime: &completionTime,
+			},
+			expected: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			obj := &metav1.ObjectMeta{
+				Labels: tc.labels,
+			}
+
+			got := shouldRegisterWithGlobalScyllaDBManager(obj, tc.restoreFromBackup, tc.restoreFromBackupStatus)
+			if got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
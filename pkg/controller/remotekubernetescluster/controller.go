@@ -22,6 +22,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
 	apimachineryutilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	apimachineryutilsets "k8s.io/apimachinery/pkg/util/sets"
 	apimachineryutilwait "k8s.io/apimachinery/pkg/util/wait"
 	corev1informers "k8s.io/client-go/informers/core/v1"
 	"k8s.io/client-go/kubernetes"
@@ -280,6 +281,10 @@ func (rkcc *Controller) updateScyllaDBCluster(old, cur interface{}) {
 		rkcc.enqueueRemoteKubernetesClustersReferencedByScyllaDBCluster,
 		rkcc.deleteScyllaDBCluster,
 	)
+
+	// RemoteKubernetesClusters no longer referenced by the ScyllaDBCluster have to be enqueued too,
+	// so their finalization can proceed.
+	rkcc.enqueueRemoteKubernetesClustersReferencedByScyllaDBCluster(1, old.(*scyllav1alpha1.ScyllaDBCluster), controllerhelpers.HandlerOperationTypeUpdate)
 }
 
 func (rkcc *Controller) deleteScyllaDBCluster(obj interface{}) {
@@ -292,10 +297,22 @@ func (rkcc *Controller) deleteScyllaDBCluster(obj interface{}) {
 func (rkcc *Controller) enqueueRemoteKubernetesClustersReferencedByScyllaDBCluster(depth int, obj kubeinterfaces.ObjectInterface, op controllerhelpers.HandlerOperationType) {
 	sc := obj.(*scyllav1alpha1.ScyllaDBCluster)
 
+	rkcNames := apimachineryutilsets.New[string]()
 	for _, dc := range sc.Spec.Datacenters {
-		rkc, err := rkcc.remoteKubernetesClusterLister.Get(dc.RemoteKubernetesClusterName)
+		rkcNames.Insert(dc.RemoteKubernetesClusterName)
+	}
+
+	// Datacenters being removed keep using their RemoteKubernetesClusters until the removal completes.
+	for _, dcStatus := range sc.Status.Datacenters {
+		if dcStatus.Removal != nil && dcStatus.RemoteKubernetesClusterName != nil {
+			rkcNames.Insert(*dcStatus.RemoteKubernetesClusterName)
+		}
+	}
+
+	for _, rkcName := range apimachineryutilsets.List(rkcNames) {
+		rkc, err := rkcc.remoteKubernetesClusterLister.Get(rkcName)
 		if err != nil {
-			apimachineryutilruntime.HandleError(fmt.Errorf("couldn't get RemoteKubernetesCluster %q: %w", dc.RemoteKubernetesClusterName, err))
+			apimachineryutilruntime.HandleError(fmt.Errorf("couldn't get RemoteKubernetesCluster %q: %w", rkcName, err))
 			continue
 		}
 		rkcc.handlers.Enqueue(depth+1, rkc, op)
@@ -5,6 +5,7 @@ package remotekubernetescluster
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
@@ -93,10 +94,8 @@ func (rkcc *Controller) isBeingUsed(ctx context.Context, rkc *scyllav1alpha1.Rem
 
 	var scyllaDBClusterReferents []string
 	for _, sc := range scs {
-		for _, dc := range sc.Spec.Datacenters {
-			if dc.RemoteKubernetesClusterName == rkc.Name {
-				scyllaDBClusterReferents = append(scyllaDBClusterReferents, naming.ObjRef(sc))
-			}
+		if isUsedByScyllaDBCluster(rkc, sc) {
+			scyllaDBClusterReferents = append(scyllaDBClusterReferents, naming.ObjRef(sc))
 		}
 	}
 
@@ -117,10 +116,8 @@ func (rkcc *Controller) isBeingUsed(ctx context.Context, rkc *scyllav1alpha1.Rem
 
 	scyllaDBClusterReferents = scyllaDBClusterReferents[:0]
 	for _, sc := range scList.Items {
-		for _, dc := range sc.Spec.Datacenters {
-			if dc.RemoteKubernetesClusterName == rkc.Name {
-				scyllaDBClusterReferents = append(scyllaDBClusterReferents, naming.ObjRef(&sc))
-			}
+		if isUsedByScyllaDBCluster(rkc, &sc) {
+			scyllaDBClusterReferents = append(scyllaDBClusterReferents, naming.ObjRef(&sc))
 		}
 	}
 
@@ -133,3 +130,27 @@ func (rkcc *Controller) isBeingUsed(ctx context.Context, rkc *scyllav1alpha1.Rem
 
 	return false, nil, nil
 }
+
+// isUsedByScyllaDBCluster returns true when any of the ScyllaDBCluster datacenters is in the RemoteKubernetesCluster,
+// including datacenters that are being removed, unless they are declared lost.
+func isUsedByScyllaDBCluster(rkc *scyllav1alpha1.RemoteKubernetesCluster, sc *scyllav1alpha1.ScyllaDBCluster) bool {
+	for _, dc := range sc.Spec.Datacenters {
+		if dc.RemoteKubernetesClusterName == rkc.Name {
+			return true
+		}
+	}
+
+	for _, dcStatus := range sc.Status.Datacenters {
+		if dcStatus.Removal == nil || dcStatus.RemoteKubernetesClusterName == nil || *dcStatus.RemoteKubernetesClusterName != rkc.Name {
+			continue
+		}
+
+		if slices.Contains(sc.Spec.LostDatacenters, dcStatus.Name) {
+			continue
+		}
+
+		return true
+	}
+
+	return false
+}
@@ -26,6 +26,8 @@ var (
 	makeRemoteSecretControllerDatacenterDegradedCondition                                 = MakeRemoteKindControllerDatacenterConditionFunc("Secret", scyllav1alpha1.DegradedCondition)
 	makeRemoteScyllaDBDatacenterNodesStatusReportControllerDatacenterProgressingCondition = MakeRemoteKindControllerDatacenterConditionFunc("ScyllaDBDatacenterNodesStatusReport", scyllav1alpha1.ProgressingCondition)
 	makeRemoteScyllaDBDatacenterNodesStatusReportControllerDatacenterDegradedCondition    = MakeRemoteKindControllerDatacenterConditionFunc("ScyllaDBDatacenterNodesStatusReport", scyllav1alpha1.DegradedCondition)
+	makeRemoteDatacenterRemovalControllerDatacenterProgressingCondition                   = MakeRemoteKindControllerDatacenterConditionFunc("DatacenterRemoval", scyllav1alpha1.ProgressingCondition)
+	makeRemoteDatacenterRemovalControllerDatacenterDegradedCondition                      = MakeRemoteKindControllerDatacenterConditionFunc("DatacenterRemoval", scyllav1alpha1.DegradedCondition)
 
 	scyllaDBClusterFinalizerProgressingCondition = internalapi.MakeKindFinalizerCondition("ScyllaDBCluster", scyllav1alpha1.ProgressingCondition)
 	scyllaDBClusterFinalizerDegradedCondition    = internalapi.MakeKindFinalizerCondition("ScyllaDBCluster", scyllav1alpha1.DegradedCondition)
@@ -38,6 +40,15 @@ var (
 	endpointsControllerDegradedCondition        = internalapi.MakeKindControllerCondition("Endpoints", scyllav1alpha1.DegradedCondition)
 	secretControllerProgressingCondition        = internalapi.MakeKindControllerCondition("Secret", scyllav1alpha1.ProgressingCondition)
 	secretControllerDegradedCondition           = internalapi.MakeKindControllerCondition("Secret", scyllav1alpha1.DegradedCondition)
+
+	restoreFromBackupControllerAvailableCondition   = internalapi.MakeKindControllerCondition("RestoreFromBackup", scyllav1alpha1.AvailableCondition)
+	restoreFromBackupControllerProgressingCondition = internalapi.MakeKindControllerCondition("RestoreFromBackup", scyllav1alpha1.ProgressingCondition)
+	restoreFromBackupControllerDegradedCondition    = internalapi.MakeKindControllerCondition("RestoreFromBackup", scyllav1alpha1.DegradedCondition)
+
+	remoteKubernetesClusterHealthControllerDegradedCondition = internalapi.MakeKindControllerCondition("RemoteKubernetesClusterHealth", scyllav1alpha1.DegradedCondition)
+
+	// scyllaDBDatacenterRebuildControllerDegradedCondition is reported by ScyllaDBDatacenter when rebuilding its nodes fails.
+	scyllaDBDatacenterRebuildControllerDegradedCondition = internalapi.MakeKindControllerCondition("Rebuild", scyllav1alpha1.DegradedCondition)
 )
 
 // MakeRemoteKindControllerDatacenterConditionFunc returns a format string for a remote kind controller datacenter condition.
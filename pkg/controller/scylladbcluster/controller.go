@@ -3,6 +3,7 @@ package scylladbcluster
 import (
 	"context"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -60,6 +61,9 @@ type Controller struct {
 	serviceLister              corev1listers.ServiceLister
 	endpointSliceLister        discoveryv1listers.EndpointSliceLister
 	endpointsLister            corev1listers.EndpointsLister
+	scyllaDBManagerTaskLister  scyllav1alpha1listers.ScyllaDBManagerTaskLister
+
+	remoteKubernetesClusterLister scyllav1alpha1listers.RemoteKubernetesClusterLister
 
 	remoteRemoteOwnerLister                         remotelister.GenericClusterLister[scyllav1alpha1listers.RemoteOwnerLister]
 	remoteScyllaDBDatacenterLister                  remotelister.GenericClusterLister[scyllav1alpha1listers.ScyllaDBDatacenterLister]
@@ -72,6 +76,12 @@ type Controller struct {
 	remoteSecretLister                              remotelister.GenericClusterLister[corev1listers.SecretLister]
 	remoteScyllaDBDatacenterNodesStatusReportLister remotelister.GenericClusterLister[scyllav1alpha1listers.ScyllaDBDatacenterNodesStatusReportLister]
 
+	// getDatacenterReplicatedKeyspaces returns the keyspaces which still replicate to the datacenter.
+	getDatacenterReplicatedKeyspaces func(ctx context.Context, sc *scyllav1alpha1.ScyllaDBCluster, dc *scyllav1alpha1.ScyllaDBClusterDatacenter, sdc *scyllav1alpha1.ScyllaDBDatacenter) ([]string, error)
+
+	// getDatacenterHostIDs returns the host IDs of the nodes in the gossip datacenter, as known to the nodes of the datacenter.
+	getDatacenterHostIDs func(ctx context.Context, sc *scyllav1alpha1.ScyllaDBCluster, dc *scyllav1alpha1.ScyllaDBClusterDatacenter, sdc *scyllav1alpha1.ScyllaDBDatacenter, gossipDatacenter string) ([]string, error)
+
 	cachesToSync []cache.InformerSynced
 
 	eventRecorder record.EventRecorder
@@ -92,6 +102,8 @@ func NewController(
 	serviceInformer corev1informers.ServiceInformer,
 	endpointSliceInformer discoveryv1informers.EndpointSliceInformer,
 	endpointsInformer corev1informers.EndpointsInformer,
+	scyllaDBManagerTaskInformer scyllav1alpha1informers.ScyllaDBManagerTaskInformer,
+	remoteKubernetesClusterInformer scyllav1alpha1informers.RemoteKubernetesClusterInformer,
 	remoteRemoteOwnerInformer remoteinformers.GenericClusterInformer,
 	remoteScyllaDBDatacenterInformer remoteinformers.GenericClusterInformer,
 	remoteNamespaceInformer remoteinformers.GenericClusterInformer,
@@ -120,6 +132,9 @@ func NewController(
 		serviceLister:              serviceInformer.Lister(),
 		endpointSliceLister:        endpointSliceInformer.Lister(),
 		endpointsLister:            endpointsInformer.Lister(),
+		scyllaDBManagerTaskLister:  scyllaDBManagerTaskInformer.Lister(),
+
+		remoteKubernetesClusterLister: remoteKubernetesClusterInformer.Lister(),
 
 		remoteRemoteOwnerLister:                         remotelister.NewClusterLister(scyllav1alpha1listers.NewRemoteOwnerLister, remoteRemoteOwnerInformer.Indexer().Cluster),
 		remoteScyllaDBDatacenterLister:                  remotelister.NewClusterLister(scyllav1alpha1listers.NewScyllaDBDatacenterLister, remoteScyllaDBDatacenterInformer.Indexer().Cluster),
@@ -140,6 +155,8 @@ func NewController(
 			serviceInformer.Informer().HasSynced,
 			endpointSliceInformer.Informer().HasSynced,
 			endpointsInformer.Informer().HasSynced,
+			scyllaDBManagerTaskInformer.Informer().HasSynced,
+			remoteKubernetesClusterInformer.Informer().HasSynced,
 			remoteRemoteOwnerInformer.Informer().HasSynced,
 			remoteScyllaDBDatacenterInformer.Informer().HasSynced,
 			remoteNamespaceInformer.Informer().HasSynced,
@@ -162,6 +179,9 @@ func NewController(
 		),
 	}
 
+	scc.getDatacenterReplicatedKeyspaces = scc.getRemoteDatacenterReplicatedKeyspaces
+	scc.getDatacenterHostIDs = scc.getGossipDatacenterHostIDs
+
 	var err error
 	scc.handlers, err = controllerhelpers.NewHandlers[*scyllav1alpha1.ScyllaDBCluster](
 		scc.queue,
@@ -225,6 +245,22 @@ func NewController(
 		},
 	)
 
+	scyllaDBManagerTaskInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    scc.addScyllaDBManagerTask,
+			UpdateFunc: scc.updateScyllaDBManagerTask,
+			DeleteFunc: scc.deleteScyllaDBManagerTask,
+		},
+	)
+
+	remoteKubernetesClusterInformer.Informer().AddEventHandler(
+		cache.ResourceEventHandlerFuncs{
+			AddFunc:    scc.addRemoteKubernetesCluster,
+			UpdateFunc: scc.updateRemoteKubernetesCluster,
+			DeleteFunc: scc.deleteRemoteKubernetesCluster,
+		},
+	)
+
 	// Handlers for local ConfigMaps and Secrets referenced by ScyllaDBClusters are skipped to optimize number of syncs which doesn't do anything.
 	// Applying configuration change requires rolling restart of ScyllaDBCluster, so these resources will be synced upon
 	// ScyllaDBCluster update.
@@ -756,6 +792,62 @@ func (scc *Controller) deleteSecret(obj interface{}) {
 	)
 }
 
+func (scc *Controller) addScyllaDBManagerTask(obj interface{}) {
+	scc.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.ScyllaDBManagerTask),
+		scc.handlers.EnqueueOwner,
+	)
+}
+
+func (scc *Controller) updateScyllaDBManagerTask(old, cur interface{}) {
+	scc.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.ScyllaDBManagerTask),
+		cur.(*scyllav1alpha1.ScyllaDBManagerTask),
+		scc.handlers.EnqueueOwner,
+		scc.deleteScyllaDBManagerTask,
+	)
+}
+
+func (scc *Controller) deleteScyllaDBManagerTask(obj interface{}) {
+	scc.handlers.HandleDelete(
+		obj,
+		scc.handlers.EnqueueOwner,
+	)
+}
+
+func (scc *Controller) addRemoteKubernetesCluster(obj interface{}) {
+	scc.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.RemoteKubernetesCluster),
+		scc.enqueueScyllaDBClustersUsingRemoteKubernetesCluster,
+	)
+}
+
+func (scc *Controller) updateRemoteKubernetesCluster(old, cur interface{}) {
+	scc.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.RemoteKubernetesCluster),
+		cur.(*scyllav1alpha1.RemoteKubernetesCluster),
+		scc.enqueueScyllaDBClustersUsingRemoteKubernetesCluster,
+		scc.deleteRemoteKubernetesCluster,
+	)
+}
+
+func (scc *Controller) deleteRemoteKubernetesCluster(obj interface{}) {
+	scc.handlers.HandleDelete(
+		obj,
+		scc.enqueueScyllaDBClustersUsingRemoteKubernetesCluster,
+	)
+}
+
+// enqueueScyllaDBClustersUsingRemoteKubernetesCluster enqueues ScyllaDBClusters having datacenters in the RemoteKubernetesCluster,
+// so topology changes paused by its unavailability resume once it recovers.
+func (scc *Controller) enqueueScyllaDBClustersUsingRemoteKubernetesCluster(depth int, obj kubeinterfaces.ObjectInterface, op controllerhelpers.HandlerOperationType) {
+	rkc := obj.(*scyllav1alpha1.RemoteKubernetesCluster)
+
+	scc.handlers.EnqueueAllFunc(scc.handlers.EnqueueWithFilterFunc(func(sc *scyllav1alpha1.ScyllaDBCluster) bool {
+		return slices.Contains(getRemoteKubernetesClusterNames(sc), rkc.Name)
+	}))(depth+1, obj, op)
+}
+
 func (scc *Controller) addRemoteScyllaDBDatacenterNodesStatusReport(obj interface{}) {
 	scc.handlers.HandleAdd(
 		obj.(*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport),
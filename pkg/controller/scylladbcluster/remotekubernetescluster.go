@@ -0,0 +1,112 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbcluster
+
+import (
+	"fmt"
+	"slices"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	apimachineryutilsets "k8s.io/apimachinery/pkg/util/sets"
+)
+
+// getRemoteKubernetesClusterNames returns the sorted names of RemoteKubernetesClusters hosting datacenters of the ScyllaDBCluster,
+// including datacenters being removed, unless they are declared lost.
+func getRemoteKubernetesClusterNames(sc *scyllav1alpha1.ScyllaDBCluster) []string {
+	rkcNames := apimachineryutilsets.New[string]()
+	for _, dc := range sc.Spec.Datacenters {
+		rkcNames.Insert(dc.RemoteKubernetesClusterName)
+	}
+
+	for _, dcStatus := range sc.Status.Datacenters {
+		if dcStatus.Removal == nil || dcStatus.RemoteKubernetesClusterName == nil || slices.Contains(sc.Spec.LostDatacenters, dcStatus.Name) {
+			continue
+		}
+		rkcNames.Insert(*dcStatus.RemoteKubernetesClusterName)
+	}
+
+	return apimachineryutilsets.List(rkcNames)
+}
+
+// getUnavailableRemoteKubernetesClusterNames returns the sorted names of RemoteKubernetesClusters hosting datacenters of the ScyllaDBCluster
+// which their healthchecks report as unavailable.
+// Missing RemoteKubernetesClusters are unavailable too, unless they only host datacenters being removed,
+// whose nodes are then removed from the surviving datacenters.
+func getUnavailableRemoteKubernetesClusterNames(sc *scyllav1alpha1.ScyllaDBCluster, rkcs map[string]*scyllav1alpha1.RemoteKubernetesCluster) []string {
+	var unavailableRKCNames []string
+	for _, rkcName := range getRemoteKubernetesClusterNames(sc) {
+		rkc, ok := rkcs[rkcName]
+		if !ok {
+			if slices.ContainsFunc(sc.Spec.Datacenters, func(dc scyllav1alpha1.ScyllaDBClusterDatacenter) bool {
+				return dc.RemoteKubernetesClusterName == rkcName
+			}) {
+				unavailableRKCNames = append(unavailableRKCNames, rkcName)
+			}
+			continue
+		}
+
+		if apimeta.IsStatusConditionFalse(rkc.Status.Conditions, scyllav1alpha1.AvailableCondition) {
+			unavailableRKCNames = append(unavailableRKCNames, rkcName)
+		}
+	}
+
+	return unavailableRKCNames
+}
+
+// pinScyllaDBDatacenterTopology makes the required ScyllaDBDatacenter keep the racks, node counts, node removals and ScyllaDB version
+// of the existing one, so no nodes are added, removed or upgraded.
+// It returns true when the required ScyllaDBDatacenter had to be changed.
+func pinScyllaDBDatacenterTopology(required, existing *scyllav1alpha1.ScyllaDBDatacenter) (bool, error) {
+	original := required.DeepCopy()
+
+	required.Spec.ScyllaDB.Image = existing.Spec.ScyllaDB.Image
+	required.Spec.UpgradePath = slices.Clone(existing.Spec.UpgradePath)
+
+	racks := make([]scyllav1alpha1.RackSpec, 0, len(existing.Spec.Racks))
+	for _, existingRack := range existing.Spec.Racks {
+		idx := slices.IndexFunc(required.Spec.Racks, func(requiredRack scyllav1alpha1.RackSpec) bool {
+			return requiredRack.Name == existingRack.Name
+		})
+		if idx == -1 {
+			racks = append(racks, *existingRack.DeepCopy())
+			continue
+		}
+		racks = append(racks, required.Spec.Racks[idx])
+	}
+	required.Spec.Racks = racks
+
+	for i := range required.Spec.Racks {
+		rackName := required.Spec.Racks[i].Name
+
+		existingNodes, err := controllerhelpers.GetRackNodeCount(existing, rackName)
+		if err != nil {
+			return false, fmt.Errorf("can't get rack %q node count of ScyllaDBDatacenter %q: %w", rackName, naming.ObjRef(existing), err)
+		}
+
+		requiredNodes, err := controllerhelpers.GetRackNodeCount(required, rackName)
+		if err != nil {
+			return false, fmt.Errorf("can't get rack %q node count of ScyllaDBDatacenter %q: %w", rackName, naming.ObjRef(required), err)
+		}
+
+		if *requiredNodes != *existingNodes {
+			required.Spec.Racks[i].Nodes = pointer.Ptr(*existingNodes)
+		}
+	}
+
+	removeNodeHostIDs, ok := existing.Annotations[naming.RemoveNodeHostIDsAnnotation]
+	if ok {
+		if required.Annotations == nil {
+			required.Annotations = map[string]string{}
+		}
+		required.Annotations[naming.RemoveNodeHostIDsAnnotation] = removeNodeHostIDs
+	} else {
+		delete(required.Annotations, naming.RemoveNodeHostIDsAnnotation)
+	}
+
+	return !equality.Semantic.DeepEqual(original, required), nil
+}
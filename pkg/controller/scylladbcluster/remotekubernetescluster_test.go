@@ -0,0 +1,335 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbcluster
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestRemoteKubernetesCluster(name string, available metav1.ConditionStatus) *scyllav1alpha1.RemoteKubernetesCluster {
+	return &scyllav1alpha1.RemoteKubernetesCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: scyllav1alpha1.RemoteKubernetesClusterStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:   scyllav1alpha1.AvailableCondition,
+					Status: available,
+				},
+			},
+		},
+	}
+}
+
+func Test_getUnavailableRemoteKubernetesClusterNames(t *testing.T) {
+	t.Parallel()
+
+	newScyllaDBCluster := func() *scyllav1alpha1.ScyllaDBCluster {
+		return &scyllav1alpha1.ScyllaDBCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cluster",
+				Namespace: "scylla",
+			},
+			Spec: scyllav1alpha1.ScyllaDBClusterSpec{
+				Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenter{
+					{
+						Name:                        "dc1",
+						RemoteKubernetesClusterName: "dc1-rkc",
+					},
+					{
+						Name:                        "dc2",
+						RemoteKubernetesClusterName: "dc2-rkc",
+					},
+				},
+			},
+		}
+	}
+
+	withRemovedDatacenter := func(sc *scyllav1alpha1.ScyllaDBCluster, lost bool) *scyllav1alpha1.ScyllaDBCluster {
+		sc.Status.Datacenters = append(sc.Status.Datacenters, scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+			Name:                        "dc3",
+			RemoteKubernetesClusterName: pointer.Ptr("dc3-rkc"),
+			Removal: &scyllav1alpha1.DatacenterRemovalStatus{
+				Method: scyllav1alpha1.DecommissionDatacenterRemovalMethod,
+			},
+		})
+		if lost {
+			sc.Spec.LostDatacenters = []string{"dc3"}
+		}
+		return sc
+	}
+
+	tt := []struct {
+		name                            string
+		sc                              *scyllav1alpha1.ScyllaDBCluster
+		rkcs                            []*scyllav1alpha1.RemoteKubernetesCluster
+		expectedUnavailableClusterNames []string
+	}{
+		{
+			name: "all remote Kubernetes clusters are available",
+			sc:   newScyllaDBCluster(),
+			rkcs: []*scyllav1alpha1.RemoteKubernetesCluster{
+				newTestRemoteKubernetesCluster("dc1-rkc", metav1.ConditionTrue),
+				newTestRemoteKubernetesCluster("dc2-rkc", metav1.ConditionTrue),
+			},
+			expectedUnavailableClusterNames: nil,
+		},
+		{
+			name: "remote Kubernetes cluster without reported availability isn't considered unavailable",
+			sc:   newScyllaDBCluster(),
+			rkcs: []*scyllav1alpha1.RemoteKubernetesCluster{
+				newTestRemoteKubernetesCluster("dc1-rkc", metav1.ConditionTrue),
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "dc2-rkc",
+					},
+				},
+			},
+			expectedUnavailableClusterNames: nil,
+		},
+		{
+			name: "remote Kubernetes cluster failing its healthchecks is unavailable",
+			sc:   newScyllaDBCluster(),
+			rkcs: []*scyllav1alpha1.RemoteKubernetesCluster{
+				newTestRemoteKubernetesCluster("dc1-rkc", metav1.ConditionTrue),
+				newTestRemoteKubernetesCluster("dc2-rkc", metav1.ConditionFalse),
+			},
+			expectedUnavailableClusterNames: []string{"dc2-rkc"},
+		},
+		{
+			name: "missing remote Kubernetes cluster of a datacenter in spec is unavailable",
+			sc:   newScyllaDBCluster(),
+			rkcs: []*scyllav1alpha1.RemoteKubernetesCluster{
+				newTestRemoteKubernetesCluster("dc2-rkc", metav1.ConditionTrue),
+			},
+			expectedUnavailableClusterNames: []string{"dc1-rkc"},
+		},
+		{
+			name: "unavailable remote Kubernetes cluster of a datacenter being removed is unavailable",
+			sc:   withRemovedDatacenter(newScyllaDBCluster(), false),
+			rkcs: []*scyllav1alpha1.RemoteKubernetesCluster{
+				newTestRemoteKubernetesCluster("dc1-rkc", metav1.ConditionTrue),
+				newTestRemoteKubernetesCluster("dc2-rkc", metav1.ConditionTrue),
+				newTestRemoteKubernetesCluster("dc3-rkc", metav1.ConditionFalse),
+			},
+			expectedUnavailableClusterNames: []string{"dc3-rkc"},
+		},
+		{
+			name: "missing remote Kubernetes cluster of a datacenter being removed is ignored",
+			sc:   withRemovedDatacenter(newScyllaDBCluster(), false),
+			rkcs: []*scyllav1alpha1.RemoteKubernetesCluster{
+				newTestRemoteKubernetesCluster("dc1-rkc", metav1.ConditionTrue),
+				newTestRemoteKubernetesCluster("dc2-rkc", metav1.ConditionTrue),
+			},
+			expectedUnavailableClusterNames: nil,
+		},
+		{
+			name: "remote Kubernetes cluster of a lost datacenter is ignored",
+			sc:   withRemovedDatacenter(newScyllaDBCluster(), true),
+			rkcs: []*scyllav1alpha1.RemoteKubernetesCluster{
+				newTestRemoteKubernetesCluster("dc1-rkc", metav1.ConditionTrue),
+				newTestRemoteKubernetesCluster("dc2-rkc", metav1.ConditionTrue),
+				newTestRemoteKubernetesCluster("dc3-rkc", metav1.ConditionFalse),
+			},
+			expectedUnavailableClusterNames: nil,
+		},
+		{
+			name: "all unavailable remote Kubernetes clusters are returned sorted",
+			sc:   withRemovedDatacenter(newScyllaDBCluster(), false),
+			rkcs: []*scyllav1alpha1.RemoteKubernetesCluster{
+				newTestRemoteKubernetesCluster("dc1-rkc", metav1.ConditionTrue),
+				newTestRemoteKubernetesCluster("dc2-rkc", metav1.ConditionFalse),
+				newTestRemoteKubernetesCluster("dc3-rkc", metav1.ConditionFalse),
+			},
+			expectedUnavailableClusterNames: []string{"dc2-rkc", "dc3-rkc"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rkcs := map[string]*scyllav1alpha1.RemoteKubernetesCluster{}
+			for _, rkc := range tc.rkcs {
+				rkcs[rkc.Name] = rkc
+			}
+
+			got := getUnavailableRemoteKubernetesClusterNames(tc.sc, rkcs)
+			if !cmp.Equal(got, tc.expectedUnavailableClusterNames) {
+				t.Errorf("expected and got unavailable cluster names differ:\n%s", cmp.Diff(tc.expectedUnavailableClusterNames, got))
+			}
+		})
+	}
+}
+
+func Test_pinScyllaDBDatacenterTopology(t *testing.T) {
+	t.Parallel()
+
+	newScyllaDBDatacenter := func(image string, racks ...scyllav1alpha1.RackSpec) *scyllav1alpha1.ScyllaDBDatacenter {
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "cluster-dc1",
+				Namespace: "scylla",
+			},
+			Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+				ScyllaDB: scyllav1alpha1.ScyllaDB{
+					Image: image,
+				},
+				RackTemplate: &scyllav1alpha1.RackTemplate{
+					Nodes: pointer.Ptr[int32](3),
+				},
+				Racks: racks,
+			},
+		}
+	}
+
+	tt := []struct {
+		name            string
+		required        *scyllav1alpha1.ScyllaDBDatacenter
+		existing        *scyllav1alpha1.ScyllaDBDatacenter
+		expected        *scyllav1alpha1.ScyllaDBDatacenter
+		expectedChanged bool
+	}{
+		{
+			name: "unchanged topology is kept",
+			required: newScyllaDBDatacenter("scylladb/scylla:2025.1.0",
+				scyllav1alpha1.RackSpec{Name: "a", RackTemplate: scyllav1alpha1.RackTemplate{Nodes: pointer.Ptr[int32](3)}},
+			),
+			existing: newScyllaDBDatacenter("scylladb/scylla:2025.1.0",
+				scyllav1alpha1.RackSpec{Name: "a"},
+			),
+			expected: newScyllaDBDatacenter("scylladb/scylla:2025.1.0",
+				scyllav1alpha1.RackSpec{Name: "a", RackTemplate: scyllav1alpha1.RackTemplate{Nodes: pointer.Ptr[int32](3)}},
+			),
+			expectedChanged: false,
+		},
+		{
+			name: "scaling racks is held back",
+			required: newScyllaDBDatacenter("scylladb/scylla:2025.1.0",
+				scyllav1alpha1.RackSpec{Name: "a", RackTemplate: scyllav1alpha1.RackTemplate{Nodes: pointer.Ptr[int32](5)}},
+				scyllav1alpha1.RackSpec{Name: "b", RackTemplate: scyllav1alpha1.RackTemplate{Nodes: pointer.Ptr[int32](1)}},
+			),
+			existing: newScyllaDBDatacenter("scylladb/scylla:2025.1.0",
+				scyllav1alpha1.RackSpec{Name: "a"},
+				scyllav1alpha1.RackSpec{Name: "b", RackTemplate: scyllav1alpha1.RackTemplate{Nodes: pointer.Ptr[int32](2)}},
+			),
+			expected: newScyllaDBDatacenter("scylladb/scylla:2025.1.0",
+				scyllav1alpha1.RackSpec{Name: "a", RackTemplate: scyllav1alpha1.RackTemplate{Nodes: pointer.Ptr[int32](3)}},
+				scyllav1alpha1.RackSpec{Name: "b", RackTemplate: scyllav1alpha1.RackTemplate{Nodes: pointer.Ptr[int32](2)}},
+			),
+			expectedChanged: true,
+		},
+		{
+			name: "added racks aren't created and removed racks are kept",
+			required: newScyllaDBDatacenter("scylladb/scylla:2025.1.0",
+				scyllav1alpha1.RackSpec{Name: "a", RackTemplate: scyllav1alpha1.RackTemplate{Nodes: pointer.Ptr[int32](3)}},
+				scyllav1alpha1.RackSpec{Name: "c", RackTemplate: scyllav1alpha1.RackTemplate{Nodes: pointer.Ptr[int32](3)}},
+			),
+			existing: newScyllaDBDatacenter("scylladb/scylla:2025.1.0",
+				scyllav1alpha1.RackSpec{Name: "a"},
+				scyllav1alpha1.RackSpec{Name: "b"},
+			),
+			expected: newScyllaDBDatacenter("scylladb/scylla:2025.1.0",
+				scyllav1alpha1.RackSpec{Name: "a", RackTemplate: scyllav1alpha1.RackTemplate{Nodes: pointer.Ptr[int32](3)}},
+				scyllav1alpha1.RackSpec{Name: "b"},
+			),
+			expectedChanged: true,
+		},
+		{
+			name: "scaling rack template is held back",
+			required: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newScyllaDBDatacenter("scylladb/scylla:2025.1.0",
+					scyllav1alpha1.RackSpec{Name: "a"},
+				)
+				sdc.Spec.RackTemplate.Nodes = pointer.Ptr[int32](5)
+				return sdc
+			}(),
+			existing: newScyllaDBDatacenter("scylladb/scylla:2025.1.0",
+				scyllav1alpha1.RackSpec{Name: "a"},
+			),
+			expected: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newScyllaDBDatacenter("scylladb/scylla:2025.1.0",
+					scyllav1alpha1.RackSpec{Name: "a", RackTemplate: scyllav1alpha1.RackTemplate{Nodes: pointer.Ptr[int32](3)}},
+				)
+				sdc.Spec.RackTemplate.Nodes = pointer.Ptr[int32](5)
+				return sdc
+			}(),
+			expectedChanged: true,
+		},
+		{
+			name: "upgrade is held back",
+			required: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newScyllaDBDatacenter("scylladb/scylla:2025.1.0",
+					scyllav1alpha1.RackSpec{Name: "a", RackTemplate: scyllav1alpha1.RackTemplate{Nodes: pointer.Ptr[int32](3)}},
+				)
+				sdc.Spec.UpgradePath = []string{"scylladb/scylla:2024.2.0"}
+				return sdc
+			}(),
+			existing: newScyllaDBDatacenter("scylladb/scylla:2024.1.0",
+				scyllav1alpha1.RackSpec{Name: "a"},
+			),
+			expected: newScyllaDBDatacenter("scylladb/scylla:2024.1.0",
+				scyllav1alpha1.RackSpec{Name: "a", RackTemplate: scyllav1alpha1.RackTemplate{Nodes: pointer.Ptr[int32](3)}},
+			),
+			expectedChanged: true,
+		},
+		{
+			name: "node removal is held back",
+			required: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newScyllaDBDatacenter("scylladb/scylla:2025.1.0",
+					scyllav1alpha1.RackSpec{Name: "a", RackTemplate: scyllav1alpha1.RackTemplate{Nodes: pointer.Ptr[int32](3)}},
+				)
+				sdc.Annotations = map[string]string{
+					naming.RemoveNodeHostIDsAnnotation: "host-id-1,host-id-2",
+				}
+				return sdc
+			}(),
+			existing: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newScyllaDBDatacenter("scylladb/scylla:2025.1.0",
+					scyllav1alpha1.RackSpec{Name: "a"},
+				)
+				sdc.Annotations = map[string]string{
+					naming.RemoveNodeHostIDsAnnotation: "host-id-1",
+				}
+				return sdc
+			}(),
+			expected: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newScyllaDBDatacenter("scylladb/scylla:2025.1.0",
+					scyllav1alpha1.RackSpec{Name: "a", RackTemplate: scyllav1alpha1.RackTemplate{Nodes: pointer.Ptr[int32](3)}},
+				)
+				sdc.Annotations = map[string]string{
+					naming.RemoveNodeHostIDsAnnotation: "host-id-1",
+				}
+				return sdc
+			}(),
+			expectedChanged: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			required := tc.required.DeepCopy()
+			gotChanged, err := pinScyllaDBDatacenterTopology(required, tc.existing)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if gotChanged != tc.expectedChanged {
+				t.Errorf("expected changed %t, got %t", tc.expectedChanged, gotChanged)
+			}
+
+			if !cmp.Equal(required, tc.expected) {
+				t.Errorf("expected and got ScyllaDBDatacenters differ:\n%s", cmp.Diff(tc.expected, required))
+			}
+		})
+	}
+}
@@ -294,6 +294,21 @@ func MakeRemoteScyllaDBDatacenters(sc *scyllav1alpha1.ScyllaDBCluster, dc *scyll
 			MinTerminationGracePeriodSeconds:        sc.Spec.MinTerminationGracePeriodSeconds,
 			MinReadySeconds:                         sc.Spec.MinReadySeconds,
 			ReadinessGates:                          sc.Spec.ReadinessGates,
+			RolloutStrategy: func() *scyllav1alpha1.RolloutStrategy {
+				if sc.Spec.RolloutStrategy == nil {
+					return nil
+				}
+
+				rolloutStrategy := sc.Spec.RolloutStrategy.DeepCopy()
+				// Canary rollouts are approved for each datacenter, as rollout revisions differ between them.
+				if rolloutStrategy.Canary != nil {
+					rolloutStrategy.Canary.ApprovedRevision = dcSpec.RolloutApprovedRevision
+				}
+
+				return rolloutStrategy
+			}(),
+			MaintenanceWindows: sc.Spec.MaintenanceWindows,
+			Rebuild:            dcSpec.Rebuild,
 			// TODO: not supported yet
 			// Ref: https://github.com/scylladb/scylla-operator/issues/2262
 			ImagePullSecrets: nil,
@@ -1002,6 +1017,8 @@ func applyDatacenterTemplateOnDatacenter(dcTemplate *scyllav1alpha1.ScyllaDBClus
 		Name:                        dc.Name,
 		RemoteKubernetesClusterName: dc.RemoteKubernetesClusterName,
 		ForceRedeploymentReason:     dc.ForceRedeploymentReason,
+		Rebuild:                     dc.Rebuild,
+		RolloutApprovedRevision:     dc.RolloutApprovedRevision,
 	}
 }
 
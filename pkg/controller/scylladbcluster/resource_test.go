@@ -6,6 +6,7 @@ import (
 	"slices"
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -1201,6 +1202,114 @@ func TestMakeScyllaDBDatacenters(t *testing.T) {
 				return dc
 			}(),
 		},
+		{
+			name: "rolloutStrategy is taken from cluster level",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				cluster := newBasicScyllaDBCluster()
+				cluster.Spec.RolloutStrategy = &scyllav1alpha1.RolloutStrategy{
+					Canary: &scyllav1alpha1.CanaryRolloutStrategy{
+						Nodes:        1,
+						SoakDuration: &metav1.Duration{Duration: time.Hour},
+					},
+				}
+				return cluster
+			}(),
+			datacenter: dcFromSpec(0),
+			remoteNamespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "scylla-aaa",
+				},
+			},
+			remoteController: &scyllav1alpha1.RemoteOwner{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster-111",
+					Namespace: "scylla-aaa",
+					UID:       "1234",
+				},
+			},
+			expectedScyllaDBDatacenters: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				dc := newBasicScyllaDBDatacenter("dc1", "scylla-aaa", []string{})
+				dc.Spec.RolloutStrategy = &scyllav1alpha1.RolloutStrategy{
+					Canary: &scyllav1alpha1.CanaryRolloutStrategy{
+						Nodes:        1,
+						SoakDuration: &metav1.Duration{Duration: time.Hour},
+					},
+				}
+				return dc
+			}(),
+		},
+		{
+			name: "canary rollout approval is taken from datacenter",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				cluster := newBasicScyllaDBCluster()
+				cluster.Spec.RolloutStrategy = &scyllav1alpha1.RolloutStrategy{
+					Canary: &scyllav1alpha1.CanaryRolloutStrategy{
+						Nodes: 1,
+					},
+				}
+				cluster.Spec.Datacenters[0].RolloutApprovedRevision = pointer.Ptr("abc")
+				return cluster
+			}(),
+			datacenter: dcFromSpec(0),
+			remoteNamespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "scylla-aaa",
+				},
+			},
+			remoteController: &scyllav1alpha1.RemoteOwner{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster-111",
+					Namespace: "scylla-aaa",
+					UID:       "1234",
+				},
+			},
+			expectedScyllaDBDatacenters: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				dc := newBasicScyllaDBDatacenter("dc1", "scylla-aaa", []string{})
+				dc.Spec.RolloutStrategy = &scyllav1alpha1.RolloutStrategy{
+					Canary: &scyllav1alpha1.CanaryRolloutStrategy{
+						Nodes:            1,
+						ApprovedRevision: pointer.Ptr("abc"),
+					},
+				}
+				return dc
+			}(),
+		},
+		{
+			name: "maintenanceWindows are taken from cluster level",
+			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
+				cluster := newBasicScyllaDBCluster()
+				cluster.Spec.MaintenanceWindows = []scyllav1alpha1.MaintenanceWindow{
+					{
+						Cron:     "0 2 * * SAT",
+						Duration: metav1.Duration{Duration: 4 * time.Hour},
+					},
+				}
+				return cluster
+			}(),
+			datacenter: dcFromSpec(0),
+			remoteNamespace: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "scylla-aaa",
+				},
+			},
+			remoteController: &scyllav1alpha1.RemoteOwner{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster-111",
+					Namespace: "scylla-aaa",
+					UID:       "1234",
+				},
+			},
+			expectedScyllaDBDatacenters: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				dc := newBasicScyllaDBDatacenter("dc1", "scylla-aaa", []string{})
+				dc.Spec.MaintenanceWindows = []scyllav1alpha1.MaintenanceWindow{
+					{
+						Cron:     "0 2 * * SAT",
+						Duration: metav1.Duration{Duration: 4 * time.Hour},
+					},
+				}
+				return dc
+			}(),
+		},
 		{
 			name: "nodes in rack template in datacenter spec overrides ones specified in datacenter template",
 			cluster: func() *scyllav1alpha1.ScyllaDBCluster {
@@ -4,6 +4,7 @@ package scylladbcluster
 
 import (
 	"context"
+	"slices"
 
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/naming"
@@ -37,11 +38,15 @@ func (scc *Controller) calculateStatus(sc *scyllav1alpha1.ScyllaDBCluster, datac
 	status := &scyllav1alpha1.ScyllaDBClusterStatus{}
 	status.ObservedGeneration = pointer.Ptr(sc.Generation)
 
+	// Restore progress is computed during the sync, carry over the previous one.
+	status.RestoreFromBackup = sc.Status.RestoreFromBackup.DeepCopy()
+
 	var nodes, currentNodes, updatedNodes, readyNodes, availableNodes int32
 	for _, dc := range sc.Spec.Datacenters {
 		scyllaDatacenters := datacentersMap[dc.RemoteKubernetesClusterName]
 		sdc := scyllaDatacenters[naming.ScyllaDBDatacenterName(sc, &dc)]
 		dcStatus := *scc.calculateDatacenterStatus(&dc, sdc)
+		dcStatus.RemoteKubernetesClusterName = pointer.Ptr(dc.RemoteKubernetesClusterName)
 
 		status.Datacenters = append(status.Datacenters, dcStatus)
 
@@ -62,12 +67,61 @@ func (scc *Controller) calculateStatus(sc *scyllav1alpha1.ScyllaDBCluster, datac
 		}
 	}
 
+	// Datacenters removed from the spec are tracked until their removal finishes.
+	// They are appended after the spec datacenters to keep the indices of the latter.
+	for _, oldDCStatus := range sc.Status.Datacenters {
+		if oldDCStatus.RemoteKubernetesClusterName == nil || slices.ContainsFunc(sc.Spec.Datacenters, func(dc scyllav1alpha1.ScyllaDBClusterDatacenter) bool {
+			return dc.Name == oldDCStatus.Name
+		}) {
+			continue
+		}
+
+		dc := &scyllav1alpha1.ScyllaDBClusterDatacenter{
+			Name:                        oldDCStatus.Name,
+			RemoteKubernetesClusterName: *oldDCStatus.RemoteKubernetesClusterName,
+		}
+
+		var sdc *scyllav1alpha1.ScyllaDBDatacenter
+		if oldDCStatus.RemoteNamespaceName != nil {
+			var err error
+			sdc, err = scc.remoteScyllaDBDatacenterLister.Cluster(dc.RemoteKubernetesClusterName).ScyllaDBDatacenters(*oldDCStatus.RemoteNamespaceName).Get(naming.ScyllaDBDatacenterName(sc, dc))
+			if err != nil {
+				sdc = nil
+			}
+		}
+
+		dcStatus := scc.calculateDatacenterStatus(dc, sdc)
+		if dcStatus.RemoteNamespaceName == nil {
+			dcStatus.RemoteNamespaceName = oldDCStatus.RemoteNamespaceName
+		}
+		dcStatus.RemoteKubernetesClusterName = pointer.Ptr(dc.RemoteKubernetesClusterName)
+		dcStatus.Removal = oldDCStatus.Removal.DeepCopy()
+		if dcStatus.Removal == nil {
+			dcStatus.Removal = &scyllav1alpha1.DatacenterRemovalStatus{
+				Method: scyllav1alpha1.DecommissionDatacenterRemovalMethod,
+			}
+		}
+
+		status.Datacenters = append(status.Datacenters, *dcStatus)
+	}
+
 	status.Nodes = pointer.Ptr(nodes)
 	status.CurrentNodes = pointer.Ptr(currentNodes)
 	status.UpdatedNodes = pointer.Ptr(updatedNodes)
 	status.ReadyNodes = pointer.Ptr(readyNodes)
 	status.AvailableNodes = pointer.Ptr(availableNodes)
 
+	if isUpgradeInProgress(sc, datacentersMap) {
+		for i, dc := range sc.Spec.Datacenters {
+			sdc, ok := datacentersMap[dc.RemoteKubernetesClusterName][naming.ScyllaDBDatacenterName(sc, &dc)]
+			if !ok {
+				continue
+			}
+
+			status.Datacenters[i].UpgradeState = pointer.Ptr(getDatacenterUpgradeState(sdc, sc.Spec.ScyllaDB.Image))
+		}
+	}
+
 	return status
 }
 
@@ -126,6 +180,10 @@ func (scc *Controller) calculateDatacenterStatus(dc *scyllav1alpha1.ScyllaDBClus
 	dcStatus.ReadyNodes = pointer.Ptr(readyNodes)
 	dcStatus.AvailableNodes = pointer.Ptr(availableNodes)
 
+	dcStatus.Rollout = sdc.Status.Rollout.DeepCopy()
+	dcStatus.Upgrade = sdc.Status.Upgrade.DeepCopy()
+	dcStatus.Rebuild = sdc.Status.Rebuild.DeepCopy()
+
 	if sdc.Status.ObservedGeneration != nil {
 		dcStatus.Stale = pointer.Ptr(*sdc.Status.ObservedGeneration < sdc.Generation)
 	}
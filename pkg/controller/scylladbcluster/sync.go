@@ -3,6 +3,7 @@ package scylladbcluster
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
@@ -16,6 +17,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -371,6 +373,91 @@ func (scc *Controller) sync(ctx context.Context, key string) error {
 
 	var errs []error
 
+	rkcs, err := scc.remoteKubernetesClusterLister.List(labels.Everything())
+	if err != nil {
+		return fmt.Errorf("can't list RemoteKubernetesClusters: %w", err)
+	}
+	rkcMap := make(map[string]*scyllav1alpha1.RemoteKubernetesCluster, len(rkcs))
+	for _, rkc := range rkcs {
+		rkcMap[rkc.Name] = rkc
+	}
+
+	// Node additions, decommissions and upgrades are paused in all datacenters while any of the remote Kubernetes clusters is unavailable.
+	unavailableRemoteKubernetesClusterNames := getUnavailableRemoteKubernetesClusterNames(sc, rkcMap)
+	if len(unavailableRemoteKubernetesClusterNames) != 0 {
+		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               remoteKubernetesClusterHealthControllerDegradedCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "RemoteKubernetesClusterUnavailable",
+			Message:            fmt.Sprintf("RemoteKubernetesCluster(s) %q are unavailable, topology changes are paused until they recover.", unavailableRemoteKubernetesClusterNames),
+			ObservedGeneration: sc.Generation,
+		})
+	} else {
+		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               remoteKubernetesClusterHealthControllerDegradedCondition,
+			Status:             metav1.ConditionFalse,
+			Reason:             internalapi.AsExpectedReason,
+			Message:            "",
+			ObservedGeneration: sc.Generation,
+		})
+	}
+
+	// Datacenters removed from the spec are removed first, so the nodes to be removed are known when syncing the remaining datacenters.
+	removedDatacenterNames := map[string]struct{}{}
+	for i := range status.Datacenters {
+		dcStatus := &status.Datacenters[i]
+		if dcStatus.Removal == nil {
+			continue
+		}
+
+		var removed bool
+		err = controllerhelpers.RunSync(
+			&status.Conditions,
+			makeRemoteDatacenterRemovalControllerDatacenterProgressingCondition(dcStatus.Name),
+			makeRemoteDatacenterRemovalControllerDatacenterDegradedCondition(dcStatus.Name),
+			sc.Generation,
+			func() ([]metav1.Condition, error) {
+				var progressingConditions []metav1.Condition
+				var err error
+				progressingConditions, removed, err = scc.syncDatacenterRemoval(ctx, sc, dcStatus, unavailableRemoteKubernetesClusterNames, remoteNamespaces, remoteScyllaDBDatacenterMap)
+				return progressingConditions, err
+			},
+		)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't sync removal of datacenter %q: %w", dcStatus.Name, err))
+		}
+
+		if removed {
+			removedDatacenterNames[dcStatus.Name] = struct{}{}
+			continue
+		}
+
+		err = controllerhelpers.SetAggregatedWorkloadConditionsBySuffixes(
+			internalapi.MakeDatacenterAvailableCondition(dcStatus.Name),
+			internalapi.MakeDatacenterProgressingCondition(dcStatus.Name),
+			internalapi.MakeDatacenterDegradedCondition(dcStatus.Name),
+			&status.Conditions,
+			sc.Generation,
+		)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't aggregate datacenter %q workload conditions: %w", dcStatus.Name, err))
+		}
+	}
+	if len(removedDatacenterNames) != 0 {
+		status.Datacenters = slices.DeleteFunc(status.Datacenters, func(dcStatus scyllav1alpha1.ScyllaDBClusterDatacenterStatus) bool {
+			_, removed := removedDatacenterNames[dcStatus.Name]
+			return removed
+		})
+		status.Conditions = slices.DeleteFunc(status.Conditions, func(c metav1.Condition) bool {
+			for name := range removedDatacenterNames {
+				if c.Type == makeRemoteDatacenterRemovalControllerDatacenterProgressingCondition(name) || c.Type == makeRemoteDatacenterRemovalControllerDatacenterDegradedCondition(name) {
+					return true
+				}
+			}
+			return false
+		})
+	}
+
 	for _, dc := range sc.Spec.Datacenters {
 		objectErrs := objectErrMaps[dc.RemoteKubernetesClusterName]
 
@@ -476,7 +563,7 @@ func (scc *Controller) sync(ctx context.Context, key string) error {
 				progressingCondition: makeRemoteScyllaDBDatacenterControllerDatacenterProgressingCondition(dc.Name),
 				degradedCondition:    makeRemoteScyllaDBDatacenterControllerDatacenterDegradedCondition(dc.Name),
 				syncFn: func(remoteNamespace *corev1.Namespace, remoteController metav1.Object) ([]metav1.Condition, error) {
-					return scc.syncRemoteScyllaDBDatacenters(ctx, sc, &dc, status, remoteNamespace, remoteController, remoteScyllaDBDatacenterMap, managingClusterDomain)
+					return scc.syncRemoteScyllaDBDatacenters(ctx, sc, &dc, status, unavailableRemoteKubernetesClusterNames, remoteNamespace, remoteController, remoteScyllaDBDatacenterMap, managingClusterDomain)
 				},
 			},
 		}
@@ -548,6 +635,14 @@ func (scc *Controller) sync(ctx context.Context, key string) error {
 				return scc.syncLocalSecrets(ctx, sc, localSecretMap)
 			},
 		},
+		{
+			kind:                 "ScyllaDBManagerTask",
+			progressingCondition: restoreFromBackupControllerProgressingCondition,
+			degradedCondition:    restoreFromBackupControllerDegradedCondition,
+			syncFn: func() ([]metav1.Condition, error) {
+				return scc.syncRestoreFromBackup(ctx, sc, status)
+			},
+		},
 	}
 
 	for _, syncParams := range localSyncParameters {
@@ -0,0 +1,568 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbcluster
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/helpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	apimachineryutilsets "k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+)
+
+// getUnreachableHostIDs returns the sorted host IDs of the datacenter nodes which are not part of any of the reporting datacenters
+// and are observed as down by all reporting nodes.
+func getUnreachableHostIDs(reports []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport, datacenterHostIDs apimachineryutilsets.Set[string]) []string {
+	reportingHostIDs := apimachineryutilsets.New[string]()
+	downHostIDs := apimachineryutilsets.New[string]()
+	upHostIDs := apimachineryutilsets.New[string]()
+	for _, report := range reports {
+		for _, rack := range report.Racks {
+			for _, node := range rack.Nodes {
+				if node.HostID != nil {
+					reportingHostIDs.Insert(*node.HostID)
+				}
+
+				for _, observedNode := range node.ObservedNodes {
+					switch observedNode.Status {
+					case scyllav1alpha1.NodeStatusUp:
+						upHostIDs.Insert(observedNode.HostID)
+					default:
+						downHostIDs.Insert(observedNode.HostID)
+					}
+				}
+			}
+		}
+	}
+
+	return apimachineryutilsets.List(downHostIDs.Difference(upHostIDs).Difference(reportingHostIDs).Intersection(datacenterHostIDs))
+}
+
+// getObservedHostIDs returns the host IDs of all nodes observed by the reporting nodes.
+func getObservedHostIDs(reports []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport) apimachineryutilsets.Set[string] {
+	observedHostIDs := apimachineryutilsets.New[string]()
+	for _, report := range reports {
+		for _, rack := range report.Racks {
+			for _, node := range rack.Nodes {
+				for _, observedNode := range node.ObservedNodes {
+					observedHostIDs.Insert(observedNode.HostID)
+				}
+			}
+		}
+	}
+
+	return observedHostIDs
+}
+
+// getRemoveNodeHostIDs returns host IDs of nodes the datacenter has to remove from the cluster.
+// Nodes are removed by the first datacenter in the spec.
+func getRemoveNodeHostIDs(sc *scyllav1alpha1.ScyllaDBCluster, dc *scyllav1alpha1.ScyllaDBClusterDatacenter, status *scyllav1alpha1.ScyllaDBClusterStatus) []string {
+	if len(sc.Spec.Datacenters) == 0 || sc.Spec.Datacenters[0].Name != dc.Name {
+		return nil
+	}
+
+	var hostIDs []string
+	for _, dcStatus := range status.Datacenters {
+		if dcStatus.Removal == nil || dcStatus.Removal.Method != scyllav1alpha1.RemoveNodeDatacenterRemovalMethod {
+			continue
+		}
+
+		hostIDs = append(hostIDs, dcStatus.Removal.HostIDs...)
+	}
+
+	return hostIDs
+}
+
+// getDatacenterNodesStatusReports returns the node status reports of the datacenters in the spec,
+// along with the sorted names of datacenters whose report is missing or doesn't identify all of their nodes yet.
+func (scc *Controller) getDatacenterNodesStatusReports(
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	remoteNamespaces map[string]*corev1.Namespace,
+	remoteScyllaDBDatacenters map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter,
+) ([]*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport, []string, error) {
+	var reports []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport
+	var incompleteDatacenterNames []string
+	for _, dc := range sc.Spec.Datacenters {
+		ns, ok := remoteNamespaces[dc.RemoteKubernetesClusterName]
+		if !ok {
+			incompleteDatacenterNames = append(incompleteDatacenterNames, dc.Name)
+			continue
+		}
+
+		sdc, ok := remoteScyllaDBDatacenters[dc.RemoteKubernetesClusterName][naming.ScyllaDBDatacenterName(sc, &dc)]
+		if !ok {
+			incompleteDatacenterNames = append(incompleteDatacenterNames, dc.Name)
+			continue
+		}
+
+		reportName, err := naming.ScyllaDBDatacenterNodesStatusReportName(sdc)
+		if err != nil {
+			return nil, nil, fmt.Errorf("can't get ScyllaDBDatacenterNodesStatusReport name for ScyllaDBDatacenter %q: %w", naming.ObjRef(sdc), err)
+		}
+
+		report, err := scc.remoteScyllaDBDatacenterNodesStatusReportLister.Cluster(dc.RemoteKubernetesClusterName).ScyllaDBDatacenterNodesStatusReports(ns.Name).Get(reportName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				incompleteDatacenterNames = append(incompleteDatacenterNames, dc.Name)
+				continue
+			}
+			return nil, nil, fmt.Errorf("can't get ScyllaDBDatacenterNodesStatusReport %q: %w", naming.ManualRef(ns.Name, reportName), err)
+		}
+
+		if !isNodesStatusReportComplete(report) {
+			incompleteDatacenterNames = append(incompleteDatacenterNames, dc.Name)
+			continue
+		}
+
+		reports = append(reports, report)
+	}
+	sort.Strings(incompleteDatacenterNames)
+
+	return reports, incompleteDatacenterNames, nil
+}
+
+// isNodesStatusReportComplete returns true if the report identifies all of its reporting nodes.
+func isNodesStatusReportComplete(report *scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport) bool {
+	for _, rack := range report.Racks {
+		for _, node := range rack.Nodes {
+			if node.HostID == nil {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// getGossipDatacenterHostIDs returns the sorted host IDs of the nodes which the datacenter nodes know to belong to the gossip datacenter.
+func (scc *Controller) getGossipDatacenterHostIDs(
+	ctx context.Context,
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	dc *scyllav1alpha1.ScyllaDBClusterDatacenter,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	gossipDatacenter string,
+) ([]string, error) {
+	scyllaClient, hosts, err := scc.newRemoteDatacenterScyllaClient(sc, dc, sdc)
+	if err != nil {
+		return nil, err
+	}
+	defer scyllaClient.Close()
+
+	nodes, err := scyllaClient.NodesStatusInfo(ctx, hosts[0])
+	if err != nil {
+		return nil, fmt.Errorf("can't get nodes status: %w", err)
+	}
+
+	var hostIDs []string
+	for _, node := range nodes {
+		nodeDatacenter, err := scyllaClient.GetSnitchDatacenter(ctx, node.Addr)
+		if err != nil {
+			return nil, fmt.Errorf("can't get datacenter of node %q: %w", node.Addr, err)
+		}
+
+		if nodeDatacenter == gossipDatacenter {
+			hostIDs = append(hostIDs, node.HostID)
+		}
+	}
+	sort.Strings(hostIDs)
+
+	return hostIDs, nil
+}
+
+func waitingForNodesStatusReportsCondition(sc *scyllav1alpha1.ScyllaDBCluster, conditionType string, dcName string, incompleteDatacenterNames []string) metav1.Condition {
+	return metav1.Condition{
+		Type:               conditionType,
+		Status:             metav1.ConditionTrue,
+		Reason:             "WaitingForNodesStatusReports",
+		Message:            fmt.Sprintf("Waiting for complete node status reports of datacenter(s) %q to determine the nodes of datacenter %q.", incompleteDatacenterNames, dcName),
+		ObservedGeneration: sc.Generation,
+	}
+}
+
+// syncDatacenterRemoval removes a datacenter that is no longer in the spec from the cluster.
+// Nodes of the datacenter are decommissioned before the remote objects are deleted.
+// When its RemoteKubernetesCluster is deleted, or the datacenter is declared lost, its nodes are removed from a surviving datacenter instead.
+// The nodes to remove are only determined once all remote Kubernetes clusters are available and all datacenters in the spec report their nodes.
+// Objects left in the remote Kubernetes cluster of a lost datacenter are not cleaned up.
+// It returns true once the datacenter is removed.
+func (scc *Controller) syncDatacenterRemoval(
+	ctx context.Context,
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	dcStatus *scyllav1alpha1.ScyllaDBClusterDatacenterStatus,
+	unavailableRemoteKubernetesClusterNames []string,
+	remoteNamespaces map[string]*corev1.Namespace,
+	remoteScyllaDBDatacenters map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter,
+) ([]metav1.Condition, bool, error) {
+	var progressingConditions []metav1.Condition
+
+	dc := &scyllav1alpha1.ScyllaDBClusterDatacenter{
+		Name:                        dcStatus.Name,
+		RemoteKubernetesClusterName: *dcStatus.RemoteKubernetesClusterName,
+	}
+	progressingConditionType := makeRemoteDatacenterRemovalControllerDatacenterProgressingCondition(dc.Name)
+
+	lost := slices.Contains(sc.Spec.LostDatacenters, dc.Name)
+
+	// Failing to get the clients doesn't mean the remote Kubernetes cluster is gone, they may not be registered yet or recover later.
+	// Only a deleted RemoteKubernetesCluster switches the removal to removing the nodes, as decommissioning can't be resumed afterwards.
+	_, err := scc.remoteKubernetesClusterLister.Get(dc.RemoteKubernetesClusterName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return progressingConditions, false, fmt.Errorf("can't get RemoteKubernetesCluster %q: %w", dc.RemoteKubernetesClusterName, err)
+	}
+	remoteKubernetesClusterGone := apierrors.IsNotFound(err)
+
+	if dcStatus.Removal.Method != scyllav1alpha1.RemoveNodeDatacenterRemovalMethod && (lost || remoteKubernetesClusterGone) {
+		// The nodes to remove can only be determined once all surviving datacenters can be relied on to report them.
+		if len(unavailableRemoteKubernetesClusterNames) != 0 {
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               progressingConditionType,
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForRemoteKubernetesClusters",
+				Message:            fmt.Sprintf("Waiting for RemoteKubernetesCluster(s) %q to become available before determining the nodes of datacenter %q.", unavailableRemoteKubernetesClusterNames, dc.Name),
+				ObservedGeneration: sc.Generation,
+			})
+			return progressingConditions, false, nil
+		}
+
+		reports, incompleteDatacenterNames, err := scc.getDatacenterNodesStatusReports(sc, remoteNamespaces, remoteScyllaDBDatacenters)
+		if err != nil {
+			return progressingConditions, false, fmt.Errorf("can't get node status reports: %w", err)
+		}
+		if len(incompleteDatacenterNames) != 0 {
+			progressingConditions = append(progressingConditions, waitingForNodesStatusReportsCondition(sc, progressingConditionType, dc.Name, incompleteDatacenterNames))
+			return progressingConditions, false, nil
+		}
+		if len(reports) == 0 {
+			return progressingConditions, false, fmt.Errorf("can't remove datacenter %q: there are no node status reports of the surviving datacenters to determine its nodes", dc.Name)
+		}
+
+		// Only nodes which the surviving nodes place in the removed datacenter are removed, so down nodes of other datacenters are left alone.
+		survivingDC := &sc.Spec.Datacenters[0]
+		survivingSDC := remoteScyllaDBDatacenters[survivingDC.RemoteKubernetesClusterName][naming.ScyllaDBDatacenterName(sc, survivingDC)]
+		datacenterHostIDs, err := scc.getDatacenterHostIDs(ctx, sc, survivingDC, survivingSDC, dc.Name)
+		if err != nil {
+			return progressingConditions, false, fmt.Errorf("can't get host IDs of datacenter %q: %w", dc.Name, err)
+		}
+
+		dcStatus.Removal.Method = scyllav1alpha1.RemoveNodeDatacenterRemovalMethod
+		dcStatus.Removal.HostIDs = getUnreachableHostIDs(reports, apimachineryutilsets.New(datacenterHostIDs...))
+
+		if lost {
+			klog.V(2).InfoS("Removed datacenter is declared lost, removing its nodes from the surviving datacenters", "ScyllaDBCluster", klog.KObj(sc), "Datacenter", dc.Name, "Cluster", dc.RemoteKubernetesClusterName)
+			scc.eventRecorder.Eventf(sc, corev1.EventTypeWarning, "RemovingLostDatacenterNodes", "Datacenter %q is declared lost, removing host(s) %q", dc.Name, dcStatus.Removal.HostIDs)
+		} else {
+			klog.V(2).InfoS("Remote Kubernetes cluster of removed datacenter is gone, removing its nodes from the surviving datacenters", "ScyllaDBCluster", klog.KObj(sc), "Datacenter", dc.Name, "Cluster", dc.RemoteKubernetesClusterName)
+			scc.eventRecorder.Eventf(sc, corev1.EventTypeNormal, "RemovingDatacenterNodes", "Remote Kubernetes cluster %q of datacenter %q is gone, removing host(s) %q", dc.RemoteKubernetesClusterName, dc.Name, dcStatus.Removal.HostIDs)
+		}
+	}
+
+	if dcStatus.Removal.Method == scyllav1alpha1.RemoveNodeDatacenterRemovalMethod {
+		if len(dcStatus.Removal.HostIDs) > 0 {
+			reports, incompleteDatacenterNames, err := scc.getDatacenterNodesStatusReports(sc, remoteNamespaces, remoteScyllaDBDatacenters)
+			if err != nil {
+				return progressingConditions, false, fmt.Errorf("can't get node status reports: %w", err)
+			}
+			if len(incompleteDatacenterNames) != 0 {
+				progressingConditions = append(progressingConditions, waitingForNodesStatusReportsCondition(sc, progressingConditionType, dc.Name, incompleteDatacenterNames))
+				return progressingConditions, false, nil
+			}
+
+			observedHostIDs := getObservedHostIDs(reports)
+			dcStatus.Removal.HostIDs = slices.DeleteFunc(dcStatus.Removal.HostIDs, func(hostID string) bool {
+				return !observedHostIDs.Has(hostID)
+			})
+		}
+
+		if len(dcStatus.Removal.HostIDs) > 0 {
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               progressingConditionType,
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForNodeRemoval",
+				Message:            fmt.Sprintf("Waiting for host(s) %q of datacenter %q to be removed from the cluster.", dcStatus.Removal.HostIDs, dc.Name),
+				ObservedGeneration: sc.Generation,
+			})
+			return progressingConditions, false, nil
+		}
+
+		if lost || remoteKubernetesClusterGone {
+			// There is nothing left to clean up in the remote Kubernetes cluster that is gone.
+			// Objects in the remote Kubernetes cluster of a lost datacenter are left behind, as it can't be relied on to respond.
+			scc.eventRecorder.Eventf(sc, corev1.EventTypeNormal, "DatacenterRemoved", "Datacenter %q was removed from the cluster", dc.Name)
+			return progressingConditions, true, nil
+		}
+	}
+
+	kubeClusterClient, scyllaClusterClient, err := scc.getClusterClients(dc.RemoteKubernetesClusterName)
+	if err != nil {
+		return progressingConditions, false, fmt.Errorf("can't get cluster %q clients: %w", dc.RemoteKubernetesClusterName, err)
+	}
+
+	remoteNamespaceList, err := scc.remoteNamespaceLister.Cluster(dc.RemoteKubernetesClusterName).List(labels.SelectorFromSet(naming.ScyllaDBClusterDatacenterSelectorLabels(sc, dc)))
+	if err != nil {
+		return progressingConditions, false, fmt.Errorf("can't list remote namespaces in %q cluster: %w", dc.RemoteKubernetesClusterName, err)
+	}
+	sort.Slice(remoteNamespaceList, func(i, j int) bool {
+		return remoteNamespaceList[i].Name < remoteNamespaceList[j].Name
+	})
+
+	for _, ns := range remoteNamespaceList {
+		sdc, err := scc.remoteScyllaDBDatacenterLister.Cluster(dc.RemoteKubernetesClusterName).ScyllaDBDatacenters(ns.Name).Get(naming.ScyllaDBDatacenterName(sc, dc))
+		if err != nil && !apierrors.IsNotFound(err) {
+			return progressingConditions, false, fmt.Errorf("can't get ScyllaDBDatacenter: %w", err)
+		}
+
+		if err == nil {
+			if sdc.DeletionTimestamp != nil {
+				progressingConditions = append(progressingConditions, metav1.Condition{
+					Type:               progressingConditionType,
+					Status:             metav1.ConditionTrue,
+					Reason:             "WaitingForScyllaDBDatacenterDeletion",
+					Message:            fmt.Sprintf("Waiting for ScyllaDBDatacenter %q to be deleted from %q Cluster.", naming.ObjRef(sdc), dc.RemoteKubernetesClusterName),
+					ObservedGeneration: sc.Generation,
+				})
+				return progressingConditions, false, nil
+			}
+
+			if dcStatus.Removal.Method == scyllav1alpha1.DecommissionDatacenterRemovalMethod {
+				decommissionProgressingConditions, err := scc.decommissionRemovedDatacenter(ctx, sc, dc, sdc, unavailableRemoteKubernetesClusterNames)
+				progressingConditions = append(progressingConditions, decommissionProgressingConditions...)
+				if err != nil || len(progressingConditions) != 0 {
+					return progressingConditions, false, err
+				}
+			}
+
+			controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, progressingConditionType, sdc, "delete", sc.Generation)
+			err = scyllaClusterClient.ScyllaV1alpha1().ScyllaDBDatacenters(sdc.Namespace).Delete(ctx, sdc.Name, metav1.DeleteOptions{
+				Preconditions: &metav1.Preconditions{
+					UID: &sdc.UID,
+				},
+				PropagationPolicy: pointer.Ptr(metav1.DeletePropagationForeground),
+			})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return progressingConditions, false, fmt.Errorf("can't delete ScyllaDBDatacenter %q: %w", naming.ObjRef(sdc), err)
+			}
+			scc.eventRecorder.Eventf(sc, corev1.EventTypeNormal, "DeletingRemovedDatacenter", "Deleting ScyllaDBDatacenter %q of removed datacenter %q from %q Cluster", naming.ObjRef(sdc), dc.Name, dc.RemoteKubernetesClusterName)
+
+			return progressingConditions, false, nil
+		}
+
+		remoteOwners, err := scc.remoteRemoteOwnerLister.Cluster(dc.RemoteKubernetesClusterName).RemoteOwners(ns.Name).List(labels.SelectorFromSet(naming.RemoteOwnerSelectorLabels(sc, dc)))
+		if err != nil {
+			return progressingConditions, false, fmt.Errorf("can't list remote remoteowners in %q cluster: %w", dc.RemoteKubernetesClusterName, err)
+		}
+
+		for _, ro := range remoteOwners {
+			if ro.DeletionTimestamp != nil {
+				continue
+			}
+
+			controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, progressingConditionType, ro, "delete", sc.Generation)
+			err = scyllaClusterClient.ScyllaV1alpha1().RemoteOwners(ro.Namespace).Delete(ctx, ro.Name, metav1.DeleteOptions{
+				Preconditions: &metav1.Preconditions{
+					UID: &ro.UID,
+				},
+				PropagationPolicy: pointer.Ptr(metav1.DeletePropagationForeground),
+			})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return progressingConditions, false, fmt.Errorf("can't delete RemoteOwner %q: %w", naming.ObjRef(ro), err)
+			}
+		}
+
+		if ns.DeletionTimestamp == nil {
+			controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, progressingConditionType, ns, "delete", sc.Generation)
+			err = kubeClusterClient.CoreV1().Namespaces().Delete(ctx, ns.Name, metav1.DeleteOptions{
+				Preconditions: &metav1.Preconditions{
+					UID: &ns.UID,
+				},
+				PropagationPolicy: pointer.Ptr(metav1.DeletePropagationForeground),
+			})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return progressingConditions, false, fmt.Errorf("can't delete Namespace %q: %w", ns.Name, err)
+			}
+		}
+
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               progressingConditionType,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForNamespaceDeletion",
+			Message:            fmt.Sprintf("Waiting for Namespace %q to be deleted from %q Cluster.", ns.Name, dc.RemoteKubernetesClusterName),
+			ObservedGeneration: sc.Generation,
+		})
+	}
+
+	if len(progressingConditions) != 0 {
+		return progressingConditions, false, nil
+	}
+
+	scc.eventRecorder.Eventf(sc, corev1.EventTypeNormal, "DatacenterRemoved", "Datacenter %q was removed from the cluster", dc.Name)
+
+	return progressingConditions, true, nil
+}
+
+// newRemoteDatacenterScyllaClient creates a ScyllaDB client for the nodes of the datacenter and returns it along with their hosts.
+func (scc *Controller) newRemoteDatacenterScyllaClient(
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	dc *scyllav1alpha1.ScyllaDBClusterDatacenter,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+) (*scyllaclient.Client, []string, error) {
+	serviceList, err := scc.remoteServiceLister.Cluster(dc.RemoteKubernetesClusterName).Services(sdc.Namespace).List(labels.SelectorFromSet(naming.ClusterLabels(sdc)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't list services of ScyllaDBDatacenter %q in %q cluster: %w", naming.ObjRef(sdc), dc.RemoteKubernetesClusterName, err)
+	}
+	services := make(map[string]*corev1.Service, len(serviceList))
+	for _, svc := range serviceList {
+		services[svc.Name] = svc
+	}
+
+	hosts, err := controllerhelpers.GetRequiredScyllaHosts(sdc, services, scc.remotePodLister.Cluster(dc.RemoteKubernetesClusterName))
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't get hosts of ScyllaDBDatacenter %q: %w", naming.ObjRef(sdc), err)
+	}
+	if len(hosts) == 0 {
+		return nil, nil, fmt.Errorf("ScyllaDBDatacenter %q has no nodes", naming.ObjRef(sdc))
+	}
+
+	agentAuthTokenSecretName, err := naming.ScyllaDBManagerAgentAuthTokenSecretNameForScyllaDBCluster(sc)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't get ScyllaDB Manager agent auth token secret name: %w", err)
+	}
+	agentAuthTokenSecret, err := scc.secretLister.Secrets(sc.Namespace).Get(agentAuthTokenSecretName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't get secret %q: %w", naming.ManualRef(sc.Namespace, agentAuthTokenSecretName), err)
+	}
+	authToken, err := helpers.GetAgentAuthTokenFromSecret(agentAuthTokenSecret)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't get agent auth token from secret %q: %w", naming.ObjRef(agentAuthTokenSecret), err)
+	}
+
+	scyllaClient, err := controllerhelpers.NewScyllaClientFromToken(hosts, authToken)
+	if err != nil {
+		return nil, nil, fmt.Errorf("can't create scylla client: %w", err)
+	}
+
+	return scyllaClient, hosts, nil
+}
+
+// getRemoteDatacenterReplicatedKeyspaces returns the keyspaces which still replicate to the datacenter,
+// as reported by the ScyllaDB API of its nodes.
+func (scc *Controller) getRemoteDatacenterReplicatedKeyspaces(
+	ctx context.Context,
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	dc *scyllav1alpha1.ScyllaDBClusterDatacenter,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+) ([]string, error) {
+	scyllaClient, hosts, err := scc.newRemoteDatacenterScyllaClient(sc, dc, sdc)
+	if err != nil {
+		return nil, err
+	}
+	defer scyllaClient.Close()
+
+	replicationFactors, err := controllerhelpers.GetDatacenterReplicationFactors(ctx, scyllaClient, hosts[0], naming.GetScyllaDBDatacenterGossipDatacenterName(sdc))
+	if err != nil {
+		return nil, err
+	}
+
+	return controllerhelpers.GetUnderReplicatedKeyspaces(0, replicationFactors), nil
+}
+
+// decommissionRemovedDatacenter scales the ScyllaDBDatacenter of a removed datacenter down to zero nodes
+// and waits for its nodes to be decommissioned.
+// Decommissioning doesn't start while any of the remote Kubernetes clusters is unavailable,
+// or while any keyspace still replicates to the datacenter, in which case the removal is reported as blocked.
+func (scc *Controller) decommissionRemovedDatacenter(
+	ctx context.Context,
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	dc *scyllav1alpha1.ScyllaDBClusterDatacenter,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	unavailableRemoteKubernetesClusterNames []string,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+	progressingConditionType := makeRemoteDatacenterRemovalControllerDatacenterProgressingCondition(dc.Name)
+
+	if !controllerhelpers.IsScyllaDBDatacenterScaledToZero(sdc) {
+		if len(unavailableRemoteKubernetesClusterNames) != 0 {
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               progressingConditionType,
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForRemoteKubernetesClusters",
+				Message:            fmt.Sprintf("Waiting for RemoteKubernetesCluster(s) %q to become available before decommissioning datacenter %q.", unavailableRemoteKubernetesClusterNames, dc.Name),
+				ObservedGeneration: sc.Generation,
+			})
+			return progressingConditions, nil
+		}
+
+		replicatedKeyspaces, err := scc.getDatacenterReplicatedKeyspaces(ctx, sc, dc, sdc)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't verify replication of datacenter %q: %w", dc.Name, err)
+		}
+		if len(replicatedKeyspaces) != 0 {
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               progressingConditionType,
+				Status:             metav1.ConditionTrue,
+				Reason:             "DatacenterRemovalBlocked",
+				Message:            fmt.Sprintf("Removal of datacenter %q is blocked: keyspace(s) %q still replicate to it, their replication has to be altered first.", dc.Name, replicatedKeyspaces),
+				ObservedGeneration: sc.Generation,
+			})
+			return progressingConditions, nil
+		}
+
+		sdcCopy := sdc.DeepCopy()
+		if sdcCopy.Spec.RackTemplate != nil && sdcCopy.Spec.RackTemplate.Nodes != nil {
+			sdcCopy.Spec.RackTemplate.Nodes = pointer.Ptr[int32](0)
+		}
+		for i := range sdcCopy.Spec.Racks {
+			sdcCopy.Spec.Racks[i].Nodes = pointer.Ptr[int32](0)
+		}
+
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, progressingConditionType, sdcCopy, "update", sc.Generation)
+		scyllaClusterClient, err := scc.scyllaRemoteClient.Cluster(dc.RemoteKubernetesClusterName)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't get client to %q cluster: %w", dc.RemoteKubernetesClusterName, err)
+		}
+
+		_, err = scyllaClusterClient.ScyllaV1alpha1().ScyllaDBDatacenters(sdcCopy.Namespace).Update(ctx, sdcCopy, metav1.UpdateOptions{})
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't scale ScyllaDBDatacenter %q down: %w", naming.ObjRef(sdc), err)
+		}
+		scc.eventRecorder.Eventf(sc, corev1.EventTypeNormal, "DecommissioningRemovedDatacenter", "Decommissioning nodes of removed datacenter %q", dc.Name)
+
+		return progressingConditions, nil
+	}
+
+	degradedCondition := apimeta.FindStatusCondition(sdc.Status.Conditions, scyllav1alpha1.DegradedCondition)
+	if degradedCondition != nil && degradedCondition.Status == metav1.ConditionTrue {
+		return progressingConditions, fmt.Errorf("removal of datacenter %q is blocked: ScyllaDBDatacenter %q is degraded: %s", dc.Name, naming.ObjRef(sdc), degradedCondition.Message)
+	}
+
+	if sdc.Status.ObservedGeneration == nil || *sdc.Status.ObservedGeneration < sdc.Generation || sdc.Status.CurrentNodes == nil || *sdc.Status.CurrentNodes != 0 {
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               progressingConditionType,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForDatacenterDecommission",
+			Message:            fmt.Sprintf("Waiting for nodes of ScyllaDBDatacenter %q to be decommissioned.", naming.ObjRef(sdc)),
+			ObservedGeneration: sc.Generation,
+		})
+		return progressingConditions, nil
+	}
+
+	return progressingConditions, nil
+}
+
+// formatHostIDs formats the host IDs as the value of RemoveNodeHostIDsAnnotation.
+func formatHostIDs(hostIDs []string) string {
+	return strings.Join(hostIDs, ",")
+}
@@ -0,0 +1,677 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbcluster
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scyllaclient "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned"
+	scyllafake "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned/fake"
+	scyllav1alpha1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	remoteclient "github.com/scylladb/scylla-operator/pkg/remoteclient/client"
+	remotelister "github.com/scylladb/scylla-operator/pkg/remoteclient/lister"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryutilsets "k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+func newNodesStatusReport(dcName string, nodes map[string][]scyllav1alpha1.ObservedNodeStatus) *scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport {
+	report := &scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
+		DatacenterName: dcName,
+		Racks: []scyllav1alpha1.RackNodesStatusReport{
+			{
+				Name: "a",
+			},
+		},
+	}
+
+	ordinal := 0
+	for hostID, observedNodes := range nodes {
+		report.Racks[0].Nodes = append(report.Racks[0].Nodes, scyllav1alpha1.NodeStatusReport{
+			Ordinal:       ordinal,
+			HostID:        pointer.Ptr(hostID),
+			ObservedNodes: observedNodes,
+		})
+		ordinal++
+	}
+
+	return report
+}
+
+func Test_getUnreachableHostIDs(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name              string
+		reports           []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport
+		datacenterHostIDs []string
+		expected          []string
+	}{
+		{
+			name:              "no reports",
+			reports:           nil,
+			datacenterHostIDs: []string{"dc3-node1", "dc3-node2"},
+			expected:          []string{},
+		},
+		{
+			name: "all nodes are up",
+			reports: []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
+				newNodesStatusReport("dc1", map[string][]scyllav1alpha1.ObservedNodeStatus{
+					"dc1-node1": {
+						{HostID: "dc1-node1", Status: scyllav1alpha1.NodeStatusUp},
+						{HostID: "dc2-node1", Status: scyllav1alpha1.NodeStatusUp},
+					},
+				}),
+			},
+			datacenterHostIDs: []string{"dc3-node1", "dc3-node2"},
+			expected:          []string{},
+		},
+		{
+			name: "nodes down for all reporters are returned in order",
+			reports: []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
+				newNodesStatusReport("dc1", map[string][]scyllav1alpha1.ObservedNodeStatus{
+					"dc1-node1": {
+						{HostID: "dc1-node1", Status: scyllav1alpha1.NodeStatusUp},
+						{HostID: "dc3-node2", Status: scyllav1alpha1.NodeStatusDown},
+						{HostID: "dc3-node1", Status: scyllav1alpha1.NodeStatusDown},
+					},
+				}),
+				newNodesStatusReport("dc2", map[string][]scyllav1alpha1.ObservedNodeStatus{
+					"dc2-node1": {
+						{HostID: "dc1-node1", Status: scyllav1alpha1.NodeStatusUp},
+						{HostID: "dc2-node1", Status: scyllav1alpha1.NodeStatusUp},
+						{HostID: "dc3-node1", Status: scyllav1alpha1.NodeStatusDown},
+						{HostID: "dc3-node2", Status: scyllav1alpha1.NodeStatusDown},
+					},
+				}),
+			},
+			datacenterHostIDs: []string{"dc3-node1", "dc3-node2"},
+			expected:          []string{"dc3-node1", "dc3-node2"},
+		},
+		{
+			name: "nodes seen up by any reporter are not returned",
+			reports: []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
+				newNodesStatusReport("dc1", map[string][]scyllav1alpha1.ObservedNodeStatus{
+					"dc1-node1": {
+						{HostID: "dc3-node1", Status: scyllav1alpha1.NodeStatusDown},
+						{HostID: "dc3-node2", Status: scyllav1alpha1.NodeStatusDown},
+					},
+				}),
+				newNodesStatusReport("dc2", map[string][]scyllav1alpha1.ObservedNodeStatus{
+					"dc2-node1": {
+						{HostID: "dc3-node1", Status: scyllav1alpha1.NodeStatusUp},
+						{HostID: "dc3-node2", Status: scyllav1alpha1.NodeStatusDown},
+					},
+				}),
+			},
+			datacenterHostIDs: []string{"dc3-node1", "dc3-node2"},
+			expected:          []string{"dc3-node2"},
+		},
+		{
+			name: "reporting nodes are not returned even when observed down",
+			reports: []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
+				newNodesStatusReport("dc1", map[string][]scyllav1alpha1.ObservedNodeStatus{
+					"dc1-node1": {
+						{HostID: "dc1-node2", Status: scyllav1alpha1.NodeStatusDown},
+						{HostID: "dc3-node1", Status: scyllav1alpha1.NodeStatusDown},
+					},
+					"dc1-node2": {
+						{HostID: "dc3-node1", Status: scyllav1alpha1.NodeStatusDown},
+					},
+				}),
+			},
+			datacenterHostIDs: []string{"dc3-node1", "dc3-node2"},
+			expected:          []string{"dc3-node1"},
+		},
+		{
+			name: "down nodes of other datacenters are not returned",
+			reports: []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport{
+				newNodesStatusReport("dc1", map[string][]scyllav1alpha1.ObservedNodeStatus{
+					"dc1-node1": {
+						{HostID: "dc1-node1", Status: scyllav1alpha1.NodeStatusUp},
+						{HostID: "dc2-node1", Status: scyllav1alpha1.NodeStatusDown},
+						{HostID: "dc3-node1", Status: scyllav1alpha1.NodeStatusDown},
+					},
+				}),
+			},
+			datacenterHostIDs: []string{"dc3-node1"},
+			expected:          []string{"dc3-node1"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getUnreachableHostIDs(tc.reports, apimachineryutilsets.New(tc.datacenterHostIDs...))
+			if !cmp.Equal(got, tc.expected) {
+				t.Errorf("expected and got host IDs differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func Test_getRemoveNodeHostIDs(t *testing.T) {
+	t.Parallel()
+
+	sc := newUpgradeTestScyllaDBCluster("scylladb/scylla:2025.1.0", nil)
+	status := &scyllav1alpha1.ScyllaDBClusterStatus{
+		Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+			{
+				Name: "dc1",
+			},
+			{
+				Name: "dc4",
+				Removal: &scyllav1alpha1.DatacenterRemovalStatus{
+					Method: scyllav1alpha1.DecommissionDatacenterRemovalMethod,
+				},
+			},
+			{
+				Name: "dc5",
+				Removal: &scyllav1alpha1.DatacenterRemovalStatus{
+					Method:  scyllav1alpha1.RemoveNodeDatacenterRemovalMethod,
+					HostIDs: []string{"dc5-node1", "dc5-node2"},
+				},
+			},
+		},
+	}
+
+	tt := []struct {
+		name     string
+		dc       *scyllav1alpha1.ScyllaDBClusterDatacenter
+		expected []string
+	}{
+		{
+			name:     "first datacenter removes the nodes",
+			dc:       &sc.Spec.Datacenters[0],
+			expected: []string{"dc5-node1", "dc5-node2"},
+		},
+		{
+			name:     "other datacenters don't remove nodes",
+			dc:       &sc.Spec.Datacenters[1],
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getRemoveNodeHostIDs(sc, tc.dc, status)
+			if !cmp.Equal(got, tc.expected) {
+				t.Errorf("expected and got host IDs differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func TestController_syncDatacenterRemoval(t *testing.T) {
+	t.Parallel()
+
+	sc := newUpgradeTestScyllaDBCluster("scylladb/scylla:2025.1.0", nil)
+	dc := &scyllav1alpha1.ScyllaDBClusterDatacenter{
+		Name:                        "dc4",
+		RemoteKubernetesClusterName: "dc4-rkc",
+	}
+
+	rkc := &scyllav1alpha1.RemoteKubernetesCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: dc.RemoteKubernetesClusterName,
+		},
+	}
+	remoteNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "scylla-dc4",
+			Labels: naming.ScyllaDBClusterDatacenterSelectorLabels(sc, dc),
+		},
+	}
+	sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      naming.ScyllaDBDatacenterName(sc, dc),
+			Namespace: remoteNamespace.Name,
+		},
+		Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+			Racks: []scyllav1alpha1.RackSpec{
+				{
+					Name: "a",
+					RackTemplate: scyllav1alpha1.RackTemplate{
+						Nodes: pointer.Ptr[int32](3),
+					},
+				},
+			},
+		},
+	}
+
+	// The nodes of the removed datacenter are still up, as observed by the surviving datacenter.
+	survivingDC := &sc.Spec.Datacenters[0]
+	survivingNamespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "scylla-dc1",
+		},
+	}
+	survivingSDC := &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      naming.ScyllaDBDatacenterName(sc, survivingDC),
+			Namespace: survivingNamespace.Name,
+		},
+	}
+	reportName, err := naming.ScyllaDBDatacenterNodesStatusReportName(survivingSDC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report := newNodesStatusReport(survivingDC.Name, map[string][]scyllav1alpha1.ObservedNodeStatus{
+		"dc1-node1": {
+			{HostID: "dc1-node1", Status: scyllav1alpha1.NodeStatusUp},
+			{HostID: "dc4-node1", Status: scyllav1alpha1.NodeStatusUp},
+		},
+	})
+	report.Name = reportName
+	report.Namespace = survivingNamespace.Name
+	remoteNamespaces := map[string]*corev1.Namespace{
+		survivingDC.RemoteKubernetesClusterName: survivingNamespace,
+	}
+	remoteScyllaDBDatacenters := map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter{
+		survivingDC.RemoteKubernetesClusterName: {
+			survivingSDC.Name: survivingSDC,
+		},
+	}
+
+	rkcCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	namespaceCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	sdcCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	reportCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, obj := range []struct {
+		indexer cache.Indexer
+		obj     interface{}
+	}{
+		{indexer: rkcCache, obj: rkc},
+		{indexer: namespaceCache, obj: remoteNamespace},
+		{indexer: sdcCache, obj: sdc},
+		{indexer: reportCache, obj: report},
+	} {
+		err = obj.indexer.Add(obj.obj)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	kubeClient := kubefake.NewSimpleClientset(remoteNamespace)
+	scyllaClient := scyllafake.NewSimpleClientset(sdc)
+	kubeRemoteClient := remoteclient.NewClusterClient(func(config []byte) (kubernetes.Interface, error) {
+		return kubeClient, nil
+	})
+	scyllaRemoteClient := remoteclient.NewClusterClient(func(config []byte) (scyllaclient.Interface, error) {
+		return scyllaClient, nil
+	})
+
+	scc := &Controller{
+		kubeRemoteClient:              kubeRemoteClient,
+		scyllaRemoteClient:            scyllaRemoteClient,
+		remoteKubernetesClusterLister: scyllav1alpha1listers.NewRemoteKubernetesClusterLister(rkcCache),
+		remoteNamespaceLister: remotelister.NewClusterLister(corev1listers.NewNamespaceLister, func(string) cache.Indexer {
+			return namespaceCache
+		}),
+		remoteScyllaDBDatacenterLister: remotelister.NewClusterLister(scyllav1alpha1listers.NewScyllaDBDatacenterLister, func(string) cache.Indexer {
+			return sdcCache
+		}),
+		remoteScyllaDBDatacenterNodesStatusReportLister: remotelister.NewClusterLister(scyllav1alpha1listers.NewScyllaDBDatacenterNodesStatusReportLister, func(string) cache.Indexer {
+			return reportCache
+		}),
+		getDatacenterReplicatedKeyspaces: func(context.Context, *scyllav1alpha1.ScyllaDBCluster, *scyllav1alpha1.ScyllaDBClusterDatacenter, *scyllav1alpha1.ScyllaDBDatacenter) ([]string, error) {
+			return nil, nil
+		},
+		eventRecorder: record.NewFakeRecorder(10),
+	}
+
+	dcStatus := &scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+		Name:                        dc.Name,
+		RemoteKubernetesClusterName: pointer.Ptr(dc.RemoteKubernetesClusterName),
+		Removal: &scyllav1alpha1.DatacenterRemovalStatus{
+			Method: scyllav1alpha1.DecommissionDatacenterRemovalMethod,
+		},
+	}
+	expectedDCStatus := dcStatus.DeepCopy()
+
+	// The clients of the remote Kubernetes cluster aren't registered yet, which doesn't mean the cluster is gone.
+	_, removed, err := scc.syncDatacenterRemoval(context.Background(), sc, dcStatus, nil, remoteNamespaces, remoteScyllaDBDatacenters)
+	if err == nil {
+		t.Errorf("expected an error, got nil")
+	}
+	if removed {
+		t.Errorf("expected the datacenter not to be removed")
+	}
+	if !cmp.Equal(dcStatus, expectedDCStatus) {
+		t.Errorf("expected and got datacenter status differ:\n%s", cmp.Diff(expectedDCStatus, dcStatus))
+	}
+	if len(scyllaClient.Actions()) != 0 {
+		t.Errorf("expected no actions, got %v", scyllaClient.Actions())
+	}
+
+	// Once the clients recover, the nodes of the datacenter are decommissioned.
+	for _, c := range []remoteclient.DynamicClusterInterface{kubeRemoteClient, scyllaRemoteClient} {
+		err = c.UpdateCluster(dc.RemoteKubernetesClusterName, []byte("config"))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	progressingConditions, removed, err := scc.syncDatacenterRemoval(context.Background(), sc, dcStatus, nil, remoteNamespaces, remoteScyllaDBDatacenters)
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if removed {
+		t.Errorf("expected the datacenter not to be removed")
+	}
+	if len(progressingConditions) == 0 {
+		t.Errorf("expected progressing conditions, got none")
+	}
+	if !cmp.Equal(dcStatus, expectedDCStatus) {
+		t.Errorf("expected and got datacenter status differ:\n%s", cmp.Diff(expectedDCStatus, dcStatus))
+	}
+
+	var gotActions []string
+	for _, action := range scyllaClient.Actions() {
+		gotActions = append(gotActions, fmt.Sprintf("%s %s", action.GetVerb(), action.GetResource().Resource))
+	}
+	expectedActions := []string{"update scylladbdatacenters"}
+	if !cmp.Equal(gotActions, expectedActions) {
+		t.Errorf("expected and got actions differ:\n%s", cmp.Diff(expectedActions, gotActions))
+	}
+
+	var gotNodes []int32
+	updatedSDC, err := scyllaClient.ScyllaV1alpha1().ScyllaDBDatacenters(sdc.Namespace).Get(context.Background(), sdc.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rack := range updatedSDC.Spec.Racks {
+		gotNodes = append(gotNodes, *rack.Nodes)
+	}
+	expectedNodes := []int32{0}
+	if !cmp.Equal(gotNodes, expectedNodes) {
+		t.Errorf("expected and got rack nodes differ:\n%s", cmp.Diff(expectedNodes, gotNodes))
+	}
+}
+
+func TestController_decommissionRemovedDatacenter(t *testing.T) {
+	t.Parallel()
+
+	sc := newUpgradeTestScyllaDBCluster("scylladb/scylla:2025.1.0", nil)
+	dc := &scyllav1alpha1.ScyllaDBClusterDatacenter{
+		Name:                        "dc4",
+		RemoteKubernetesClusterName: "dc4-rkc",
+	}
+	sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      naming.ScyllaDBDatacenterName(sc, dc),
+			Namespace: "scylla-dc4",
+		},
+		Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+			Racks: []scyllav1alpha1.RackSpec{
+				{
+					Name: "a",
+					RackTemplate: scyllav1alpha1.RackTemplate{
+						Nodes: pointer.Ptr[int32](3),
+					},
+				},
+			},
+		},
+	}
+
+	tt := []struct {
+		name                                    string
+		unavailableRemoteKubernetesClusterNames []string
+		replicatedKeyspaces                     []string
+		replicatedKeyspacesErr                  error
+		expectedProgressingReasons              []string
+		expectedActions                         []string
+		expectedErr                             error
+	}{
+		{
+			name:                                    "waits for unavailable remote Kubernetes clusters",
+			unavailableRemoteKubernetesClusterNames: []string{"dc2-rkc"},
+			expectedProgressingReasons:              []string{"WaitingForRemoteKubernetesClusters"},
+			expectedActions:                         nil,
+			expectedErr:                             nil,
+		},
+		{
+			name:                       "removal is blocked while keyspaces replicate to the datacenter",
+			replicatedKeyspaces:        []string{"ks1", "ks2"},
+			expectedProgressingReasons: []string{"DatacenterRemovalBlocked"},
+			expectedActions:            nil,
+			expectedErr:                nil,
+		},
+		{
+			name:                       "replication can't be verified",
+			replicatedKeyspacesErr:     errors.New("connection refused"),
+			expectedProgressingReasons: nil,
+			expectedActions:            nil,
+			expectedErr:                fmt.Errorf(`can't verify replication of datacenter "dc4": %w`, errors.New("connection refused")),
+		},
+		{
+			name:                       "datacenter is scaled down when no keyspace replicates to it",
+			expectedProgressingReasons: []string{"Progressing"},
+			expectedActions:            []string{"update scylladbdatacenters"},
+			expectedErr:                nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			scyllaClient := scyllafake.NewSimpleClientset(sdc.DeepCopy())
+			scyllaRemoteClient := remoteclient.NewClusterClient(func(config []byte) (scyllaclient.Interface, error) {
+				return scyllaClient, nil
+			})
+			err := scyllaRemoteClient.UpdateCluster(dc.RemoteKubernetesClusterName, []byte("config"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			scc := &Controller{
+				scyllaRemoteClient: scyllaRemoteClient,
+				getDatacenterReplicatedKeyspaces: func(context.Context, *scyllav1alpha1.ScyllaDBCluster, *scyllav1alpha1.ScyllaDBClusterDatacenter, *scyllav1alpha1.ScyllaDBDatacenter) ([]string, error) {
+					return tc.replicatedKeyspaces, tc.replicatedKeyspacesErr
+				},
+				eventRecorder: record.NewFakeRecorder(10),
+			}
+
+			progressingConditions, err := scc.decommissionRemovedDatacenter(context.Background(), sc, dc, sdc, tc.unavailableRemoteKubernetesClusterNames)
+			if !reflect.DeepEqual(err, tc.expectedErr) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			var gotProgressingReasons []string
+			for _, c := range progressingConditions {
+				gotProgressingReasons = append(gotProgressingReasons, c.Reason)
+			}
+			if !cmp.Equal(gotProgressingReasons, tc.expectedProgressingReasons) {
+				t.Errorf("expected and got progressing reasons differ:\n%s", cmp.Diff(tc.expectedProgressingReasons, gotProgressingReasons))
+			}
+
+			var gotActions []string
+			for _, action := range scyllaClient.Actions() {
+				gotActions = append(gotActions, fmt.Sprintf("%s %s", action.GetVerb(), action.GetResource().Resource))
+			}
+			if !cmp.Equal(gotActions, tc.expectedActions) {
+				t.Errorf("expected and got actions differ:\n%s", cmp.Diff(tc.expectedActions, gotActions))
+			}
+		})
+	}
+}
+
+func TestController_syncDatacenterRemovalOfLostDatacenter(t *testing.T) {
+	t.Parallel()
+
+	newReports := func(sc *scyllav1alpha1.ScyllaDBCluster) ([]*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport, map[string]*corev1.Namespace, map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter) {
+		// A node of dc2 is down too, but it is not a node of the lost datacenter.
+		observedNodes := []scyllav1alpha1.ObservedNodeStatus{
+			{HostID: "dc1-node1", Status: scyllav1alpha1.NodeStatusUp},
+			{HostID: "dc2-node1", Status: scyllav1alpha1.NodeStatusUp},
+			{HostID: "dc2-node2", Status: scyllav1alpha1.NodeStatusDown},
+			{HostID: "dc3-node1", Status: scyllav1alpha1.NodeStatusUp},
+			{HostID: "dc4-node1", Status: scyllav1alpha1.NodeStatusDown},
+			{HostID: "dc4-node2", Status: scyllav1alpha1.NodeStatusDown},
+		}
+
+		var reports []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport
+		remoteNamespaces := map[string]*corev1.Namespace{}
+		remoteScyllaDBDatacenters := map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter{}
+		for _, dc := range sc.Spec.Datacenters {
+			ns := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: fmt.Sprintf("scylla-%s", dc.Name),
+				},
+			}
+			sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      naming.ScyllaDBDatacenterName(sc, &dc),
+					Namespace: ns.Name,
+				},
+			}
+			reportName, err := naming.ScyllaDBDatacenterNodesStatusReportName(sdc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			report := newNodesStatusReport(dc.Name, map[string][]scyllav1alpha1.ObservedNodeStatus{
+				fmt.Sprintf("%s-node1", dc.Name): observedNodes,
+			})
+			report.Name = reportName
+			report.Namespace = ns.Name
+
+			reports = append(reports, report)
+			remoteNamespaces[dc.RemoteKubernetesClusterName] = ns
+			remoteScyllaDBDatacenters[dc.RemoteKubernetesClusterName] = map[string]*scyllav1alpha1.ScyllaDBDatacenter{
+				sdc.Name: sdc,
+			}
+		}
+
+		return reports, remoteNamespaces, remoteScyllaDBDatacenters
+	}
+
+	tt := []struct {
+		name                                    string
+		unavailableRemoteKubernetesClusterNames []string
+		modifyReports                           func([]*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport) []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport
+		expectedProgressingReasons              []string
+		expectedRemoval                         *scyllav1alpha1.DatacenterRemovalStatus
+	}{
+		{
+			name:                                    "waits for unavailable remote Kubernetes clusters",
+			unavailableRemoteKubernetesClusterNames: []string{"dc2-rkc"},
+			expectedProgressingReasons:              []string{"WaitingForRemoteKubernetesClusters"},
+			expectedRemoval: &scyllav1alpha1.DatacenterRemovalStatus{
+				Method: scyllav1alpha1.DecommissionDatacenterRemovalMethod,
+			},
+		},
+		{
+			name: "waits for missing node status reports",
+			modifyReports: func(reports []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport) []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport {
+				return reports[:2]
+			},
+			expectedProgressingReasons: []string{"WaitingForNodesStatusReports"},
+			expectedRemoval: &scyllav1alpha1.DatacenterRemovalStatus{
+				Method: scyllav1alpha1.DecommissionDatacenterRemovalMethod,
+			},
+		},
+		{
+			name: "waits for node status reports identifying all nodes",
+			modifyReports: func(reports []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport) []*scyllav1alpha1.ScyllaDBDatacenterNodesStatusReport {
+				reports[1].Racks[0].Nodes = append(reports[1].Racks[0].Nodes, scyllav1alpha1.NodeStatusReport{
+					Ordinal: 1,
+				})
+				return reports
+			},
+			expectedProgressingReasons: []string{"WaitingForNodesStatusReports"},
+			expectedRemoval: &scyllav1alpha1.DatacenterRemovalStatus{
+				Method: scyllav1alpha1.DecommissionDatacenterRemovalMethod,
+			},
+		},
+		{
+			name:                       "removes only the nodes of the lost datacenter",
+			expectedProgressingReasons: []string{"WaitingForNodeRemoval"},
+			expectedRemoval: &scyllav1alpha1.DatacenterRemovalStatus{
+				Method:  scyllav1alpha1.RemoveNodeDatacenterRemovalMethod,
+				HostIDs: []string{"dc4-node1", "dc4-node2"},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sc := newUpgradeTestScyllaDBCluster("scylladb/scylla:2025.1.0", nil)
+			sc.Spec.LostDatacenters = []string{"dc4"}
+
+			reports, remoteNamespaces, remoteScyllaDBDatacenters := newReports(sc)
+			if tc.modifyReports != nil {
+				reports = tc.modifyReports(reports)
+			}
+
+			rkcCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			reportCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			for _, report := range reports {
+				err := reportCache.Add(report)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			scc := &Controller{
+				remoteKubernetesClusterLister: scyllav1alpha1listers.NewRemoteKubernetesClusterLister(rkcCache),
+				remoteScyllaDBDatacenterNodesStatusReportLister: remotelister.NewClusterLister(scyllav1alpha1listers.NewScyllaDBDatacenterNodesStatusReportLister, func(string) cache.Indexer {
+					return reportCache
+				}),
+				getDatacenterHostIDs: func(_ context.Context, _ *scyllav1alpha1.ScyllaDBCluster, _ *scyllav1alpha1.ScyllaDBClusterDatacenter, _ *scyllav1alpha1.ScyllaDBDatacenter, gossipDatacenter string) ([]string, error) {
+					if gossipDatacenter != "dc4" {
+						return nil, fmt.Errorf("unexpected gossip datacenter %q", gossipDatacenter)
+					}
+					return []string{"dc4-node1", "dc4-node2"}, nil
+				},
+				eventRecorder: record.NewFakeRecorder(10),
+			}
+
+			dcStatus := &scyllav1alpha1.ScyllaDBClusterDatacenterStatus{
+				Name:                        "dc4",
+				RemoteKubernetesClusterName: pointer.Ptr("dc4-rkc"),
+				Removal: &scyllav1alpha1.DatacenterRemovalStatus{
+					Method: scyllav1alpha1.DecommissionDatacenterRemovalMethod,
+				},
+			}
+
+			progressingConditions, removed, err := scc.syncDatacenterRemoval(context.Background(), sc, dcStatus, tc.unavailableRemoteKubernetesClusterNames, remoteNamespaces, remoteScyllaDBDatacenters)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if removed {
+				t.Errorf("expected the datacenter not to be removed")
+			}
+
+			var gotProgressingReasons []string
+			for _, c := range progressingConditions {
+				gotProgressingReasons = append(gotProgressingReasons, c.Reason)
+			}
+			if !cmp.Equal(gotProgressingReasons, tc.expectedProgressingReasons) {
+				t.Errorf("expected and got progressing reasons differ:\n%s", cmp.Diff(tc.expectedProgressingReasons, gotProgressingReasons))
+			}
+
+			if !cmp.Equal(dcStatus.Removal, tc.expectedRemoval) {
+				t.Errorf("expected and got removal status differ:\n%s", cmp.Diff(tc.expectedRemoval, dcStatus.Removal))
+			}
+		})
+	}
+}
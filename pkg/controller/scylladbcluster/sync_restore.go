@@ -0,0 +1,49 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbcluster
+
+import (
+	"context"
+	"fmt"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// syncRestoreFromBackup initializes the cluster from a ScyllaDB Manager backup once all datacenters are rolled out.
+func (scc *Controller) syncRestoreFromBackup(
+	ctx context.Context,
+	sc *scyllav1alpha1.ScyllaDBCluster,
+	status *scyllav1alpha1.ScyllaDBClusterStatus,
+) ([]metav1.Condition, error) {
+	if sc.Spec.RestoreFromBackup != nil && status.RestoreFromBackup == nil {
+		status.RestoreFromBackup = &scyllav1alpha1.RestoreFromBackupStatus{}
+	}
+
+	var waitingForRolloutMessage string
+	for _, dc := range sc.Spec.Datacenters {
+		if !apimeta.IsStatusConditionFalse(status.Conditions, makeRemoteScyllaDBDatacenterControllerDatacenterProgressingCondition(dc.Name)) {
+			waitingForRolloutMessage = fmt.Sprintf("Waiting for datacenter %q to roll out before restoring the ScyllaDBCluster from backup.", dc.Name)
+			break
+		}
+	}
+
+	return controllerhelpers.SyncRestoreFromBackup(
+		ctx,
+		scc.scyllaClient.ScyllaV1alpha1(),
+		scc.scyllaDBManagerTaskLister,
+		scc.eventRecorder,
+		sc,
+		scyllav1alpha1.ScyllaDBClusterGVK,
+		naming.ScyllaDBClusterLocalSelectorLabels(sc),
+		sc.Spec.RestoreFromBackup,
+		status.RestoreFromBackup,
+		waitingForRolloutMessage,
+		&status.Conditions,
+		restoreFromBackupControllerAvailableCondition,
+		restoreFromBackupControllerProgressingCondition,
+	)
+}
@@ -21,6 +21,7 @@ func (scc *Controller) syncRemoteScyllaDBDatacenters(
 	sc *scyllav1alpha1.ScyllaDBCluster,
 	dc *scyllav1alpha1.ScyllaDBClusterDatacenter,
 	status *scyllav1alpha1.ScyllaDBClusterStatus,
+	unavailableRemoteKubernetesClusterNames []string,
 	remoteNamespace *corev1.Namespace,
 	remoteController metav1.Object,
 	remoteScyllaDBDatacenters map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter,
@@ -32,6 +33,15 @@ func (scc *Controller) syncRemoteScyllaDBDatacenters(
 		return progressingConditions, fmt.Errorf("can't make remote ScyllaDBDatacenters: %w", err)
 	}
 
+	// Nodes of datacenters whose remote Kubernetes cluster is gone are removed by one of the remaining datacenters.
+	removeNodeHostIDs := getRemoveNodeHostIDs(sc, dc, status)
+	if len(removeNodeHostIDs) != 0 {
+		if requiredScyllaDBDatacenter.Annotations == nil {
+			requiredScyllaDBDatacenter.Annotations = map[string]string{}
+		}
+		requiredScyllaDBDatacenter.Annotations[naming.RemoveNodeHostIDsAnnotation] = formatHostIDs(removeNodeHostIDs)
+	}
+
 	clusterClient, err := scc.scyllaRemoteClient.Cluster(dc.RemoteKubernetesClusterName)
 	if err != nil {
 		return nil, fmt.Errorf("can't get client to %q cluster: %w", dc.RemoteKubernetesClusterName, err)
@@ -39,9 +49,7 @@ func (scc *Controller) syncRemoteScyllaDBDatacenters(
 
 	// Delete any excessive ScyllaDBDatacenters.
 	// Delete has to be the first action to avoid getting stuck on quota.
-	// FIXME: This should first scale all racks to 0, only then remove.
-	//  	 Without graceful removal, state of other DC might be skewed.
-	// Ref: https://github.com/scylladb/scylla-operator/issues/2604
+	// ScyllaDBDatacenters of datacenters removed from the spec live in their own namespaces and are removed gracefully by syncDatacenterRemoval.
 	err = controllerhelpers.Prune(ctx,
 		[]*scyllav1alpha1.ScyllaDBDatacenter{requiredScyllaDBDatacenter},
 		remoteScyllaDBDatacenters[dc.RemoteKubernetesClusterName],
@@ -55,6 +63,21 @@ func (scc *Controller) syncRemoteScyllaDBDatacenters(
 	}
 
 	existingSDC, sdcExists := remoteScyllaDBDatacenters[dc.RemoteKubernetesClusterName][requiredScyllaDBDatacenter.Name]
+
+	// Topology changes are paused while any of the remote Kubernetes clusters is unavailable, as the datacenters it hosts
+	// can't take part in them. They resume once the remote Kubernetes clusters become available again.
+	if len(unavailableRemoteKubernetesClusterNames) != 0 && !sdcExists {
+		klog.V(4).InfoS("Waiting for remote Kubernetes clusters to become available before creating ScyllaDBDatacenter", "ScyllaDBCluster", klog.KObj(sc), "ScyllaDBDatacenter", klog.KObj(requiredScyllaDBDatacenter), "UnavailableClusters", unavailableRemoteKubernetesClusterNames)
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               makeRemoteScyllaDBDatacenterControllerDatacenterProgressingCondition(dc.Name),
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForRemoteKubernetesClusters",
+			Message:            fmt.Sprintf("Waiting for RemoteKubernetesCluster(s) %q to become available before creating datacenter %q.", unavailableRemoteKubernetesClusterNames, dc.Name),
+			ObservedGeneration: sc.Generation,
+		})
+		return progressingConditions, nil
+	}
+
 	if !sdcExists {
 		klog.V(4).InfoS("Required ScyllaDBDatacenter doesn't exists, awaiting all previous DC to finish bootstrapping", "ScyllaDBCluster", klog.KObj(sc), "ScyllaDBDatacenter", klog.KObj(requiredScyllaDBDatacenter))
 		for i := range sc.Spec.Datacenters {
@@ -138,6 +161,42 @@ func (scc *Controller) syncRemoteScyllaDBDatacenters(
 		return progressingConditions, nil
 	}
 
+	// ScyllaDB version upgrades are rolled out one datacenter at a time.
+	// Until the preceding datacenters are upgraded, the datacenter keeps using its current image.
+	var pausedProgressingConditions []metav1.Condition
+	if sdcExists && existingSDC.Spec.ScyllaDB.Image != requiredScyllaDBDatacenter.Spec.ScyllaDB.Image {
+		blockingDCName, blocked := getDatacenterBlockingUpgrade(sc, dc, remoteScyllaDBDatacenters)
+		if blocked {
+			klog.V(4).InfoS("Waiting for preceding datacenter to be upgraded", "ScyllaDBCluster", klog.KObj(sc), "Datacenter", dc.Name, "PrecedingDatacenter", blockingDCName)
+			requiredScyllaDBDatacenter.Spec.ScyllaDB.Image = existingSDC.Spec.ScyllaDB.Image
+			pausedProgressingConditions = append(pausedProgressingConditions, metav1.Condition{
+				Type:               makeRemoteScyllaDBDatacenterControllerDatacenterProgressingCondition(dc.Name),
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForDatacenterUpgrade",
+				Message:            fmt.Sprintf("Waiting for datacenter %q to be upgraded, rolled out and healthy before upgrading datacenter %q.", blockingDCName, dc.Name),
+				ObservedGeneration: sc.Generation,
+			})
+		}
+	}
+
+	if len(unavailableRemoteKubernetesClusterNames) != 0 {
+		pinned, err := pinScyllaDBDatacenterTopology(requiredScyllaDBDatacenter, existingSDC)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't pin topology of ScyllaDBDatacenter %q: %w", naming.ObjRef(existingSDC), err)
+		}
+
+		if pinned {
+			klog.V(4).InfoS("Waiting for remote Kubernetes clusters to become available before changing ScyllaDBDatacenter topology", "ScyllaDBCluster", klog.KObj(sc), "ScyllaDBDatacenter", klog.KObj(existingSDC), "UnavailableClusters", unavailableRemoteKubernetesClusterNames)
+			pausedProgressingConditions = append(pausedProgressingConditions, metav1.Condition{
+				Type:               makeRemoteScyllaDBDatacenterControllerDatacenterProgressingCondition(dc.Name),
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForRemoteKubernetesClusters",
+				Message:            fmt.Sprintf("Waiting for RemoteKubernetesCluster(s) %q to become available before changing nodes or version of datacenter %q.", unavailableRemoteKubernetesClusterNames, dc.Name),
+				ObservedGeneration: sc.Generation,
+			})
+		}
+	}
+
 	sdc, changed, err := resourceapply.ApplyScyllaDBDatacenter(ctx, clusterClient.ScyllaV1alpha1(), scc.remoteScyllaDBDatacenterLister.Cluster(dc.RemoteKubernetesClusterName), scc.eventRecorder, requiredScyllaDBDatacenter, resourceapply.ApplyOptions{})
 	if err != nil {
 		return progressingConditions, fmt.Errorf("can't apply scylladbdatacenter: %w", err)
@@ -146,6 +205,16 @@ func (scc *Controller) syncRemoteScyllaDBDatacenters(
 	if changed {
 		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, makeRemoteScyllaDBDatacenterControllerDatacenterProgressingCondition(dc.Name), sdc, "apply", sc.Generation)
 	}
+	progressingConditions = append(progressingConditions, pausedProgressingConditions...)
+
+	// Rebuild failures, e.g. when keyspace replication can't be altered because ScyllaDB requires authentication,
+	// are only reported by the remote ScyllaDBDatacenter, so they are surfaced here.
+	if sdcExists && existingSDC.Spec.Rebuild != nil {
+		rebuildDegradedCondition := meta.FindStatusCondition(existingSDC.Status.Conditions, scyllaDBDatacenterRebuildControllerDegradedCondition)
+		if rebuildDegradedCondition != nil && rebuildDegradedCondition.Status == metav1.ConditionTrue {
+			return progressingConditions, fmt.Errorf("rebuild of datacenter %q has failed: %s", dc.Name, rebuildDegradedCondition.Message)
+		}
+	}
 
 	// Use existingSDC coming from cache to validate the rollout state instead of a freshly fetched SDC,
 	// because the state of the required object depends on the state of other DCs (e.g., seed calculation).
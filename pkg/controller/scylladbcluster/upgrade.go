@@ -0,0 +1,107 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbcluster
+
+import (
+	"slices"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+)
+
+// getDatacenterUpgradeOrder returns the datacenters in the order they are upgraded in.
+func getDatacenterUpgradeOrder(sc *scyllav1alpha1.ScyllaDBCluster) []scyllav1alpha1.ScyllaDBClusterDatacenter {
+	var order []string
+	if sc.Spec.UpgradeStrategy != nil {
+		order = sc.Spec.UpgradeStrategy.DatacenterOrder
+	}
+
+	datacenters := slices.Clone(sc.Spec.Datacenters)
+	slices.SortStableFunc(datacenters, func(a, b scyllav1alpha1.ScyllaDBClusterDatacenter) int {
+		aIdx, bIdx := slices.Index(order, a.Name), slices.Index(order, b.Name)
+		switch {
+		case aIdx == bIdx:
+			return 0
+		case aIdx == -1:
+			return 1
+		case bIdx == -1:
+			return -1
+		default:
+			return aIdx - bIdx
+		}
+	})
+
+	return datacenters
+}
+
+// isVersionRolledOut returns true when all racks of the ScyllaDBDatacenter run the version of the image.
+func isVersionRolledOut(sdc *scyllav1alpha1.ScyllaDBDatacenter, image string) bool {
+	version, err := naming.ImageToVersion(image)
+	if err != nil {
+		return true
+	}
+
+	for _, rs := range sdc.Status.Racks {
+		if rs.CurrentVersion != version {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getDatacenterUpgradeState returns the state of the ScyllaDBDatacenter in an upgrade to the image.
+func getDatacenterUpgradeState(sdc *scyllav1alpha1.ScyllaDBDatacenter, image string) scyllav1alpha1.DatacenterUpgradeState {
+	if sdc.Spec.ScyllaDB.Image != image {
+		return scyllav1alpha1.PendingDatacenterUpgradeState
+	}
+
+	if !isVersionRolledOut(sdc, image) {
+		return scyllav1alpha1.UpgradingDatacenterUpgradeState
+	}
+
+	rolledOut, err := controllerhelpers.IsScyllaDBDatacenterRolledOut(sdc)
+	if err != nil || !rolledOut {
+		return scyllav1alpha1.UpgradingDatacenterUpgradeState
+	}
+
+	return scyllav1alpha1.UpgradedDatacenterUpgradeState
+}
+
+// isUpgradeInProgress returns true when any of the existing ScyllaDBDatacenters doesn't use or run the image of the cluster yet.
+func isUpgradeInProgress(sc *scyllav1alpha1.ScyllaDBCluster, remoteScyllaDBDatacenters map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter) bool {
+	for _, dc := range sc.Spec.Datacenters {
+		sdc, ok := remoteScyllaDBDatacenters[dc.RemoteKubernetesClusterName][naming.ScyllaDBDatacenterName(sc, &dc)]
+		if !ok {
+			continue
+		}
+
+		if sdc.Spec.ScyllaDB.Image != sc.Spec.ScyllaDB.Image || !isVersionRolledOut(sdc, sc.Spec.ScyllaDB.Image) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getDatacenterBlockingUpgrade returns the name of the first existing datacenter preceding the datacenter
+// in the upgrade order that isn't upgraded yet, if any.
+func getDatacenterBlockingUpgrade(sc *scyllav1alpha1.ScyllaDBCluster, dc *scyllav1alpha1.ScyllaDBClusterDatacenter, remoteScyllaDBDatacenters map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter) (string, bool) {
+	for _, previousDC := range getDatacenterUpgradeOrder(sc) {
+		if previousDC.Name == dc.Name {
+			break
+		}
+
+		sdc, ok := remoteScyllaDBDatacenters[previousDC.RemoteKubernetesClusterName][naming.ScyllaDBDatacenterName(sc, &previousDC)]
+		if !ok {
+			continue
+		}
+
+		if getDatacenterUpgradeState(sdc, sc.Spec.ScyllaDB.Image) != scyllav1alpha1.UpgradedDatacenterUpgradeState {
+			return previousDC.Name, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,314 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbcluster
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newUpgradeTestScyllaDBCluster(image string, order []string) *scyllav1alpha1.ScyllaDBCluster {
+	sc := &scyllav1alpha1.ScyllaDBCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster",
+			Namespace: "scylla",
+		},
+		Spec: scyllav1alpha1.ScyllaDBClusterSpec{
+			ScyllaDB: scyllav1alpha1.ScyllaDB{
+				Image: image,
+			},
+			Datacenters: []scyllav1alpha1.ScyllaDBClusterDatacenter{
+				{
+					Name:                        "dc1",
+					RemoteKubernetesClusterName: "dc1-rkc",
+				},
+				{
+					Name:                        "dc2",
+					RemoteKubernetesClusterName: "dc2-rkc",
+				},
+				{
+					Name:                        "dc3",
+					RemoteKubernetesClusterName: "dc3-rkc",
+				},
+			},
+		},
+	}
+
+	if order != nil {
+		sc.Spec.UpgradeStrategy = &scyllav1alpha1.ScyllaDBClusterUpgradeStrategy{
+			DatacenterOrder: order,
+		}
+	}
+
+	return sc
+}
+
+func newUpgradeTestScyllaDBDatacenter(name, image, version string, rolledOut bool) *scyllav1alpha1.ScyllaDBDatacenter {
+	progressingStatus := metav1.ConditionFalse
+	if !rolledOut {
+		progressingStatus = metav1.ConditionTrue
+	}
+
+	return &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Namespace:  "scylla",
+			Generation: 1,
+		},
+		Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+			ScyllaDB: scyllav1alpha1.ScyllaDB{
+				Image: image,
+			},
+		},
+		Status: scyllav1alpha1.ScyllaDBDatacenterStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:               scyllav1alpha1.AvailableCondition,
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 1,
+				},
+				{
+					Type:               scyllav1alpha1.ProgressingCondition,
+					Status:             progressingStatus,
+					ObservedGeneration: 1,
+				},
+				{
+					Type:               scyllav1alpha1.DegradedCondition,
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: 1,
+				},
+			},
+			Racks: []scyllav1alpha1.RackStatus{
+				{
+					Name:           "a",
+					CurrentVersion: version,
+				},
+			},
+		},
+	}
+}
+
+func newUpgradeTestRemoteScyllaDBDatacenters(sc *scyllav1alpha1.ScyllaDBCluster, sdcs ...*scyllav1alpha1.ScyllaDBDatacenter) map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter {
+	remoteScyllaDBDatacenters := map[string]map[string]*scyllav1alpha1.ScyllaDBDatacenter{}
+	for i, sdc := range sdcs {
+		dc := sc.Spec.Datacenters[i]
+		sdc.Name = naming.ScyllaDBDatacenterName(sc, &dc)
+		remoteScyllaDBDatacenters[dc.RemoteKubernetesClusterName] = map[string]*scyllav1alpha1.ScyllaDBDatacenter{
+			sdc.Name: sdc,
+		}
+	}
+
+	return remoteScyllaDBDatacenters
+}
+
+func Test_getDatacenterUpgradeOrder(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name          string
+		order         []string
+		expectedOrder []string
+	}{
+		{
+			name:          "order of datacenters by default",
+			order:         nil,
+			expectedOrder: []string{"dc1", "dc2", "dc3"},
+		},
+		{
+			name:          "fully specified order",
+			order:         []string{"dc3", "dc1", "dc2"},
+			expectedOrder: []string{"dc3", "dc1", "dc2"},
+		},
+		{
+			name:          "unlisted datacenters follow the listed ones",
+			order:         []string{"dc2"},
+			expectedOrder: []string{"dc2", "dc1", "dc3"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, dc := range getDatacenterUpgradeOrder(newUpgradeTestScyllaDBCluster("scylladb/scylla:2025.1.0", tc.order)) {
+				got = append(got, dc.Name)
+			}
+
+			if !cmp.Equal(got, tc.expectedOrder) {
+				t.Errorf("expected and got orders differ:\n%s", cmp.Diff(tc.expectedOrder, got))
+			}
+		})
+	}
+}
+
+func Test_getDatacenterBlockingUpgrade(t *testing.T) {
+	t.Parallel()
+
+	const (
+		oldImage = "scylladb/scylla:2024.1.0"
+		newImage = "scylladb/scylla:2025.1.0"
+	)
+
+	tt := []struct {
+		name               string
+		order              []string
+		dcName             string
+		sdcs               []*scyllav1alpha1.ScyllaDBDatacenter
+		expectedBlockingDC string
+		expectedBlocked    bool
+	}{
+		{
+			name:   "first datacenter is upgraded right away",
+			dcName: "dc1",
+			sdcs: []*scyllav1alpha1.ScyllaDBDatacenter{
+				newUpgradeTestScyllaDBDatacenter("", oldImage, "2024.1.0", true),
+				newUpgradeTestScyllaDBDatacenter("", oldImage, "2024.1.0", true),
+				newUpgradeTestScyllaDBDatacenter("", oldImage, "2024.1.0", true),
+			},
+			expectedBlocked: false,
+		},
+		{
+			name:   "datacenter waits for the preceding one to be upgraded",
+			dcName: "dc2",
+			sdcs: []*scyllav1alpha1.ScyllaDBDatacenter{
+				newUpgradeTestScyllaDBDatacenter("", oldImage, "2024.1.0", true),
+				newUpgradeTestScyllaDBDatacenter("", oldImage, "2024.1.0", true),
+				newUpgradeTestScyllaDBDatacenter("", oldImage, "2024.1.0", true),
+			},
+			expectedBlockingDC: "dc1",
+			expectedBlocked:    true,
+		},
+		{
+			name:   "datacenter waits for the preceding one to roll out",
+			dcName: "dc2",
+			sdcs: []*scyllav1alpha1.ScyllaDBDatacenter{
+				newUpgradeTestScyllaDBDatacenter("", newImage, "2025.1.0", false),
+				newUpgradeTestScyllaDBDatacenter("", oldImage, "2024.1.0", true),
+				newUpgradeTestScyllaDBDatacenter("", oldImage, "2024.1.0", true),
+			},
+			expectedBlockingDC: "dc1",
+			expectedBlocked:    true,
+		},
+		{
+			name:   "datacenter is upgraded once the preceding ones are upgraded",
+			dcName: "dc3",
+			sdcs: []*scyllav1alpha1.ScyllaDBDatacenter{
+				newUpgradeTestScyllaDBDatacenter("", newImage, "2025.1.0", true),
+				newUpgradeTestScyllaDBDatacenter("", newImage, "2025.1.0", true),
+				newUpgradeTestScyllaDBDatacenter("", oldImage, "2024.1.0", true),
+			},
+			expectedBlocked: false,
+		},
+		{
+			name:   "datacenter first in the upgrade order is upgraded right away",
+			order:  []string{"dc3"},
+			dcName: "dc3",
+			sdcs: []*scyllav1alpha1.ScyllaDBDatacenter{
+				newUpgradeTestScyllaDBDatacenter("", oldImage, "2024.1.0", true),
+				newUpgradeTestScyllaDBDatacenter("", oldImage, "2024.1.0", true),
+				newUpgradeTestScyllaDBDatacenter("", oldImage, "2024.1.0", true),
+			},
+			expectedBlocked: false,
+		},
+		{
+			name:   "datacenter waits for datacenters preceding it in the upgrade order",
+			order:  []string{"dc3"},
+			dcName: "dc1",
+			sdcs: []*scyllav1alpha1.ScyllaDBDatacenter{
+				newUpgradeTestScyllaDBDatacenter("", oldImage, "2024.1.0", true),
+				newUpgradeTestScyllaDBDatacenter("", oldImage, "2024.1.0", true),
+				newUpgradeTestScyllaDBDatacenter("", newImage, "2024.1.0", false),
+			},
+			expectedBlockingDC: "dc3",
+			expectedBlocked:    true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sc := newUpgradeTestScyllaDBCluster(newImage, tc.order)
+			remoteScyllaDBDatacenters := newUpgradeTestRemoteScyllaDBDatacenters(sc, tc.sdcs...)
+
+			var dc *scyllav1alpha1.ScyllaDBClusterDatacenter
+			for i := range sc.Spec.Datacenters {
+				if sc.Spec.Datacenters[i].Name == tc.dcName {
+					dc = &sc.Spec.Datacenters[i]
+				}
+			}
+
+			gotBlockingDC, gotBlocked := getDatacenterBlockingUpgrade(sc, dc, remoteScyllaDBDatacenters)
+			if gotBlocked != tc.expectedBlocked {
+				t.Errorf("expected blocked %t, got %t", tc.expectedBlocked, gotBlocked)
+			}
+			if gotBlockingDC != tc.expectedBlockingDC {
+				t.Errorf("expected blocking datacenter %q, got %q", tc.expectedBlockingDC, gotBlockingDC)
+			}
+		})
+	}
+}
+
+func Test_calculateStatus_UpgradeState(t *testing.T) {
+	t.Parallel()
+
+	const (
+		oldImage = "scylladb/scylla:2024.1.0"
+		newImage = "scylladb/scylla:2025.1.0"
+	)
+
+	tt := []struct {
+		name           string
+		sdcs           []*scyllav1alpha1.ScyllaDBDatacenter
+		expectedStates []*scyllav1alpha1.DatacenterUpgradeState
+	}{
+		{
+			name: "no upgrade in progress",
+			sdcs: []*scyllav1alpha1.ScyllaDBDatacenter{
+				newUpgradeTestScyllaDBDatacenter("", newImage, "2025.1.0", true),
+				newUpgradeTestScyllaDBDatacenter("", newImage, "2025.1.0", false),
+				newUpgradeTestScyllaDBDatacenter("", newImage, "2025.1.0", true),
+			},
+			expectedStates: []*scyllav1alpha1.DatacenterUpgradeState{nil, nil, nil},
+		},
+		{
+			name: "upgrade in progress",
+			sdcs: []*scyllav1alpha1.ScyllaDBDatacenter{
+				newUpgradeTestScyllaDBDatacenter("", newImage, "2025.1.0", true),
+				newUpgradeTestScyllaDBDatacenter("", newImage, "2024.1.0", false),
+				newUpgradeTestScyllaDBDatacenter("", oldImage, "2024.1.0", true),
+			},
+			expectedStates: []*scyllav1alpha1.DatacenterUpgradeState{
+				pointer.Ptr(scyllav1alpha1.UpgradedDatacenterUpgradeState),
+				pointer.Ptr(scyllav1alpha1.UpgradingDatacenterUpgradeState),
+				pointer.Ptr(scyllav1alpha1.PendingDatacenterUpgradeState),
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sc := newUpgradeTestScyllaDBCluster(newImage, nil)
+			remoteScyllaDBDatacenters := newUpgradeTestRemoteScyllaDBDatacenters(sc, tc.sdcs...)
+
+			status := (&Controller{}).calculateStatus(sc, remoteScyllaDBDatacenters)
+
+			var got []*scyllav1alpha1.DatacenterUpgradeState
+			for _, dcStatus := range status.Datacenters {
+				got = append(got, dcStatus.UpgradeState)
+			}
+
+			if !cmp.Equal(got, tc.expectedStates) {
+				t.Errorf("expected and got upgrade states differ:\n%s", cmp.Diff(tc.expectedStates, got))
+			}
+		})
+	}
+}
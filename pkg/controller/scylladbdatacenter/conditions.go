@@ -24,4 +24,13 @@ const (
 	configControllerDegradedCondition                                 = "ConfigControllerDegraded"
 	scyllaDBDatacenterNodesStatusReportControllerProgressingCondition = "ScyllaDBDatacenterNodesStatusReportControllerProgressing"
 	scyllaDBDatacenterNodesStatusReportControllerDegradedCondition    = "ScyllaDBDatacenterNodesStatusReportControllerDegraded"
+	restoreFromBackupControllerAvailableCondition                     = "RestoreFromBackupControllerAvailable"
+	restoreFromBackupControllerProgressingCondition                   = "RestoreFromBackupControllerProgressing"
+	restoreFromBackupControllerDegradedCondition                      = "RestoreFromBackupControllerDegraded"
+	upgradeSnapshotControllerProgressingCondition                     = "UpgradeSnapshotControllerProgressing"
+	upgradeSnapshotControllerDegradedCondition                        = "UpgradeSnapshotControllerDegraded"
+	removeNodeControllerProgressingCondition                          = "RemoveNodeControllerProgressing"
+	removeNodeControllerDegradedCondition                             = "RemoveNodeControllerDegraded"
+	rebuildControllerProgressingCondition                             = "RebuildControllerProgressing"
+	rebuildControllerDegradedCondition                                = "RebuildControllerDegraded"
 )
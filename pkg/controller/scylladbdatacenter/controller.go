@@ -33,6 +33,7 @@ import (
 	networkingv1informers "k8s.io/client-go/informers/networking/v1"
 	policyv1informers "k8s.io/client-go/informers/policy/v1"
 	rbacv1informers "k8s.io/client-go/informers/rbac/v1"
+	storagev1informers "k8s.io/client-go/informers/storage/v1"
 	"k8s.io/client-go/kubernetes"
 	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	appsv1listers "k8s.io/client-go/listers/apps/v1"
@@ -41,6 +42,7 @@ import (
 	networkingv1listers "k8s.io/client-go/listers/networking/v1"
 	policyv1listers "k8s.io/client-go/listers/policy/v1"
 	rbacv1listers "k8s.io/client-go/listers/rbac/v1"
+	storagev1listers "k8s.io/client-go/listers/storage/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
@@ -66,6 +68,8 @@ type Controller struct {
 	scyllaClient scyllav1alpha1client.ScyllaV1alpha1Interface
 
 	podLister                                 corev1listers.PodLister
+	pvcLister                                 corev1listers.PersistentVolumeClaimLister
+	storageClassLister                        storagev1listers.StorageClassLister
 	serviceLister                             corev1listers.ServiceLister
 	secretLister                              corev1listers.SecretLister
 	configMapLister                           corev1listers.ConfigMapLister
@@ -77,6 +81,8 @@ type Controller struct {
 	scyllaDBDatacenterLister                  scyllav1alpha1listers.ScyllaDBDatacenterLister
 	jobLister                                 batchv1listers.JobLister
 	scyllaDBDatacenterNodesStatusReportLister scyllav1alpha1listers.ScyllaDBDatacenterNodesStatusReportLister
+	scyllaDBVolumeSnapshotLister              scyllav1alpha1listers.ScyllaDBVolumeSnapshotLister
+	scyllaDBManagerTaskLister                 scyllav1alpha1listers.ScyllaDBManagerTaskLister
 
 	cachesToSync []cache.InformerSynced
 
@@ -92,6 +98,8 @@ func NewController(
 	kubeClient kubernetes.Interface,
 	scyllaClient scyllav1alpha1client.ScyllaV1alpha1Interface,
 	podInformer corev1informers.PodInformer,
+	pvcInformer corev1informers.PersistentVolumeClaimInformer,
+	storageClassInformer storagev1informers.StorageClassInformer,
 	serviceInformer corev1informers.ServiceInformer,
 	secretInformer corev1informers.SecretInformer,
 	configMapInformer corev1informers.ConfigMapInformer,
@@ -103,6 +111,8 @@ func NewController(
 	jobInformer batchv1informers.JobInformer,
 	scyllaDBDatacenterInformer scyllav1alpha1informers.ScyllaDBDatacenterInformer,
 	scyllaDBDatacenterNodesStatusReportInformer scyllav1alpha1informers.ScyllaDBDatacenterNodesStatusReportInformer,
+	scyllaDBVolumeSnapshotInformer scyllav1alpha1informers.ScyllaDBVolumeSnapshotInformer,
+	scyllaDBManagerTaskInformer scyllav1alpha1informers.ScyllaDBManagerTaskInformer,
 	operatorImage string,
 	cqlsIngressPort int,
 	keyGetter crypto.RSAKeyGetter,
@@ -119,6 +129,8 @@ func NewController(
 		scyllaClient: scyllaClient,
 
 		podLister:                podInformer.Lister(),
+		pvcLister:                pvcInformer.Lister(),
+		storageClassLister:       storageClassInformer.Lister(),
 		serviceLister:            serviceInformer.Lister(),
 		secretLister:             secretInformer.Lister(),
 		configMapLister:          configMapInformer.Lister(),
@@ -130,9 +142,13 @@ func NewController(
 		scyllaDBDatacenterLister: scyllaDBDatacenterInformer.Lister(),
 		jobLister:                jobInformer.Lister(),
 		scyllaDBDatacenterNodesStatusReportLister: scyllaDBDatacenterNodesStatusReportInformer.Lister(),
+		scyllaDBVolumeSnapshotLister:              scyllaDBVolumeSnapshotInformer.Lister(),
+		scyllaDBManagerTaskLister:                 scyllaDBManagerTaskInformer.Lister(),
 
 		cachesToSync: []cache.InformerSynced{
 			podInformer.Informer().HasSynced,
+			pvcInformer.Informer().HasSynced,
+			storageClassInformer.Informer().HasSynced,
 			serviceInformer.Informer().HasSynced,
 			secretInformer.Informer().HasSynced,
 			configMapInformer.Informer().HasSynced,
@@ -144,6 +160,8 @@ func NewController(
 			scyllaDBDatacenterInformer.Informer().HasSynced,
 			jobInformer.Informer().HasSynced,
 			scyllaDBDatacenterNodesStatusReportInformer.Informer().HasSynced,
+			scyllaDBVolumeSnapshotInformer.Informer().HasSynced,
+			scyllaDBManagerTaskInformer.Informer().HasSynced,
 		},
 
 		eventRecorder: eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "scylladbdatacenter-controller"}),
@@ -204,6 +222,13 @@ func NewController(
 		DeleteFunc: sdcc.deletePod,
 	})
 
+	// We need PVC events to track the progress of storage expansion.
+	pvcInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    sdcc.addPVC,
+		UpdateFunc: sdcc.updatePVC,
+		DeleteFunc: sdcc.deletePVC,
+	})
+
 	serviceAccountInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    sdcc.addServiceAccount,
 		UpdateFunc: sdcc.updateServiceAccount,
@@ -252,6 +277,19 @@ func NewController(
 		DeleteFunc: sdcc.deleteScyllaDBDatacenterNodesStatusReport,
 	})
 
+	scyllaDBVolumeSnapshotInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    sdcc.addScyllaDBVolumeSnapshot,
+		UpdateFunc: sdcc.updateScyllaDBVolumeSnapshot,
+		DeleteFunc: sdcc.deleteScyllaDBVolumeSnapshot,
+	})
+
+	// We need ScyllaDBManagerTask events to track the progress of restoring from backup.
+	scyllaDBManagerTaskInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    sdcc.addScyllaDBManagerTask,
+		UpdateFunc: sdcc.updateScyllaDBManagerTask,
+		DeleteFunc: sdcc.deleteScyllaDBManagerTask,
+	})
+
 	return sdcc, nil
 }
 
@@ -528,6 +566,44 @@ func (sdcc *Controller) deletePod(obj interface{}) {
 	)
 }
 
+func (sdcc *Controller) enqueueThroughClusterNameLabel(depth int, obj kubeinterfaces.ObjectInterface, op controllerhelpers.HandlerOperationType) {
+	sdcName, ok := obj.GetLabels()[naming.ClusterNameLabel]
+	if !ok {
+		return
+	}
+
+	sdc, err := sdcc.scyllaDBDatacenterLister.ScyllaDBDatacenters(obj.GetNamespace()).Get(sdcName)
+	if err != nil {
+		return
+	}
+
+	klog.V(4).InfoS("Enqueuing ScyllaDBDatacenter through a cluster name label", "Object", klog.KObj(obj), "ScyllaDBDatacenter", klog.KObj(sdc))
+	sdcc.handlers.Enqueue(depth+1, sdc, op)
+}
+
+func (sdcc *Controller) addPVC(obj interface{}) {
+	sdcc.handlers.HandleAdd(
+		obj.(*corev1.PersistentVolumeClaim),
+		sdcc.enqueueThroughClusterNameLabel,
+	)
+}
+
+func (sdcc *Controller) updatePVC(old, cur interface{}) {
+	sdcc.handlers.HandleUpdate(
+		old.(*corev1.PersistentVolumeClaim),
+		cur.(*corev1.PersistentVolumeClaim),
+		sdcc.enqueueThroughClusterNameLabel,
+		sdcc.deletePVC,
+	)
+}
+
+func (sdcc *Controller) deletePVC(obj interface{}) {
+	sdcc.handlers.HandleDelete(
+		obj,
+		sdcc.enqueueThroughClusterNameLabel,
+	)
+}
+
 func (sdcc *Controller) addStatefulSet(obj interface{}) {
 	sdcc.handlers.HandleAdd(
 		obj.(*appsv1.StatefulSet),
@@ -678,3 +754,64 @@ func (sdcc *Controller) deleteScyllaDBDatacenterNodesStatusReport(obj interface{
 		sdcc.handlers.EnqueueOwner,
 	)
 }
+
+func (sdcc *Controller) addScyllaDBVolumeSnapshot(obj interface{}) {
+	sdcc.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.ScyllaDBVolumeSnapshot),
+		sdcc.enqueueThroughStorageDataSource,
+	)
+}
+
+func (sdcc *Controller) updateScyllaDBVolumeSnapshot(old, cur interface{}) {
+	sdcc.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.ScyllaDBVolumeSnapshot),
+		cur.(*scyllav1alpha1.ScyllaDBVolumeSnapshot),
+		sdcc.enqueueThroughStorageDataSource,
+		sdcc.deleteScyllaDBVolumeSnapshot,
+	)
+}
+
+func (sdcc *Controller) deleteScyllaDBVolumeSnapshot(obj interface{}) {
+	sdcc.handlers.HandleDelete(
+		obj,
+		sdcc.enqueueThroughStorageDataSource,
+	)
+}
+
+func (sdcc *Controller) enqueueThroughStorageDataSource(depth int, obj kubeinterfaces.ObjectInterface, op controllerhelpers.HandlerOperationType) {
+	svs := obj.(*scyllav1alpha1.ScyllaDBVolumeSnapshot)
+
+	sdcc.handlers.EnqueueAllFunc(sdcc.handlers.EnqueueWithFilterFunc(func(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+		for _, rack := range sdc.Spec.Racks {
+			dataSource := getRackStorageDataSource(sdc, rack)
+			if dataSource != nil && dataSource.ScyllaDBVolumeSnapshotRef.Name == svs.Name {
+				return true
+			}
+		}
+
+		return false
+	}))(depth+1, obj, op)
+}
+
+func (sdcc *Controller) addScyllaDBManagerTask(obj interface{}) {
+	sdcc.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.ScyllaDBManagerTask),
+		sdcc.handlers.EnqueueOwner,
+	)
+}
+
+func (sdcc *Controller) updateScyllaDBManagerTask(old, cur interface{}) {
+	sdcc.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.ScyllaDBManagerTask),
+		cur.(*scyllav1alpha1.ScyllaDBManagerTask),
+		sdcc.handlers.EnqueueOwner,
+		sdcc.deleteScyllaDBManagerTask,
+	)
+}
+
+func (sdcc *Controller) deleteScyllaDBManagerTask(obj interface{}) {
+	sdcc.handlers.HandleDelete(
+		obj,
+		sdcc.handlers.EnqueueOwner,
+	)
+}
@@ -49,6 +49,12 @@ const (
 	scylladbAlternatorServingCertsVolumeName = "scylladb-alternator-serving-certs"
 )
 
+const (
+	// volumeSnapshotIdentityResetMarkerFileName is the name of a file in the data directory
+	// recording the UID of the ScyllaDBDatacenter the node identity was reset for.
+	volumeSnapshotIdentityResetMarkerFileName = ".scylla-operator-volume-snapshot-identity-reset"
+)
+
 const (
 	rootUID int64 = 0
 	rootGID int64 = 0
@@ -77,6 +83,9 @@ var (
 		// object created on migration from scyllav1.ScyllaCluster object. Setting it shouldn't trigger a rollout as it
 		// doesn't affect the ScyllaDB cluster itself.
 		naming.ScyllaDBManagerClusterRegistrationNameOverrideAnnotation,
+		// This annotation is set by ScyllaDBCluster controller to remove unreachable nodes of other datacenters.
+		// Setting it shouldn't trigger a rollout as it doesn't affect the nodes of this datacenter.
+		naming.RemoveNodeHostIDsAnnotation,
 	}
 
 	// Label keys excluded from propagation to underlying resources.
@@ -479,7 +488,7 @@ func StatefulSetForRack(rack scyllav1alpha1.RackSpec, sdc *scyllav1alpha1.Scylla
 		return nil, fmt.Errorf("can't get rack %q node count of ScyllaDBDatacenter %q: %w", rack.Name, naming.ObjRef(sdc), err)
 	}
 
-	initContainers, err := makeInitContainers(sdc, sidecarImage)
+	initContainers, err := makeInitContainers(rack, sdc, sidecarImage)
 	if err != nil {
 		return nil, fmt.Errorf("can't make init containers: %w", err)
 	}
@@ -1188,7 +1197,7 @@ func containerPorts(sdc *scyllav1alpha1.ScyllaDBDatacenter) ([]corev1.ContainerP
 	return ports, nil
 }
 
-func makeInitContainers(sdc *scyllav1alpha1.ScyllaDBDatacenter, sidecarImage string) ([]corev1.Container, error) {
+func makeInitContainers(rack scyllav1alpha1.RackSpec, sdc *scyllav1alpha1.ScyllaDBDatacenter, sidecarImage string) ([]corev1.Container, error) {
 	var initContainers []corev1.Container
 
 	sidecarInjectionCointainer := makeSidecarInjectionContainer(sidecarImage)
@@ -1202,6 +1211,12 @@ func makeInitContainers(sdc *scyllav1alpha1.ScyllaDBDatacenter, sidecarImage str
 		initContainers = append(initContainers, *sysctlContainer)
 	}
 
+	// The identity has to be reset before the bootstrap barrier inspects the data directory.
+	identityResetContainer, ok := makeVolumeSnapshotIdentityResetInitContainer(rack, sdc, sidecarImage)
+	if ok {
+		initContainers = append(initContainers, *identityResetContainer)
+	}
+
 	bootstrapBarrierContainer, ok, err := makeScyllaDBBootstrapBarrierInitContainer(sdc, sidecarImage)
 	if err != nil {
 		return nil, fmt.Errorf("can't make ScyllaDB bootstrap barrier init container: %w", err)
@@ -1283,6 +1298,53 @@ func makeSysctlInitContainer(sdc *scyllav1alpha1.ScyllaDBDatacenter, sidecarImag
 	}, true, nil
 }
 
+// makeVolumeSnapshotIdentityResetInitContainer creates an init container for racks seeded from volume snapshots.
+// Volumes restored from a snapshot carry the host ID, tokens and peers of the node they were taken from.
+// On the first start with a given ScyllaDBDatacenter, the container removes the system keyspace, commitlog and hints,
+// so the node joins with a new identity while keeping the schema and the user data.
+// A marker file prevents resetting the identity again on subsequent restarts.
+func makeVolumeSnapshotIdentityResetInitContainer(rack scyllav1alpha1.RackSpec, sdc *scyllav1alpha1.ScyllaDBDatacenter, image string) (*corev1.Container, bool) {
+	if rack.ScyllaDB == nil || rack.ScyllaDB.Storage == nil || rack.ScyllaDB.Storage.DataSource == nil {
+		return nil, false
+	}
+
+	markerPath := path.Join(naming.DataDir, volumeSnapshotIdentityResetMarkerFileName)
+	return &corev1.Container{
+		Name:            "volume-snapshot-identity-reset",
+		Image:           image,
+		ImagePullPolicy: corev1.PullIfNotPresent,
+		Command: []string{
+			"/bin/sh",
+			"-ec",
+			strings.TrimSpace(fmt.Sprintf(`
+if [ "$( cat '%[1]s' 2>/dev/null || true )" = '%[2]s' ]; then
+  exit 0
+fi
+rm -rf '%[3]s/data/system' '%[3]s/commitlog' '%[3]s/hints' '%[3]s/view_hints'
+find '%[3]s/data' -path '*/snapshots/so_volumesnapshot_*' -prune -exec rm -rf {} + 2>/dev/null || true
+printf '%%s' '%[2]s' > '%[1]s'
+`, markerPath, sdc.UID, naming.DataDir)),
+		},
+		Resources: corev1.ResourceRequirements{
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+				corev1.ResourceMemory: resource.MustParse("50Mi"),
+			},
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+				corev1.ResourceMemory: resource.MustParse("50Mi"),
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      naming.PVCTemplateName,
+				MountPath: naming.DataDir,
+				ReadOnly:  false,
+			},
+		},
+	}, true
+}
+
 // makeScyllaDBBootstrapBarrierInitContainer creates an init container that blocks proceeding with ScyllaDB startup until bootstrap preconditions are met.
 // It depends on the availability of the operator binary in a shared volume, as well as `scylla sstable query` command in the ScyllaDB container image.
 func makeScyllaDBBootstrapBarrierInitContainer(sdc *scyllav1alpha1.ScyllaDBDatacenter, image string) (*corev1.Container, bool, error) {
@@ -1890,6 +1952,269 @@ func MakeJobs(sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*corev
 	return jobs, progressingConditions, nil
 }
 
+// MakeUpgradeSSTablesJob makes a Job upgrading SSTables of the node behind the member Service.
+func MakeUpgradeSSTablesJob(sdc *scyllav1alpha1.ScyllaDBDatacenter, rack *scyllav1alpha1.RackSpec, svc *corev1.Service, nodeAddress string, image string) *batchv1.Job {
+	labels := cloneMapExcludingKeysOrEmpty(sdc.Labels, nonPropagatedLabelKeys)
+
+	maps.Copy(labels, map[string]string{
+		naming.ClusterNameLabel: sdc.Name,
+		naming.NodeJobLabel:     svc.Name,
+		naming.NodeJobTypeLabel: string(naming.JobTypeUpgradeSSTables),
+	})
+
+	podLabels := maps.Clone(labels)
+	podLabels[naming.PodTypeLabel] = string(naming.PodTypeUpgradeSSTablesJob)
+
+	annotations := cloneMapExcludingKeysOrEmpty(sdc.Annotations, nonPropagatedAnnotationKeys)
+
+	var tolerations []corev1.Toleration
+	var affinity *corev1.Affinity
+	if rack.Placement != nil {
+		tolerations = rack.Placement.Tolerations
+		affinity = &corev1.Affinity{
+			NodeAffinity:    rack.Placement.NodeAffinity,
+			PodAffinity:     rack.Placement.PodAffinity,
+			PodAntiAffinity: rack.Placement.PodAntiAffinity,
+		}
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      naming.UpgradeSSTablesJobForService(svc.Name),
+			Namespace: sdc.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(sdc, scyllav1alpha1.ScyllaDBDatacenterGVK),
+			},
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: batchv1.JobSpec{
+			Selector:       nil,
+			ManualSelector: pointer.Ptr(false),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      podLabels,
+					Annotations: annotations,
+				},
+				Spec: corev1.PodSpec{
+					Tolerations:   tolerations,
+					Affinity:      affinity,
+					RestartPolicy: corev1.RestartPolicyOnFailure,
+					Containers: []corev1.Container{
+						{
+							Name:            naming.UpgradeSSTablesContainerName,
+							Image:           image,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Args: []string{
+								"upgradesstables-job",
+								"--manager-auth-config-path=/etc/scylla-upgradesstables-job/auth-token.yaml",
+								fmt.Sprintf("--node-address=%s", nodeAddress),
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "scylla-manager-agent-token",
+									ReadOnly:  true,
+									MountPath: "/etc/scylla-upgradesstables-job/auth-token.yaml",
+									SubPath:   naming.ScyllaAgentAuthTokenFileName,
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "scylla-manager-agent-token",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: naming.AgentAuthTokenSecretName(sdc),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// MakeRemoveNodeJob makes a Job removing the node with the provided host ID from the cluster, coordinated by the node at nodeAddress.
+func MakeRemoveNodeJob(sdc *scyllav1alpha1.ScyllaDBDatacenter, rack *scyllav1alpha1.RackSpec, hostID string, nodeAddress string, image string) *batchv1.Job {
+	labels := cloneMapExcludingKeysOrEmpty(sdc.Labels, nonPropagatedLabelKeys)
+
+	maps.Copy(labels, map[string]string{
+		naming.ClusterNameLabel: sdc.Name,
+		naming.NodeJobLabel:     hostID,
+		naming.NodeJobTypeLabel: string(naming.JobTypeRemoveNode),
+	})
+
+	podLabels := maps.Clone(labels)
+	podLabels[naming.PodTypeLabel] = string(naming.PodTypeRemoveNodeJob)
+
+	annotations := cloneMapExcludingKeysOrEmpty(sdc.Annotations, nonPropagatedAnnotationKeys)
+
+	var tolerations []corev1.Toleration
+	var affinity *corev1.Affinity
+	if rack.Placement != nil {
+		tolerations = rack.Placement.Tolerations
+		affinity = &corev1.Affinity{
+			NodeAffinity:    rack.Placement.NodeAffinity,
+			PodAffinity:     rack.Placement.PodAffinity,
+			PodAntiAffinity: rack.Placement.PodAntiAffinity,
+		}
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      naming.RemoveNodeJobForHostID(hostID),
+			Namespace: sdc.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(sdc, scyllav1alpha1.ScyllaDBDatacenterGVK),
+			},
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: batchv1.JobSpec{
+			Selector:       nil,
+			ManualSelector: pointer.Ptr(false),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      podLabels,
+					Annotations: annotations,
+				},
+				Spec: corev1.PodSpec{
+					Tolerations:   tolerations,
+					Affinity:      affinity,
+					RestartPolicy: corev1.RestartPolicyOnFailure,
+					Containers: []corev1.Container{
+						{
+							Name:            naming.RemoveNodeContainerName,
+							Image:           image,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Args: []string{
+								"removenode-job",
+								"--manager-auth-config-path=/etc/scylla-removenode-job/auth-token.yaml",
+								fmt.Sprintf("--node-address=%s", nodeAddress),
+								fmt.Sprintf("--host-id=%s", hostID),
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "scylla-manager-agent-token",
+									ReadOnly:  true,
+									MountPath: "/etc/scylla-removenode-job/auth-token.yaml",
+									SubPath:   naming.ScyllaAgentAuthTokenFileName,
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "scylla-manager-agent-token",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: naming.AgentAuthTokenSecretName(sdc),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// MakeRebuildJob makes a Job streaming data to the node behind the member Service from the source datacenter.
+func MakeRebuildJob(sdc *scyllav1alpha1.ScyllaDBDatacenter, rack *scyllav1alpha1.RackSpec, svc *corev1.Service, nodeAddress string, image string) *batchv1.Job {
+	labels := cloneMapExcludingKeysOrEmpty(sdc.Labels, nonPropagatedLabelKeys)
+
+	maps.Copy(labels, map[string]string{
+		naming.ClusterNameLabel: sdc.Name,
+		naming.NodeJobLabel:     svc.Name,
+		naming.NodeJobTypeLabel: string(naming.JobTypeRebuild),
+	})
+
+	podLabels := maps.Clone(labels)
+	podLabels[naming.PodTypeLabel] = string(naming.PodTypeRebuildJob)
+
+	annotations := cloneMapExcludingKeysOrEmpty(sdc.Annotations, nonPropagatedAnnotationKeys)
+
+	var tolerations []corev1.Toleration
+	var affinity *corev1.Affinity
+	if rack.Placement != nil {
+		tolerations = rack.Placement.Tolerations
+		affinity = &corev1.Affinity{
+			NodeAffinity:    rack.Placement.NodeAffinity,
+			PodAffinity:     rack.Placement.PodAffinity,
+			PodAntiAffinity: rack.Placement.PodAntiAffinity,
+		}
+	}
+
+	args := []string{
+		"rebuild-job",
+		"--manager-auth-config-path=/etc/scylla-rebuild-job/auth-token.yaml",
+		fmt.Sprintf("--node-address=%s", nodeAddress),
+		fmt.Sprintf("--source-datacenter=%s", sdc.Spec.Rebuild.SourceDatacenter),
+		fmt.Sprintf("--termination-message-path=%s", corev1.TerminationMessagePathDefault),
+	}
+	if sdc.Spec.Rebuild.AlterKeyspaceReplication != nil && *sdc.Spec.Rebuild.AlterKeyspaceReplication {
+		args = append(args,
+			"--alter-keyspace-replication",
+			fmt.Sprintf("--datacenter=%s", naming.GetScyllaDBDatacenterGossipDatacenterName(sdc)),
+		)
+	}
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      naming.RebuildJobForService(svc.Name),
+			Namespace: sdc.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(sdc, scyllav1alpha1.ScyllaDBDatacenterGVK),
+			},
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: batchv1.JobSpec{
+			Selector:       nil,
+			ManualSelector: pointer.Ptr(false),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      podLabels,
+					Annotations: annotations,
+				},
+				Spec: corev1.PodSpec{
+					Tolerations:   tolerations,
+					Affinity:      affinity,
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:            naming.RebuildContainerName,
+							Image:           image,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Args:            args,
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "scylla-manager-agent-token",
+									ReadOnly:  true,
+									MountPath: "/etc/scylla-rebuild-job/auth-token.yaml",
+									SubPath:   naming.ScyllaAgentAuthTokenFileName,
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "scylla-manager-agent-token",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: naming.AgentAuthTokenSecretName(sdc),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func MakeManagedScyllaDBConfigMaps(sdc *scyllav1alpha1.ScyllaDBDatacenter) ([]*corev1.ConfigMap, error) {
 	var managedCMs []*corev1.ConfigMap
 
@@ -2144,6 +2469,15 @@ func applyRackTemplateOnRackSpec(rackTemplate *scyllav1alpha1.RackTemplate, rack
 								}
 								return nil
 							}(),
+							DataSource: func() *scyllav1alpha1.StorageDataSource {
+								if rack.ScyllaDB != nil && rack.ScyllaDB.Storage != nil && rack.ScyllaDB.Storage.DataSource != nil {
+									return rack.ScyllaDB.Storage.DataSource
+								}
+								if rackTemplate.ScyllaDB != nil && rackTemplate.ScyllaDB.Storage != nil && rackTemplate.ScyllaDB.Storage.DataSource != nil {
+									return rackTemplate.ScyllaDB.Storage.DataSource
+								}
+								return nil
+							}(),
 						}
 					}(),
 					CustomConfigMapRef: func() *string {
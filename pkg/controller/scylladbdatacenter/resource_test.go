@@ -3884,6 +3884,416 @@ func TestMakeJobs(t *testing.T) {
 	}
 }
 
+func TestMakeUpgradeSSTablesJob(t *testing.T) {
+	t.Parallel()
+
+	sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic",
+			Namespace: "default",
+			UID:       "the-uid",
+			Labels: map[string]string{
+				"default-sc-label": "foo",
+			},
+			Annotations: map[string]string{
+				"default-sc-annotation": "bar",
+			},
+		},
+		Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+			ClusterName:    "basic",
+			DatacenterName: pointer.Ptr("dc"),
+			Racks: []scyllav1alpha1.RackSpec{
+				{
+					Name: "rack",
+					RackTemplate: scyllav1alpha1.RackTemplate{
+						Placement: &scyllav1alpha1.Placement{
+							Tolerations: []corev1.Toleration{
+								{
+									Key:      "dedicated",
+									Operator: corev1.TolerationOpEqual,
+									Value:    "scylla",
+									Effect:   corev1.TaintEffectNoSchedule,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic-dc-rack-0",
+			Namespace: "default",
+		},
+	}
+
+	expected := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "upgradesstables-basic-dc-rack-0",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         "scylla.scylladb.com/v1alpha1",
+					Kind:               "ScyllaDBDatacenter",
+					Name:               "basic",
+					UID:                "the-uid",
+					Controller:         pointer.Ptr(true),
+					BlockOwnerDeletion: pointer.Ptr(true),
+				},
+			},
+			Labels: map[string]string{
+				"default-sc-label":                           "foo",
+				"scylla/cluster":                             "basic",
+				"scylla-operator.scylladb.com/node-job":      "basic-dc-rack-0",
+				"scylla-operator.scylladb.com/node-job-type": "UpgradeSSTables",
+			},
+			Annotations: map[string]string{
+				"default-sc-annotation": "bar",
+			},
+		},
+		Spec: batchv1.JobSpec{
+			ManualSelector: pointer.Ptr(false),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"default-sc-label":                           "foo",
+						"scylla/cluster":                             "basic",
+						"scylla-operator.scylladb.com/node-job":      "basic-dc-rack-0",
+						"scylla-operator.scylladb.com/node-job-type": "UpgradeSSTables",
+						"scylla-operator.scylladb.com/pod-type":      "upgradesstables-job",
+					},
+					Annotations: map[string]string{
+						"default-sc-annotation": "bar",
+					},
+				},
+				Spec: corev1.PodSpec{
+					Tolerations: []corev1.Toleration{
+						{
+							Key:      "dedicated",
+							Operator: corev1.TolerationOpEqual,
+							Value:    "scylla",
+							Effect:   corev1.TaintEffectNoSchedule,
+						},
+					},
+					Affinity:      &corev1.Affinity{},
+					RestartPolicy: corev1.RestartPolicyOnFailure,
+					Containers: []corev1.Container{
+						{
+							Name:            naming.UpgradeSSTablesContainerName,
+							Image:           "scylladb/scylla-operator:latest",
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Args: []string{
+								"upgradesstables-job",
+								"--manager-auth-config-path=/etc/scylla-upgradesstables-job/auth-token.yaml",
+								"--node-address=10.0.0.1",
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "scylla-manager-agent-token",
+									ReadOnly:  true,
+									MountPath: "/etc/scylla-upgradesstables-job/auth-token.yaml",
+									SubPath:   "auth-token.yaml",
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "scylla-manager-agent-token",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: "basic-auth-token",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	got := MakeUpgradeSSTablesJob(sdc, &sdc.Spec.Racks[0], svc, "10.0.0.1", "scylladb/scylla-operator:latest")
+	if !apiequality.Semantic.DeepEqual(got, expected) {
+		t.Errorf("expected and actual Job differ: %s", cmp.Diff(expected, got))
+	}
+}
+
+func TestMakeRemoveNodeJob(t *testing.T) {
+	t.Parallel()
+
+	sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic",
+			Namespace: "default",
+			UID:       "the-uid",
+			Labels: map[string]string{
+				"default-sc-label": "foo",
+			},
+			Annotations: map[string]string{
+				"default-sc-annotation": "bar",
+			},
+		},
+		Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+			ClusterName:    "basic",
+			DatacenterName: pointer.Ptr("dc"),
+			Racks: []scyllav1alpha1.RackSpec{
+				{
+					Name: "rack",
+					RackTemplate: scyllav1alpha1.RackTemplate{
+						Placement: &scyllav1alpha1.Placement{
+							Tolerations: []corev1.Toleration{
+								{
+									Key:      "dedicated",
+									Operator: corev1.TolerationOpEqual,
+									Value:    "scylla",
+									Effect:   corev1.TaintEffectNoSchedule,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "removenode-c2d8a2f1-6a4e-4d5b-9f3e-2a1b0c9d8e7f",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         "scylla.scylladb.com/v1alpha1",
+					Kind:               "ScyllaDBDatacenter",
+					Name:               "basic",
+					UID:                "the-uid",
+					Controller:         pointer.Ptr(true),
+					BlockOwnerDeletion: pointer.Ptr(true),
+				},
+			},
+			Labels: map[string]string{
+				"default-sc-label":                           "foo",
+				"scylla/cluster":                             "basic",
+				"scylla-operator.scylladb.com/node-job":      "c2d8a2f1-6a4e-4d5b-9f3e-2a1b0c9d8e7f",
+				"scylla-operator.scylladb.com/node-job-type": "RemoveNode",
+			},
+			Annotations: map[string]string{
+				"default-sc-annotation": "bar",
+			},
+		},
+		Spec: batchv1.JobSpec{
+			ManualSelector: pointer.Ptr(false),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"default-sc-label":                           "foo",
+						"scylla/cluster":                             "basic",
+						"scylla-operator.scylladb.com/node-job":      "c2d8a2f1-6a4e-4d5b-9f3e-2a1b0c9d8e7f",
+						"scylla-operator.scylladb.com/node-job-type": "RemoveNode",
+						"scylla-operator.scylladb.com/pod-type":      "removenode-job",
+					},
+					Annotations: map[string]string{
+						"default-sc-annotation": "bar",
+					},
+				},
+				Spec: corev1.PodSpec{
+					Tolerations: []corev1.Toleration{
+						{
+							Key:      "dedicated",
+							Operator: corev1.TolerationOpEqual,
+							Value:    "scylla",
+							Effect:   corev1.TaintEffectNoSchedule,
+						},
+					},
+					Affinity:      &corev1.Affinity{},
+					RestartPolicy: corev1.RestartPolicyOnFailure,
+					Containers: []corev1.Container{
+						{
+							Name:            naming.RemoveNodeContainerName,
+							Image:           "scylladb/scylla-operator:latest",
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Args: []string{
+								"removenode-job",
+								"--manager-auth-config-path=/etc/scylla-removenode-job/auth-token.yaml",
+								"--node-address=10.0.0.1",
+								"--host-id=c2d8a2f1-6a4e-4d5b-9f3e-2a1b0c9d8e7f",
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "scylla-manager-agent-token",
+									ReadOnly:  true,
+									MountPath: "/etc/scylla-removenode-job/auth-token.yaml",
+									SubPath:   "auth-token.yaml",
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "scylla-manager-agent-token",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: "basic-auth-token",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	got := MakeRemoveNodeJob(sdc, &sdc.Spec.Racks[0], "c2d8a2f1-6a4e-4d5b-9f3e-2a1b0c9d8e7f", "10.0.0.1", "scylladb/scylla-operator:latest")
+	if !apiequality.Semantic.DeepEqual(got, expected) {
+		t.Errorf("expected and actual Job differ: %s", cmp.Diff(expected, got))
+	}
+}
+
+func TestMakeRebuildJob(t *testing.T) {
+	t.Parallel()
+
+	sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic",
+			Namespace: "default",
+			UID:       "the-uid",
+			Labels: map[string]string{
+				"default-sc-label": "foo",
+			},
+			Annotations: map[string]string{
+				"default-sc-annotation": "bar",
+			},
+		},
+		Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+			ClusterName:    "basic",
+			DatacenterName: pointer.Ptr("dc"),
+			Rebuild: &scyllav1alpha1.RebuildOptions{
+				SourceDatacenter:         "dc1",
+				AlterKeyspaceReplication: pointer.Ptr(true),
+			},
+			Racks: []scyllav1alpha1.RackSpec{
+				{
+					Name: "rack",
+					RackTemplate: scyllav1alpha1.RackTemplate{
+						Placement: &scyllav1alpha1.Placement{
+							Tolerations: []corev1.Toleration{
+								{
+									Key:      "dedicated",
+									Operator: corev1.TolerationOpEqual,
+									Value:    "scylla",
+									Effect:   corev1.TaintEffectNoSchedule,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rebuild-basic-dc-rack-0",
+			Namespace: "default",
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion:         "scylla.scylladb.com/v1alpha1",
+					Kind:               "ScyllaDBDatacenter",
+					Name:               "basic",
+					UID:                "the-uid",
+					Controller:         pointer.Ptr(true),
+					BlockOwnerDeletion: pointer.Ptr(true),
+				},
+			},
+			Labels: map[string]string{
+				"default-sc-label":                           "foo",
+				"scylla/cluster":                             "basic",
+				"scylla-operator.scylladb.com/node-job":      "basic-dc-rack-0",
+				"scylla-operator.scylladb.com/node-job-type": "Rebuild",
+			},
+			Annotations: map[string]string{
+				"default-sc-annotation": "bar",
+			},
+		},
+		Spec: batchv1.JobSpec{
+			ManualSelector: pointer.Ptr(false),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						"default-sc-label":                           "foo",
+						"scylla/cluster":                             "basic",
+						"scylla-operator.scylladb.com/node-job":      "basic-dc-rack-0",
+						"scylla-operator.scylladb.com/node-job-type": "Rebuild",
+						"scylla-operator.scylladb.com/pod-type":      "rebuild-job",
+					},
+					Annotations: map[string]string{
+						"default-sc-annotation": "bar",
+					},
+				},
+				Spec: corev1.PodSpec{
+					Tolerations: []corev1.Toleration{
+						{
+							Key:      "dedicated",
+							Operator: corev1.TolerationOpEqual,
+							Value:    "scylla",
+							Effect:   corev1.TaintEffectNoSchedule,
+						},
+					},
+					Affinity:      &corev1.Affinity{},
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:            naming.RebuildContainerName,
+							Image:           "scylladb/scylla-operator:latest",
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Args: []string{
+								"rebuild-job",
+								"--manager-auth-config-path=/etc/scylla-rebuild-job/auth-token.yaml",
+								"--node-address=10.0.0.1",
+								"--source-datacenter=dc1",
+								"--termination-message-path=/dev/termination-log",
+								"--alter-keyspace-replication",
+								"--datacenter=dc",
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "scylla-manager-agent-token",
+									ReadOnly:  true,
+									MountPath: "/etc/scylla-rebuild-job/auth-token.yaml",
+									SubPath:   "auth-token.yaml",
+								},
+							},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name: "scylla-manager-agent-token",
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{
+									SecretName: "basic-auth-token",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	svc := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic-dc-rack-0",
+			Namespace: "default",
+		},
+	}
+
+	got := MakeRebuildJob(sdc, &sdc.Spec.Racks[0], svc, "10.0.0.1", "scylladb/scylla-operator:latest")
+	if !apiequality.Semantic.DeepEqual(got, expected) {
+		t.Errorf("expected and actual Job differ: %s", cmp.Diff(expected, got))
+	}
+}
+
 func Test_MakeManagedScyllaDBConfig(t *testing.T) {
 	newBasicScyllaDBDatacenter := func() *scyllav1alpha1.ScyllaDBDatacenter {
 		return &scyllav1alpha1.ScyllaDBDatacenter{
@@ -3,11 +3,15 @@ package scylladbdatacenter
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
 	"github.com/scylladb/scylla-operator/pkg/naming"
 	"github.com/scylladb/scylla-operator/pkg/pointer"
 	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
@@ -69,6 +73,15 @@ func (sdcc *Controller) calculateRackStatus(sdc *scyllav1alpha1.ScyllaDBDatacent
 		Stale:          pointer.Ptr(true),
 	}
 
+	// Conditions and storage migration progress are computed during the sync, carry over the previous ones.
+	oldRackStatus, _, ok := oslices.Find(sdc.Status.Racks, func(rackStatus scyllav1alpha1.RackStatus) bool {
+		return rackStatus.Name == rackName
+	})
+	if ok {
+		status.Conditions = slices.Clone(oldRackStatus.Conditions)
+		status.StorageMigration = oldRackStatus.StorageMigration.DeepCopy()
+	}
+
 	if sts == nil {
 		return status
 	}
@@ -80,6 +93,13 @@ func (sdcc *Controller) calculateRackStatus(sdc *scyllav1alpha1.ScyllaDBDatacent
 	status.CurrentNodes = pointer.Ptr(sts.Status.CurrentReplicas)
 	status.Stale = pointer.Ptr(sts.Status.ObservedGeneration < sts.Generation)
 
+	seededNodes, err := sdcc.getVolumeSnapshotSeededNodes(sts)
+	if err != nil {
+		klog.ErrorS(err, "can't get nodes seeded from volume snapshots", "StatefulSet", klog.KObj(sts))
+	} else {
+		status.VolumeSnapshotSeededNodes = seededNodes
+	}
+
 	scyllaDBImageVersion, err := naming.ImageToVersion(sdc.Spec.ScyllaDB.Image)
 	if err != nil {
 		klog.ErrorS(err, "can't get version of image", "Image", sdc.Spec.ScyllaDB.Image)
@@ -119,17 +139,49 @@ func updateAggregatedStatusFields(status *scyllav1alpha1.ScyllaDBDatacenterStatu
 // calculateStatus calculates the ScyllaCluster status.
 // This function should always succeed. Do not return an error.
 // If a particular object can be missing, it should be reflected in the value itself, like "Unknown" or "".
-func (sdcc *Controller) calculateStatus(sdc *scyllav1alpha1.ScyllaDBDatacenter, statefulSetMap map[string]*appsv1.StatefulSet) *scyllav1alpha1.ScyllaDBDatacenterStatus {
+func (sdcc *Controller) calculateStatus(sdc *scyllav1alpha1.ScyllaDBDatacenter, statefulSetMap map[string]*appsv1.StatefulSet, configMapMap map[string]*corev1.ConfigMap) *scyllav1alpha1.ScyllaDBDatacenterStatus {
 	status := sdc.Status.DeepCopy()
 	status.ObservedGeneration = pointer.Ptr(sdc.Generation)
 
+	// Racks are updated to the image from the upgrade path the datacenter is being upgraded through.
+	effectiveSDC, err := sdcc.applyUpgradePath(sdc, statefulSetMap, configMapMap)
+	if err != nil {
+		klog.ErrorS(err, "can't apply upgrade path", "ScyllaDBDatacenter", klog.KObj(sdc))
+		effectiveSDC = sdc
+	}
+
 	// Clear the previous rack status.
 	status.Racks = []scyllav1alpha1.RackStatus{}
 
 	// Calculate the status for racks.
 	for _, rack := range sdc.Spec.Racks {
 		stsName := naming.StatefulSetNameForRack(rack, sdc)
-		status.Racks = append(status.Racks, *sdcc.calculateRackStatus(sdc, rack.Name, statefulSetMap[stsName]))
+		status.Racks = append(status.Racks, *sdcc.calculateRackStatus(effectiveSDC, rack.Name, statefulSetMap[stsName]))
+	}
+
+	// Racks removed from the spec are reported until their nodes are decommissioned and the StatefulSets are removed.
+	for _, stsName := range slices.Sorted(maps.Keys(statefulSetMap)) {
+		sts := statefulSetMap[stsName]
+		if sts.DeletionTimestamp != nil {
+			continue
+		}
+
+		rackName, ok := sts.Labels[naming.RackNameLabel]
+		if !ok {
+			continue
+		}
+
+		if slices.ContainsFunc(sdc.Spec.Racks, func(rack scyllav1alpha1.RackSpec) bool {
+			return rack.Name == rackName
+		}) {
+			continue
+		}
+
+		rackStatus := sdcc.calculateRackStatus(effectiveSDC, rackName, sts)
+		rackStatus.Removal = &scyllav1alpha1.RackRemovalStatus{
+			RemainingNodes: *sts.Spec.Replicas,
+		}
+		status.Racks = append(status.Racks, *rackStatus)
 	}
 
 	updateAggregatedStatusFields(status)
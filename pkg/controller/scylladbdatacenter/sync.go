@@ -206,12 +206,18 @@ func (sdcc *Controller) sync(ctx context.Context, key string) error {
 		return objectErr
 	}
 
-	status := sdcc.calculateStatus(sdc, statefulSetMap)
+	status := sdcc.calculateStatus(sdc, statefulSetMap, configMapMap)
 
 	if sdc.DeletionTimestamp != nil {
 		return sdcc.updateStatus(ctx, sdc, status)
 	}
 
+	now := time.Now()
+	maintenanceWindowGate, err := newMaintenanceWindowGate(sdc, now)
+	if err != nil {
+		return err
+	}
+
 	var errs []error
 
 	err = controllerhelpers.RunSync(
@@ -295,7 +301,7 @@ func (sdcc *Controller) sync(ctx context.Context, key string) error {
 		statefulSetControllerDegradedCondition,
 		sdc.Generation,
 		func() ([]metav1.Condition, error) {
-			return sdcc.syncStatefulSets(ctx, key, sdc, status, statefulSetMap, serviceMap, configMapMap)
+			return sdcc.syncStatefulSets(ctx, key, sdc, status, statefulSetMap, serviceMap, configMapMap, maintenanceWindowGate)
 		},
 	)
 	if err != nil {
@@ -313,7 +319,7 @@ func (sdcc *Controller) sync(ctx context.Context, key string) error {
 		serviceControllerDegradedCondition,
 		sdc.Generation,
 		func() ([]metav1.Condition, error) {
-			return sdcc.syncServices(ctx, sdc, status, serviceMap, statefulSetMap, jobMap)
+			return sdcc.syncServices(ctx, sdc, status, serviceMap, statefulSetMap, jobMap, maintenanceWindowGate)
 		},
 	)
 	if err != nil {
@@ -352,13 +358,77 @@ func (sdcc *Controller) sync(ctx context.Context, key string) error {
 		jobControllerDegradedCondition,
 		sdc.Generation,
 		func() ([]metav1.Condition, error) {
-			return sdcc.syncJobs(ctx, sdc, serviceMap, jobMap)
+			return sdcc.syncJobs(ctx, sdc, serviceMap, jobMap, maintenanceWindowGate)
 		},
 	)
 	if err != nil {
 		errs = append(errs, fmt.Errorf("can't sync jobs: %w", err))
 	}
 
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		removeNodeControllerProgressingCondition,
+		removeNodeControllerDegradedCondition,
+		sdc.Generation,
+		func() ([]metav1.Condition, error) {
+			return sdcc.syncRemoveNodes(ctx, sdc, serviceMap, jobMap, maintenanceWindowGate)
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync node removals: %w", err))
+	}
+
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		rebuildControllerProgressingCondition,
+		rebuildControllerDegradedCondition,
+		sdc.Generation,
+		func() ([]metav1.Condition, error) {
+			return sdcc.syncRebuild(ctx, sdc, status, serviceMap, jobMap, maintenanceWindowGate)
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync rebuild: %w", err))
+	}
+
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		upgradeSnapshotControllerProgressingCondition,
+		upgradeSnapshotControllerDegradedCondition,
+		sdc.Generation,
+		func() ([]metav1.Condition, error) {
+			return sdcc.syncUpgradeSnapshots(ctx, key, sdc, status, serviceMap)
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync upgrade snapshots: %w", err))
+	}
+
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		restoreFromBackupControllerProgressingCondition,
+		restoreFromBackupControllerDegradedCondition,
+		sdc.Generation,
+		func() ([]metav1.Condition, error) {
+			return sdcc.syncRestoreFromBackup(ctx, sdc, status)
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync restore from backup: %w", err))
+	}
+
+	sdcc.syncMaintenanceWindowStatus(key, sdc, status, maintenanceWindowGate, now)
+
+	err = sdcc.syncUpgradeFailureStatus(sdc, status, configMapMap)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync upgrade failure status: %w", err))
+	}
+
+	err = sdcc.syncUpgradeStatus(sdc, status, configMapMap)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync upgrade status: %w", err))
+	}
+
 	// Aggregate conditions.
 	err = controllerhelpers.SetAggregatedWorkloadConditions(&status.Conditions, sdc.Generation)
 	if err != nil {
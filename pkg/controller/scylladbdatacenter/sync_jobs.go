@@ -25,6 +25,7 @@ func (sdcc *Controller) syncJobs(
 	sdc *scyllav1alpha1.ScyllaDBDatacenter,
 	services map[string]*corev1.Service,
 	jobs map[string]*batchv1.Job,
+	maintenanceWindowGate *maintenanceWindowGate,
 ) ([]metav1.Condition, error) {
 	requiredJobs, progressingConditions, err := MakeJobs(sdc, services, sdcc.podLister, sdcc.operatorImage)
 	if err != nil {
@@ -37,7 +38,15 @@ func (sdcc *Controller) syncJobs(
 	var progressingMessages []string
 	var errs []error
 
-	err = controllerhelpers.Prune(ctx, requiredJobs, jobs,
+	// SSTables upgrade Jobs are managed by the upgrade.
+	cleanupJobs := map[string]*batchv1.Job{}
+	for name, job := range jobs {
+		if job.Labels[naming.NodeJobTypeLabel] == string(naming.JobTypeCleanup) {
+			cleanupJobs[name] = job
+		}
+	}
+
+	err = controllerhelpers.Prune(ctx, requiredJobs, cleanupJobs,
 		&controllerhelpers.PruneControlFuncs{
 			DeleteFunc: sdcc.kubeClient.BatchV1().Jobs(sdc.Namespace).Delete,
 		},
@@ -72,6 +81,12 @@ func (sdcc *Controller) syncJobs(
 	}
 
 	for _, job := range requiredJobs {
+		// Cleanup is disruptive, new Jobs can only start within a maintenance window.
+		_, found := jobs[job.Name]
+		if !found && !maintenanceWindowGate.allows(fmt.Sprintf("cleanup Job %q", naming.ObjRef(job))) {
+			continue
+		}
+
 		fresh, changed, err := resourceapply.ApplyJob(ctx, sdcc.kubeClient.BatchV1(), sdcc.jobLister, sdcc.eventRecorder, job, resourceapply.ApplyOptions{})
 		if changed {
 			controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, jobControllerProgressingCondition, job, "apply", sdc.Generation)
@@ -0,0 +1,82 @@
+package scylladbdatacenter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// maintenanceWindowGate decides whether disruptive operations can start
+// and keeps track of the operations that are queued until the next maintenance window.
+type maintenanceWindowGate struct {
+	open       bool
+	nextStart  time.Time
+	queuedWork []string
+}
+
+func newMaintenanceWindowGate(sdc *scyllav1alpha1.ScyllaDBDatacenter, now time.Time) (*maintenanceWindowGate, error) {
+	open, nextStart, err := controllerhelpers.GetMaintenanceWindowState(sdc.Spec.MaintenanceWindows, now)
+	if err != nil {
+		return nil, fmt.Errorf("can't evaluate maintenance windows: %w", err)
+	}
+
+	return &maintenanceWindowGate{
+		open:      open,
+		nextStart: nextStart,
+	}, nil
+}
+
+// allows returns true when a disruptive operation can start.
+// Otherwise, the operation is recorded as queued.
+func (g *maintenanceWindowGate) allows(work string) bool {
+	if g.open {
+		return true
+	}
+
+	g.queuedWork = append(g.queuedWork, work)
+
+	return false
+}
+
+// syncMaintenanceWindowStatus reports the queued operations and requeues the ScyllaDBDatacenter
+// for the start of the next maintenance window.
+func (sdcc *Controller) syncMaintenanceWindowStatus(
+	key string,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
+	gate *maintenanceWindowGate,
+	now time.Time,
+) {
+	if len(gate.queuedWork) == 0 {
+		apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               scyllav1alpha1.WaitingForMaintenanceWindowCondition,
+			Status:             metav1.ConditionFalse,
+			Reason:             internalapi.AsExpectedReason,
+			ObservedGeneration: sdc.Generation,
+		})
+		return
+	}
+
+	message := fmt.Sprintf("Waiting for a maintenance window to start: %s.", strings.Join(gate.queuedWork, ", "))
+	if !gate.nextStart.IsZero() {
+		message = fmt.Sprintf("Waiting for the next maintenance window starting at %s: %s.", gate.nextStart.Format(time.RFC3339), strings.Join(gate.queuedWork, ", "))
+
+		klog.V(2).InfoS("Disruptive operations are queued until the next maintenance window", "ScyllaDBDatacenter", klog.KObj(sdc), "NextStart", gate.nextStart, "QueuedWork", gate.queuedWork)
+		sdcc.queue.AddAfter(key, gate.nextStart.Sub(now))
+	}
+
+	apimeta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               scyllav1alpha1.WaitingForMaintenanceWindowCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             "OutsideMaintenanceWindow",
+		Message:            message,
+		ObservedGeneration: sdc.Generation,
+	})
+}
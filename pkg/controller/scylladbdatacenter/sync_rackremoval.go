@@ -0,0 +1,190 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+)
+
+// getCurrentScyllaHosts returns the hosts of all existing nodes, including those not required by the spec anymore.
+func (sdcc *Controller) getCurrentScyllaHosts(sdc *scyllav1alpha1.ScyllaDBDatacenter, statefulSets map[string]*appsv1.StatefulSet, services map[string]*corev1.Service) ([]string, error) {
+	var hosts []string
+	var errs []error
+	for _, stsName := range slices.Sorted(maps.Keys(statefulSets)) {
+		sts := statefulSets[stsName]
+		if sts.DeletionTimestamp != nil {
+			continue
+		}
+
+		for ord := int32(0); ord < *sts.Spec.Replicas; ord++ {
+			svcName := fmt.Sprintf("%s-%d", sts.Name, ord)
+			svc, ok := services[svcName]
+			if !ok {
+				errs = append(errs, fmt.Errorf("service %q does not exist", naming.ManualRef(sdc.Namespace, svcName)))
+				continue
+			}
+
+			podName := naming.PodNameFromService(svc)
+			pod, err := sdcc.podLister.Pods(sdc.Namespace).Get(podName)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("can't get pod %q: %w", naming.ManualRef(sdc.Namespace, podName), err))
+				continue
+			}
+
+			host, err := controllerhelpers.GetScyllaHost(sdc, svc, pod)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("can't get scylla host for service %q: %w", naming.ObjRef(svc), err))
+				continue
+			}
+
+			hosts = append(hosts, host)
+		}
+	}
+
+	return hosts, apimachineryutilerrors.NewAggregate(errs)
+}
+
+// verifyReplication makes sure that the datacenter keeps at least as many nodes as the replication factor of every keyspace
+// once it's scaled to the provided number of nodes.
+func (sdcc *Controller) verifyReplication(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	nodes int,
+	statefulSets map[string]*appsv1.StatefulSet,
+	services map[string]*corev1.Service,
+) error {
+	hosts, err := sdcc.getCurrentScyllaHosts(sdc, statefulSets, services)
+	if err != nil {
+		return fmt.Errorf("can't get hosts: %w", err)
+	}
+	if len(hosts) == 0 {
+		return fmt.Errorf("there are no nodes to verify the replication with")
+	}
+
+	scyllaClient, err := sdcc.getScyllaClient(ctx, sdc, hosts)
+	if err != nil {
+		return fmt.Errorf("can't get scylla client: %w", err)
+	}
+	defer scyllaClient.Close()
+
+	datacenter := naming.GetScyllaDBDatacenterGossipDatacenterName(sdc)
+	replicationFactors, err := controllerhelpers.GetDatacenterReplicationFactors(ctx, scyllaClient, hosts[0], datacenter)
+	if err != nil {
+		return err
+	}
+
+	underReplicatedKeyspaces := controllerhelpers.GetUnderReplicatedKeyspaces(nodes, replicationFactors)
+	if len(underReplicatedKeyspaces) == 0 {
+		return nil
+	}
+
+	if nodes == 0 {
+		return fmt.Errorf("keyspace(s) %q still replicate to datacenter %q, their replication has to be altered first", underReplicatedKeyspaces, datacenter)
+	}
+
+	return fmt.Errorf("datacenter %q would be left with %d node(s) which is fewer than the replication factor of keyspace(s) %q", datacenter, nodes, underReplicatedKeyspaces)
+}
+
+// decommissionRemovedRack scales the StatefulSet of a rack that was removed from the spec down by one node,
+// decommissioning the node first.
+func (sdcc *Controller) decommissionRemovedRack(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	rackStatus *scyllav1alpha1.RackStatus,
+	sts *appsv1.StatefulSet,
+	statefulSets map[string]*appsv1.StatefulSet,
+	services map[string]*corev1.Service,
+	maintenanceWindowGate *maintenanceWindowGate,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	lastSvcName := fmt.Sprintf("%s-%d", sts.Name, *sts.Spec.Replicas-1)
+	if rackStatus.Removal != nil {
+		rackStatus.Removal.DecommissioningNode = pointer.Ptr(lastSvcName)
+	}
+
+	lastSvc, ok := services[lastSvcName]
+	if !ok {
+		return progressingConditions, fmt.Errorf("can't decommission node of removed rack %q: service %q is missing", rackStatus.Name, naming.ManualRef(sdc.Namespace, lastSvcName))
+	}
+
+	switch lastSvc.Labels[naming.DecommissionedLabel] {
+	case "":
+		if !maintenanceWindowGate.allows(fmt.Sprintf("decommission of node %q of removed rack %q", lastSvcName, rackStatus.Name)) {
+			return progressingConditions, nil
+		}
+
+		// Account for the node to be decommissioned.
+		nodes := -1
+		for _, sts := range statefulSets {
+			if sts.DeletionTimestamp == nil {
+				nodes += int(*sts.Spec.Replicas)
+			}
+		}
+
+		err := sdcc.verifyReplication(ctx, sdc, nodes, statefulSets, services)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't remove rack %q: %w", rackStatus.Name, err)
+		}
+
+		lastSvcCopy := lastSvc.DeepCopy()
+		if lastSvcCopy.Labels == nil {
+			lastSvcCopy.Labels = map[string]string{}
+		}
+		// Record the intent to decommission the member.
+		lastSvcCopy.Labels[naming.DecommissionedLabel] = naming.LabelValueFalse
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, lastSvcCopy, "update", sdc.Generation)
+		_, err = sdcc.kubeClient.CoreV1().Services(lastSvcCopy.Namespace).Update(ctx, lastSvcCopy, metav1.UpdateOptions{})
+		if err != nil {
+			return progressingConditions, err
+		}
+		sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "DecommissioningRemovedRack", "Decommissioning node %q of removed rack %q", lastSvcName, rackStatus.Name)
+
+		return progressingConditions, nil
+
+	case naming.LabelValueFalse:
+		klog.V(4).InfoS("Waiting for node of removed rack to be decommissioned", "ScyllaDBDatacenter", klog.KObj(sdc), "Rack", rackStatus.Name, "Service", klog.KObj(lastSvc))
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               statefulSetControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "DecommissioningRemovedRack",
+			Message:            fmt.Sprintf("Waiting for node %q of removed rack %q to be decommissioned.", lastSvcName, rackStatus.Name),
+			ObservedGeneration: sdc.Generation,
+		})
+
+		return progressingConditions, nil
+	}
+
+	scale := &autoscalingv1.Scale{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            sts.Name,
+			Namespace:       sts.Namespace,
+			ResourceVersion: sts.ResourceVersion,
+		},
+		Spec: autoscalingv1.ScaleSpec{
+			Replicas: *sts.Spec.Replicas - 1,
+		},
+	}
+	klog.V(2).InfoS("Scaling down StatefulSet of removed rack", "ScyllaDBDatacenter", klog.KObj(sdc), "StatefulSet", klog.KObj(sts), "CurrentReplicas", *sts.Spec.Replicas, "UpdatedReplicas", scale.Spec.Replicas)
+	controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, scale, "updateScale", sdc.Generation)
+	_, err := sdcc.kubeClient.AppsV1().StatefulSets(sts.Namespace).UpdateScale(ctx, sts.Name, scale, metav1.UpdateOptions{})
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't update scale: %w", err)
+	}
+
+	return progressingConditions, nil
+}
@@ -0,0 +1,300 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+type rebuildNode struct {
+	rack    scyllav1alpha1.RackSpec
+	svcName string
+}
+
+// getRebuildNodes returns the nodes of the datacenter in the order they are rebuilt.
+func getRebuildNodes(sdc *scyllav1alpha1.ScyllaDBDatacenter) ([]rebuildNode, error) {
+	var nodes []rebuildNode
+	for _, rack := range sdc.Spec.Racks {
+		rackNodes, err := controllerhelpers.GetRackNodeCount(sdc, rack.Name)
+		if err != nil {
+			return nil, fmt.Errorf("can't get rack %q node count of ScyllaDBDatacenter %q: %w", rack.Name, naming.ObjRef(sdc), err)
+		}
+
+		for i := int32(0); i < *rackNodes; i++ {
+			nodes = append(nodes, rebuildNode{
+				rack:    rack,
+				svcName: naming.MemberServiceName(rack, sdc, int(i)),
+			})
+		}
+	}
+
+	return nodes, nil
+}
+
+// getLatestTerminationMessage returns the termination message of the most recently failed container of the Pods.
+func getLatestTerminationMessage(pods []*corev1.Pod) string {
+	var latest *corev1.ContainerStateTerminated
+	for _, pod := range pods {
+		for _, cs := range pod.Status.ContainerStatuses {
+			for _, terminated := range []*corev1.ContainerStateTerminated{cs.State.Terminated, cs.LastTerminationState.Terminated} {
+				if terminated == nil || terminated.ExitCode == 0 || len(strings.TrimSpace(terminated.Message)) == 0 {
+					continue
+				}
+
+				if latest == nil || terminated.FinishedAt.After(latest.FinishedAt.Time) {
+					latest = terminated
+				}
+			}
+		}
+	}
+
+	if latest == nil {
+		return ""
+	}
+
+	return strings.TrimSpace(latest.Message)
+}
+
+// getJobFailureMessage returns the termination message of the most recently failed Pod of the Job, if any.
+func (sdcc *Controller) getJobFailureMessage(job *batchv1.Job) (string, error) {
+	if job.Status.Failed == 0 || job.Spec.Selector == nil {
+		return "", nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+	if err != nil {
+		return "", fmt.Errorf("can't convert Job selector: %w", err)
+	}
+
+	pods, err := sdcc.podLister.Pods(job.Namespace).List(selector)
+	if err != nil {
+		return "", fmt.Errorf("can't list Pods of Job %q: %w", naming.ObjRef(job), err)
+	}
+
+	return getLatestTerminationMessage(pods), nil
+}
+
+// syncRebuild rebuilds the nodes of the datacenter from the source datacenter using a Job per node, one node at a time,
+// once all nodes of the datacenter are up.
+// Completed Jobs record the rebuilt nodes, so they are kept until rebuild is unset.
+func (sdcc *Controller) syncRebuild(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
+	services map[string]*corev1.Service,
+	jobs map[string]*batchv1.Job,
+	maintenanceWindowGate *maintenanceWindowGate,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	rebuildJobs := map[string]*batchv1.Job{}
+	for name, job := range jobs {
+		if job.Labels[naming.NodeJobTypeLabel] != string(naming.JobTypeRebuild) {
+			continue
+		}
+
+		if sdc.Spec.Rebuild != nil {
+			rebuildJobs[name] = job
+			continue
+		}
+
+		if job.DeletionTimestamp != nil {
+			continue
+		}
+
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, rebuildControllerProgressingCondition, job, "delete", sdc.Generation)
+		err := sdcc.kubeClient.BatchV1().Jobs(sdc.Namespace).Delete(ctx, job.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{
+				UID: &job.UID,
+			},
+			PropagationPolicy: pointer.Ptr(metav1.DeletePropagationBackground),
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return progressingConditions, fmt.Errorf("can't delete Job %q: %w", naming.ObjRef(job), err)
+		}
+	}
+
+	if sdc.Spec.Rebuild == nil {
+		status.Rebuild = nil
+		return progressingConditions, nil
+	}
+
+	nodes, err := getRebuildNodes(sdc)
+	if err != nil {
+		return progressingConditions, err
+	}
+
+	rebuildStatus := &scyllav1alpha1.RebuildStatus{
+		SourceDatacenter: sdc.Spec.Rebuild.SourceDatacenter,
+		Nodes:            int32(len(nodes)),
+	}
+	status.Rebuild = rebuildStatus
+
+	for _, node := range nodes {
+		job, ok := rebuildJobs[naming.RebuildJobForService(node.svcName)]
+		if ok && job.Status.CompletionTime != nil {
+			rebuildStatus.RebuiltNodes++
+		}
+	}
+
+	if rebuildStatus.RebuiltNodes == rebuildStatus.Nodes {
+		rebuildStatus.Phase = scyllav1alpha1.CompletedRebuildPhase
+		return progressingConditions, nil
+	}
+
+	if rebuildStatus.RebuiltNodes == 0 {
+		waitingCondition, err := sdcc.getRebuildWaitingForNodesCondition(sdc, services, nodes)
+		if err != nil {
+			return progressingConditions, err
+		}
+		if waitingCondition != nil {
+			rebuildStatus.Phase = scyllav1alpha1.WaitingForNodesRebuildPhase
+			progressingConditions = append(progressingConditions, *waitingCondition)
+			return progressingConditions, nil
+		}
+	}
+
+	rebuildStatus.Phase = scyllav1alpha1.RebuildingRebuildPhase
+
+	for _, node := range nodes {
+		job, ok := rebuildJobs[naming.RebuildJobForService(node.svcName)]
+		if ok {
+			if job.Status.CompletionTime != nil {
+				continue
+			}
+
+			// The reason of the failure, e.g. ScyllaDB requiring authentication to alter keyspace replication,
+			// is only known to the Job's Pods.
+			failureMessage, err := sdcc.getJobFailureMessage(job)
+			if err != nil {
+				return progressingConditions, fmt.Errorf("can't get failure message of Job %q: %w", naming.ObjRef(job), err)
+			}
+
+			if isJobFailed(job) {
+				if len(failureMessage) != 0 {
+					return progressingConditions, fmt.Errorf("job %q rebuilding node %q has failed: %s, delete it to retry", naming.ObjRef(job), node.svcName, failureMessage)
+				}
+				return progressingConditions, fmt.Errorf("job %q rebuilding node %q has failed, delete it to retry", naming.ObjRef(job), node.svcName)
+			}
+
+			rebuildStatus.RebuildingNode = pointer.Ptr(node.svcName)
+			if len(failureMessage) != 0 {
+				return progressingConditions, fmt.Errorf("job %q rebuilding node %q is failing: %s", naming.ObjRef(job), node.svcName, failureMessage)
+			}
+
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               rebuildControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForRebuild",
+				Message:            fmt.Sprintf("Waiting for Job %q to rebuild node %q.", naming.ObjRef(job), node.svcName),
+				ObservedGeneration: sdc.Generation,
+			})
+			return progressingConditions, nil
+		}
+
+		waitingCondition, err := sdcc.getRebuildWaitingForNodesCondition(sdc, services, []rebuildNode{node})
+		if err != nil {
+			return progressingConditions, err
+		}
+		if waitingCondition != nil {
+			progressingConditions = append(progressingConditions, *waitingCondition)
+			return progressingConditions, nil
+		}
+
+		if !maintenanceWindowGate.allows(fmt.Sprintf("rebuild of node %q", node.svcName)) {
+			return progressingConditions, nil
+		}
+
+		svc := services[node.svcName]
+		podName := naming.PodNameFromService(svc)
+		pod, err := sdcc.podLister.Pods(sdc.Namespace).Get(podName)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't get Pod %q: %w", naming.ManualRef(sdc.Namespace, podName), err)
+		}
+
+		nodeAddress, err := controllerhelpers.GetScyllaClientBroadcastHost(sdc, svc, pod)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't get node address of %q Pod: %w", naming.ObjRef(pod), err)
+		}
+
+		required := MakeRebuildJob(sdc, &node.rack, svc, nodeAddress, sdcc.operatorImage)
+		job, changed, err := resourceapply.ApplyJob(ctx, sdcc.kubeClient.BatchV1(), sdcc.jobLister, sdcc.eventRecorder, required, resourceapply.ApplyOptions{})
+		if changed {
+			controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, rebuildControllerProgressingCondition, required, "apply", sdc.Generation)
+		}
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't apply Job: %w", err)
+		}
+
+		rebuildStatus.RebuildingNode = pointer.Ptr(node.svcName)
+
+		klog.V(2).InfoS("Started node rebuild", "ScyllaDBDatacenter", klog.KObj(sdc), "Node", node.svcName, "SourceDatacenter", sdc.Spec.Rebuild.SourceDatacenter, "Job", klog.KObj(job))
+		sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "RebuildingNode", "Rebuilding node %q from datacenter %q", node.svcName, sdc.Spec.Rebuild.SourceDatacenter)
+
+		return progressingConditions, nil
+	}
+
+	return progressingConditions, nil
+}
+
+// getRebuildWaitingForNodesCondition returns a progressing condition when any of the nodes isn't up yet.
+func (sdcc *Controller) getRebuildWaitingForNodesCondition(
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	services map[string]*corev1.Service,
+	nodes []rebuildNode,
+) (*metav1.Condition, error) {
+	if apimeta.IsStatusConditionTrue(sdc.Status.Conditions, statefulSetControllerProgressingCondition) {
+		return &metav1.Condition{
+			Type:               rebuildControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForStatefulSetController",
+			Message:            "Waiting for StatefulSet controller to finish progressing before rebuilding nodes.",
+			ObservedGeneration: sdc.Generation,
+		}, nil
+	}
+
+	for _, node := range nodes {
+		svc, ok := services[node.svcName]
+		if !ok {
+			return &metav1.Condition{
+				Type:               rebuildControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForService",
+				Message:            fmt.Sprintf("Waiting for Service %q", naming.ManualRef(sdc.Namespace, node.svcName)),
+				ObservedGeneration: sdc.Generation,
+			}, nil
+		}
+
+		podName := naming.PodNameFromService(svc)
+		pod, err := sdcc.podLister.Pods(sdc.Namespace).Get(podName)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("can't get Pod %q: %w", naming.ManualRef(sdc.Namespace, podName), err)
+		}
+
+		if err != nil || !controllerhelpers.IsPodReady(pod) {
+			return &metav1.Condition{
+				Type:               rebuildControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForNodes",
+				Message:            fmt.Sprintf("Waiting for node %q to be ready before rebuilding nodes.", node.svcName),
+				ObservedGeneration: sdc.Generation,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,135 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbdatacenter
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_getLatestTerminationMessage(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	newPod := func(statuses ...corev1.ContainerStatus) *corev1.Pod {
+		return &corev1.Pod{
+			Status: corev1.PodStatus{
+				ContainerStatuses: statuses,
+			},
+		}
+	}
+
+	newTerminated := func(exitCode int32, message string, finishedAt time.Time) *corev1.ContainerStateTerminated {
+		return &corev1.ContainerStateTerminated{
+			ExitCode:   exitCode,
+			Message:    message,
+			FinishedAt: metav1.NewTime(finishedAt),
+		}
+	}
+
+	tt := []struct {
+		name     string
+		pods     []*corev1.Pod
+		expected string
+	}{
+		{
+			name:     "no pods",
+			pods:     nil,
+			expected: "",
+		},
+		{
+			name: "running container",
+			pods: []*corev1.Pod{
+				newPod(corev1.ContainerStatus{
+					State: corev1.ContainerState{
+						Running: &corev1.ContainerStateRunning{},
+					},
+				}),
+			},
+			expected: "",
+		},
+		{
+			name: "succeeded container is ignored",
+			pods: []*corev1.Pod{
+				newPod(corev1.ContainerStatus{
+					State: corev1.ContainerState{
+						Terminated: newTerminated(0, "done", now),
+					},
+				}),
+			},
+			expected: "",
+		},
+		{
+			name: "failed container without message is ignored",
+			pods: []*corev1.Pod{
+				newPod(corev1.ContainerStatus{
+					State: corev1.ContainerState{
+						Terminated: newTerminated(1, " \n", now),
+					},
+				}),
+			},
+			expected: "",
+		},
+		{
+			name: "failed container message is trimmed",
+			pods: []*corev1.Pod{
+				newPod(corev1.ContainerStatus{
+					State: corev1.ContainerState{
+						Terminated: newTerminated(1, "can't alter keyspace replication\n", now),
+					},
+				}),
+			},
+			expected: "can't alter keyspace replication",
+		},
+		{
+			name: "last termination state of a restarted container is used",
+			pods: []*corev1.Pod{
+				newPod(corev1.ContainerStatus{
+					State: corev1.ContainerState{
+						Running: &corev1.ContainerStateRunning{},
+					},
+					LastTerminationState: corev1.ContainerState{
+						Terminated: newTerminated(1, "previous failure", now),
+					},
+				}),
+			},
+			expected: "previous failure",
+		},
+		{
+			name: "most recent failure across pods is used",
+			pods: []*corev1.Pod{
+				newPod(corev1.ContainerStatus{
+					State: corev1.ContainerState{
+						Terminated: newTerminated(1, "older failure", now),
+					},
+				}),
+				newPod(corev1.ContainerStatus{
+					State: corev1.ContainerState{
+						Terminated: newTerminated(1, "newer failure", now.Add(time.Minute)),
+					},
+				}),
+				newPod(corev1.ContainerStatus{
+					State: corev1.ContainerState{
+						Terminated: newTerminated(1, "oldest failure", now.Add(-time.Minute)),
+					},
+				}),
+			},
+			expected: "newer failure",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getLatestTerminationMessage(tc.pods)
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
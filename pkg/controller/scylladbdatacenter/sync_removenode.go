@@ -0,0 +1,187 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// getRemoveNodeHostIDs returns the host IDs of nodes requested to be removed from the cluster, in the requested order.
+func getRemoveNodeHostIDs(sdc *scyllav1alpha1.ScyllaDBDatacenter) []string {
+	var hostIDs []string
+	for _, hostID := range strings.Split(sdc.Annotations[naming.RemoveNodeHostIDsAnnotation], ",") {
+		hostID = strings.TrimSpace(hostID)
+		if len(hostID) == 0 {
+			continue
+		}
+		hostIDs = append(hostIDs, hostID)
+	}
+
+	return hostIDs
+}
+
+// getRemoveNodeCoordinator returns the first ready node of the datacenter, which coordinates the node removal.
+func (sdcc *Controller) getRemoveNodeCoordinator(sdc *scyllav1alpha1.ScyllaDBDatacenter, services map[string]*corev1.Service) (*scyllav1alpha1.RackSpec, string, error) {
+	for _, rack := range sdc.Spec.Racks {
+		rackNodes, err := controllerhelpers.GetRackNodeCount(sdc, rack.Name)
+		if err != nil {
+			return nil, "", fmt.Errorf("can't get rack %q node count of ScyllaDBDatacenter %q: %w", rack.Name, naming.ObjRef(sdc), err)
+		}
+
+		for i := int32(0); i < *rackNodes; i++ {
+			svc, ok := services[naming.MemberServiceName(rack, sdc, int(i))]
+			if !ok {
+				continue
+			}
+
+			pod, err := sdcc.podLister.Pods(sdc.Namespace).Get(naming.PodNameFromService(svc))
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, "", fmt.Errorf("can't get Pod %q: %w", naming.ManualRef(sdc.Namespace, naming.PodNameFromService(svc)), err)
+			}
+
+			if !controllerhelpers.IsPodReady(pod) {
+				continue
+			}
+
+			nodeAddress, err := controllerhelpers.GetScyllaClientBroadcastHost(sdc, svc, pod)
+			if err != nil {
+				return nil, "", fmt.Errorf("can't get node address of %q Pod: %w", naming.ObjRef(pod), err)
+			}
+
+			return &rack, nodeAddress, nil
+		}
+	}
+
+	return nil, "", nil
+}
+
+// syncRemoveNodes removes the nodes requested by RemoveNodeHostIDsAnnotation from the cluster using a Job per node, one node at a time.
+// Jobs of the nodes that are no longer requested to be removed are deleted.
+func (sdcc *Controller) syncRemoveNodes(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	services map[string]*corev1.Service,
+	jobs map[string]*batchv1.Job,
+	maintenanceWindowGate *maintenanceWindowGate,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	hostIDs := getRemoveNodeHostIDs(sdc)
+
+	requiredJobNames := make(map[string]struct{}, len(hostIDs))
+	for _, hostID := range hostIDs {
+		requiredJobNames[naming.RemoveNodeJobForHostID(hostID)] = struct{}{}
+	}
+
+	removeNodeJobs := map[string]*batchv1.Job{}
+	for name, job := range jobs {
+		if job.Labels[naming.NodeJobTypeLabel] != string(naming.JobTypeRemoveNode) {
+			continue
+		}
+
+		if _, required := requiredJobNames[name]; required {
+			removeNodeJobs[name] = job
+			continue
+		}
+
+		if job.DeletionTimestamp != nil {
+			continue
+		}
+
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, removeNodeControllerProgressingCondition, job, "delete", sdc.Generation)
+		err := sdcc.kubeClient.BatchV1().Jobs(sdc.Namespace).Delete(ctx, job.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{
+				UID: &job.UID,
+			},
+			PropagationPolicy: pointer.Ptr(metav1.DeletePropagationBackground),
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return progressingConditions, fmt.Errorf("can't delete Job %q: %w", naming.ObjRef(job), err)
+		}
+	}
+
+	for _, hostID := range hostIDs {
+		job, ok := removeNodeJobs[naming.RemoveNodeJobForHostID(hostID)]
+		if ok {
+			if isJobFailed(job) {
+				return progressingConditions, fmt.Errorf("job %q removing node with host ID %q has failed, delete it to retry", naming.ObjRef(job), hostID)
+			}
+
+			if job.Status.CompletionTime == nil {
+				progressingConditions = append(progressingConditions, metav1.Condition{
+					Type:               removeNodeControllerProgressingCondition,
+					Status:             metav1.ConditionTrue,
+					Reason:             "WaitingForNodeRemoval",
+					Message:            fmt.Sprintf("Waiting for Job %q to remove node with host ID %q.", naming.ObjRef(job), hostID),
+					ObservedGeneration: sdc.Generation,
+				})
+				return progressingConditions, nil
+			}
+
+			continue
+		}
+
+		if apimeta.IsStatusConditionTrue(sdc.Status.Conditions, statefulSetControllerProgressingCondition) {
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               removeNodeControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForStatefulSetController",
+				Message:            fmt.Sprintf("Waiting for StatefulSet controller to finish progressing before removing node with host ID %q.", hostID),
+				ObservedGeneration: sdc.Generation,
+			})
+			return progressingConditions, nil
+		}
+
+		rack, nodeAddress, err := sdcc.getRemoveNodeCoordinator(sdc, services)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't get node coordinating the node removal: %w", err)
+		}
+		if rack == nil {
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               removeNodeControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForReadyNode",
+				Message:            fmt.Sprintf("Waiting for a ready node to remove node with host ID %q.", hostID),
+				ObservedGeneration: sdc.Generation,
+			})
+			return progressingConditions, nil
+		}
+
+		if !maintenanceWindowGate.allows(fmt.Sprintf("removal of node with host ID %q", hostID)) {
+			return progressingConditions, nil
+		}
+
+		required := MakeRemoveNodeJob(sdc, rack, hostID, nodeAddress, sdcc.operatorImage)
+		job, changed, err := resourceapply.ApplyJob(ctx, sdcc.kubeClient.BatchV1(), sdcc.jobLister, sdcc.eventRecorder, required, resourceapply.ApplyOptions{})
+		if changed {
+			controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, removeNodeControllerProgressingCondition, required, "apply", sdc.Generation)
+		}
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't apply Job: %w", err)
+		}
+
+		klog.V(2).InfoS("Started node removal", "ScyllaDBDatacenter", klog.KObj(sdc), "HostID", hostID, "Job", klog.KObj(job))
+		sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "RemovingNode", "Removing node with host ID %q from the cluster", hostID)
+
+		return progressingConditions, nil
+	}
+
+	return progressingConditions, nil
+}
@@ -0,0 +1,46 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbdatacenter
+
+import (
+	"context"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// syncRestoreFromBackup initializes the datacenter from a ScyllaDB Manager backup once it's rolled out.
+func (sdcc *Controller) syncRestoreFromBackup(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
+) ([]metav1.Condition, error) {
+	if sdc.Spec.RestoreFromBackup != nil && status.RestoreFromBackup == nil {
+		status.RestoreFromBackup = &scyllav1alpha1.RestoreFromBackupStatus{}
+	}
+
+	var waitingForRolloutMessage string
+	if !apimeta.IsStatusConditionTrue(status.Conditions, statefulSetControllerAvailableCondition) ||
+		!apimeta.IsStatusConditionFalse(status.Conditions, statefulSetControllerProgressingCondition) {
+		waitingForRolloutMessage = "Waiting for the ScyllaDBDatacenter to roll out before restoring it from backup."
+	}
+
+	return controllerhelpers.SyncRestoreFromBackup(
+		ctx,
+		sdcc.scyllaClient,
+		sdcc.scyllaDBManagerTaskLister,
+		sdcc.eventRecorder,
+		sdc,
+		scyllav1alpha1.ScyllaDBDatacenterGVK,
+		naming.ClusterLabels(sdc),
+		sdc.Spec.RestoreFromBackup,
+		status.RestoreFromBackup,
+		waitingForRolloutMessage,
+		&status.Conditions,
+		restoreFromBackupControllerAvailableCondition,
+		restoreFromBackupControllerProgressingCondition,
+	)
+}
@@ -0,0 +1,211 @@
+package scylladbdatacenter
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/util/hash"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// getCanaryRolloutStrategy returns the canary rollout strategy, or nil when it isn't used.
+func getCanaryRolloutStrategy(sdc *scyllav1alpha1.ScyllaDBDatacenter) *scyllav1alpha1.CanaryRolloutStrategy {
+	if sdc.Spec.RolloutStrategy == nil {
+		return nil
+	}
+
+	return sdc.Spec.RolloutStrategy.Canary
+}
+
+// calculateRolloutRevision returns an identifier of the desired state of ScyllaDB nodes in all racks.
+func calculateRolloutRevision(requiredStatefulSets []*appsv1.StatefulSet) (string, error) {
+	templates := make([]corev1.PodTemplateSpec, 0, len(requiredStatefulSets))
+	for _, sts := range requiredStatefulSets {
+		templates = append(templates, sts.Spec.Template)
+	}
+
+	h, err := hash.HashObjectFNV64a(templates)
+	if err != nil {
+		return "", fmt.Errorf("can't hash pod templates: %w", err)
+	}
+
+	return strconv.FormatUint(h, 16), nil
+}
+
+// getCanaryPartitions returns StatefulSet partitions that limit the update to the canary nodes.
+// Canary nodes are assigned to racks in order, starting with the highest ordinals as StatefulSets update those first.
+func getCanaryPartitions(requiredStatefulSets []*appsv1.StatefulSet, canaryNodes int32) map[string]int32 {
+	partitions := make(map[string]int32, len(requiredStatefulSets))
+
+	remaining := canaryNodes
+	for _, sts := range requiredStatefulSets {
+		replicas := *sts.Spec.Replicas
+		rackCanaryNodes := min(remaining, replicas)
+		partitions[sts.Name] = replicas - rackCanaryNodes
+		remaining -= rackCanaryNodes
+	}
+
+	return partitions
+}
+
+// getPartitionedNodes returns the number of nodes the partitions of the StatefulSets allow to update.
+func getPartitionedNodes(statefulSets []*appsv1.StatefulSet) int32 {
+	var nodes int32
+	for _, sts := range statefulSets {
+		partition := int32(0)
+		if sts.Spec.UpdateStrategy.RollingUpdate != nil && sts.Spec.UpdateStrategy.RollingUpdate.Partition != nil {
+			partition = *sts.Spec.UpdateStrategy.RollingUpdate.Partition
+		}
+
+		nodes += max(*sts.Spec.Replicas-partition, 0)
+	}
+
+	return nodes
+}
+
+// areStatefulSetsFullyUpdated returns true when all replicas of the StatefulSets run the update revision.
+func areStatefulSetsFullyUpdated(statefulSets []*appsv1.StatefulSet) bool {
+	for _, sts := range statefulSets {
+		if sts.Status.ObservedGeneration < sts.Generation || sts.Status.UpdateRevision != sts.Status.CurrentRevision {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isCanaryStatefulSetRolledOut returns true when the StatefulSet updated the nodes its partition allows.
+// Unlike controllerhelpers.IsStatefulSetRolledOut, it accepts nodes below the partition being at the update revision already,
+// which happens when the canary partition is set on a StatefulSet whose template didn't change.
+func isCanaryStatefulSetRolledOut(sts *appsv1.StatefulSet) (bool, error) {
+	if sts.Spec.Replicas != nil && sts.Status.UpdatedReplicas == *sts.Spec.Replicas && sts.Status.UpdateRevision == sts.Status.CurrentRevision {
+		sts = sts.DeepCopy()
+		sts.Spec.UpdateStrategy.RollingUpdate = nil
+	}
+
+	return controllerhelpers.IsStatefulSetRolledOut(sts)
+}
+
+// getUpdatedNodes returns the names of the nodes running the update revision of their StatefulSet.
+func (sdcc *Controller) getUpdatedNodes(statefulSets []*appsv1.StatefulSet) ([]string, error) {
+	var updatedNodes []string
+	for _, sts := range statefulSets {
+		if len(sts.Status.UpdateRevision) == 0 {
+			continue
+		}
+
+		for ord := int32(0); ord < *sts.Spec.Replicas; ord++ {
+			podName := fmt.Sprintf("%s-%d", sts.Name, ord)
+			pod, err := sdcc.podLister.Pods(sts.Namespace).Get(podName)
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, fmt.Errorf("can't get Pod %q: %w", naming.ManualRef(sts.Namespace, podName), err)
+			}
+
+			if pod.Labels[appsv1.ControllerRevisionHashLabelKey] == sts.Status.UpdateRevision {
+				updatedNodes = append(updatedNodes, pod.Name)
+			}
+		}
+	}
+
+	return updatedNodes, nil
+}
+
+// syncRolloutStatus tracks the rollout of the required revision when a canary rollout strategy is used.
+func (sdcc *Controller) syncRolloutStatus(
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
+	requiredStatefulSets []*appsv1.StatefulSet,
+	statefulSets map[string]*appsv1.StatefulSet,
+) error {
+	if getCanaryRolloutStrategy(sdc) == nil {
+		status.Rollout = nil
+		return nil
+	}
+
+	revision, err := calculateRolloutRevision(requiredStatefulSets)
+	if err != nil {
+		return fmt.Errorf("can't calculate rollout revision: %w", err)
+	}
+
+	if status.Rollout == nil || status.Rollout.Revision != revision {
+		klog.V(2).InfoS("Starting a new rollout", "ScyllaDBDatacenter", klog.KObj(sdc), "Revision", revision)
+		status.Rollout = &scyllav1alpha1.RolloutStatus{
+			Revision: revision,
+		}
+	}
+
+	var existingStatefulSets []*appsv1.StatefulSet
+	for _, req := range requiredStatefulSets {
+		sts, ok := statefulSets[req.Name]
+		if ok {
+			existingStatefulSets = append(existingStatefulSets, sts)
+		}
+	}
+
+	updatedNodes, err := sdcc.getUpdatedNodes(existingStatefulSets)
+	if err != nil {
+		return fmt.Errorf("can't get updated nodes: %w", err)
+	}
+	status.Rollout.UpdatedNodes = updatedNodes
+
+	return nil
+}
+
+// holdRolloutForCanary returns true when the rollout has to stop after updating the given number of nodes,
+// until the canary nodes soak or the rollout is approved.
+// The caller is responsible for making sure the updated nodes are ready.
+func (sdcc *Controller) holdRolloutForCanary(key string, sdc *scyllav1alpha1.ScyllaDBDatacenter, rollout *scyllav1alpha1.RolloutStatus, updatedNodes int32) bool {
+	canary := getCanaryRolloutStrategy(sdc)
+	if canary == nil || rollout == nil || rollout.CanaryCompleted {
+		return false
+	}
+
+	if updatedNodes < canary.Nodes {
+		return false
+	}
+
+	now := time.Now()
+	if rollout.CanaryReadyTime == nil {
+		rollout.CanaryReadyTime = pointer.Ptr(metav1.NewTime(now))
+	}
+
+	approved := canary.ApprovedRevision != nil && *canary.ApprovedRevision == rollout.Revision
+
+	soaked := false
+	if canary.SoakDuration != nil {
+		soakEnd := rollout.CanaryReadyTime.Add(canary.SoakDuration.Duration)
+		if now.Before(soakEnd) {
+			sdcc.queue.AddAfter(key, soakEnd.Sub(now))
+		} else {
+			soaked = true
+		}
+	}
+
+	if approved || soaked {
+		klog.V(2).InfoS("Canary nodes are done, continuing the rollout", "ScyllaDBDatacenter", klog.KObj(sdc), "Revision", rollout.Revision, "Approved", approved, "Soaked", soaked)
+		sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "CanaryRolloutCompleted", "Continuing rollout of revision %q past %d canary node(s)", rollout.Revision, canary.Nodes)
+		rollout.Paused = false
+		rollout.CanaryCompleted = true
+		return false
+	}
+
+	if !rollout.Paused {
+		sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "CanaryRolloutPaused", "Rollout of revision %q is paused after updating %d canary node(s)", rollout.Revision, updatedNodes)
+	}
+	klog.V(4).InfoS("Rollout is held for canary nodes", "ScyllaDBDatacenter", klog.KObj(sdc), "Revision", rollout.Revision)
+	rollout.Paused = true
+
+	return true
+}
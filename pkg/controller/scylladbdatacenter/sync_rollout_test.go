@@ -0,0 +1,175 @@
+package scylladbdatacenter
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_getCanaryPartitions(t *testing.T) {
+	t.Parallel()
+
+	newStatefulSet := func(name string, replicas int32) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: pointer.Ptr(replicas),
+			},
+		}
+	}
+
+	tt := []struct {
+		name               string
+		statefulSets       []*appsv1.StatefulSet
+		canaryNodes        int32
+		expectedPartitions map[string]int32
+	}{
+		{
+			name: "single canary node is taken from the first rack",
+			statefulSets: []*appsv1.StatefulSet{
+				newStatefulSet("a", 3),
+				newStatefulSet("b", 3),
+			},
+			canaryNodes: 1,
+			expectedPartitions: map[string]int32{
+				"a": 2,
+				"b": 3,
+			},
+		},
+		{
+			name: "canary nodes spill over to the next rack",
+			statefulSets: []*appsv1.StatefulSet{
+				newStatefulSet("a", 2),
+				newStatefulSet("b", 3),
+				newStatefulSet("c", 3),
+			},
+			canaryNodes: 3,
+			expectedPartitions: map[string]int32{
+				"a": 0,
+				"b": 2,
+				"c": 3,
+			},
+		},
+		{
+			name: "more canary nodes than nodes updates everything",
+			statefulSets: []*appsv1.StatefulSet{
+				newStatefulSet("a", 1),
+				newStatefulSet("b", 1),
+			},
+			canaryNodes: 5,
+			expectedPartitions: map[string]int32{
+				"a": 0,
+				"b": 0,
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getCanaryPartitions(tc.statefulSets, tc.canaryNodes)
+			if !cmp.Equal(got, tc.expectedPartitions) {
+				t.Errorf("expected and got partitions differ:\n%s", cmp.Diff(tc.expectedPartitions, got))
+			}
+
+			for _, sts := range tc.statefulSets {
+				sts.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateStatefulSetStrategy{
+					Partition: pointer.Ptr(got[sts.Name]),
+				}
+			}
+
+			var totalNodes int32
+			for _, sts := range tc.statefulSets {
+				totalNodes += *sts.Spec.Replicas
+			}
+			expectedPartitionedNodes := min(tc.canaryNodes, totalNodes)
+			gotPartitionedNodes := getPartitionedNodes(tc.statefulSets)
+			if gotPartitionedNodes != expectedPartitionedNodes {
+				t.Errorf("expected %d partitioned nodes, got %d", expectedPartitionedNodes, gotPartitionedNodes)
+			}
+		})
+	}
+}
+
+func Test_isCanaryStatefulSetRolledOut(t *testing.T) {
+	t.Parallel()
+
+	newStatefulSet := func(partition int32, status appsv1.StatefulSetStatus) *appsv1.StatefulSet {
+		status.ObservedGeneration = 1
+		status.Replicas = 3
+		status.ReadyReplicas = 3
+		status.AvailableReplicas = 3
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Generation: 1,
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: pointer.Ptr(int32(3)),
+				UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+					Type: appsv1.RollingUpdateStatefulSetStrategyType,
+					RollingUpdate: &appsv1.RollingUpdateStatefulSetStrategy{
+						Partition: pointer.Ptr(partition),
+					},
+				},
+			},
+			Status: status,
+		}
+	}
+
+	tt := []struct {
+		name     string
+		sts      *appsv1.StatefulSet
+		expected bool
+	}{
+		{
+			name: "canary nodes are updated",
+			sts: newStatefulSet(2, appsv1.StatefulSetStatus{
+				CurrentRevision: "old",
+				UpdateRevision:  "new",
+				CurrentReplicas: 2,
+				UpdatedReplicas: 1,
+			}),
+			expected: true,
+		},
+		{
+			name: "canary nodes are being updated",
+			sts: newStatefulSet(2, appsv1.StatefulSetStatus{
+				CurrentRevision: "old",
+				UpdateRevision:  "new",
+				CurrentReplicas: 3,
+				UpdatedReplicas: 0,
+			}),
+			expected: false,
+		},
+		{
+			name: "all nodes are at the update revision already",
+			sts: newStatefulSet(2, appsv1.StatefulSetStatus{
+				CurrentRevision: "same",
+				UpdateRevision:  "same",
+				CurrentReplicas: 3,
+				UpdatedReplicas: 3,
+			}),
+			expected: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := isCanaryStatefulSetRolledOut(tc.sts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
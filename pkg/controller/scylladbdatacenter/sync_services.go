@@ -90,8 +90,10 @@ func (sdcc *Controller) pruneServices(
 			return spec.Name == rackName
 		})
 		if !ok {
-			errs = append(errs, fmt.Errorf("can't find rack spec for service %q having %q rack label", naming.ObjRef(svc), rackName))
-			continue
+			// Services of removed racks are cleaned up as their nodes are decommissioned.
+			rackSpec = scyllav1alpha1.RackSpec{
+				Name: rackName,
+			}
 		}
 
 		stsName := naming.StatefulSetNameForRack(rackSpec, sdc)
@@ -205,6 +207,7 @@ func (sdcc *Controller) syncServices(
 	services map[string]*corev1.Service,
 	statefulSets map[string]*appsv1.StatefulSet,
 	jobs map[string]*batchv1.Job,
+	maintenanceWindowGate *maintenanceWindowGate,
 ) ([]metav1.Condition, error) {
 	requiredServices, err := sdcc.makeServices(sdc, services, jobs)
 	if err != nil {
@@ -246,6 +249,12 @@ func (sdcc *Controller) syncServices(
 			return progressingConditions, fmt.Errorf("can't replace node %q, ScyllaDB version of %q ScyllaCluster doesn't support HostID based replace procedure", naming.ObjRef(svc), naming.ObjRef(sdc))
 		}
 
+		// Replacements that have already started are finished regardless of maintenance windows.
+		_, replacing := svc.Labels[naming.ReplacingNodeHostIDLabel]
+		if !replacing && !maintenanceWindowGate.allows(fmt.Sprintf("replacement of node %q", naming.ObjRef(svc))) {
+			continue
+		}
+
 		klog.V(4).InfoS("Replacing node using HostID", "ScyllaDBDatacenter", klog.KObj(sdc), "Service", klog.KObj(svc))
 		pcs, err := sdcc.replaceNodeUsingHostID(ctx, sdc, svc)
 		if err != nil {
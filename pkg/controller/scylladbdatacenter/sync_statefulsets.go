@@ -4,13 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/blang/semver"
 	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
 	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
-	"github.com/scylladb/scylla-operator/pkg/helpers"
 	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
 	"github.com/scylladb/scylla-operator/pkg/internalapi"
 	"github.com/scylladb/scylla-operator/pkg/naming"
@@ -18,7 +19,6 @@ import (
 	"github.com/scylladb/scylla-operator/pkg/resourceapply"
 	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
 	"github.com/scylladb/scylla-operator/pkg/util/hash"
-	"github.com/scylladb/scylla-operator/pkg/util/parallel"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -47,87 +47,20 @@ func (sdcc *Controller) makeRacks(sdc *scyllav1alpha1.ScyllaDBDatacenter, statef
 			return nil, err
 		}
 
+		// Track the pod template separately, so we can tell rolling restarts from other StatefulSet updates.
+		podTemplateHash, err := hash.HashObjects(sts.Spec.Template)
+		if err != nil {
+			return nil, fmt.Errorf("can't hash pod template: %w", err)
+		}
+		sts.Annotations[naming.PodTemplateHashAnnotation] = podTemplateHash
+
 		sets = append(sets, sts)
 	}
 	return sets, nil
 }
 
-func (sdcc *Controller) getScyllaManagerAgentToken(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter) (string, error) {
-	secretName := naming.AgentAuthTokenSecretName(sdc)
-	secret, err := sdcc.secretLister.Secrets(sdc.Namespace).Get(secretName)
-	if err != nil {
-		return "", fmt.Errorf("can't get manager agent auth secret %s/%s: %w", sdc.Namespace, secretName, err)
-	}
-
-	token, err := helpers.GetAgentAuthTokenFromSecret(secret)
-	if err != nil {
-		return "", fmt.Errorf("can't get agent token from secret %s: %w", naming.ObjRef(secret), err)
-	}
-
-	return token, nil
-}
-
 func (sdcc *Controller) getScyllaClient(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, hosts []string) (*scyllaclient.Client, error) {
-	managerAgentAuthToken, err := sdcc.getScyllaManagerAgentToken(ctx, sdc)
-	if err != nil {
-		return nil, fmt.Errorf("can't get manager agent auth token: %w", err)
-	}
-
-	client, err := controllerhelpers.NewScyllaClientFromToken(hosts, managerAgentAuthToken)
-	if err != nil {
-		return nil, err
-	}
-
-	return client, nil
-}
-
-func (sdcc *Controller) backupKeyspaces(ctx context.Context, scyllaClient *scyllaclient.Client, hosts, keyspaces []string, snapshotTag string) error {
-	return parallel.ForEach(len(hosts), func(i int) error {
-		host := hosts[i]
-
-		snapshots, err := scyllaClient.Snapshots(ctx, host)
-		if err != nil {
-			return fmt.Errorf("can't list snapshots on host %q: %w", host, err)
-		}
-
-		if oslices.ContainsItem(snapshots, snapshotTag) {
-			return nil
-		}
-
-		for _, keyspace := range keyspaces {
-			err := scyllaClient.TakeSnapshot(ctx, host, snapshotTag, keyspace)
-			if err != nil {
-				return fmt.Errorf("can't take a snapshot on host %q and keyspace %q: %w", host, keyspace, err)
-			}
-		}
-
-		return nil
-	})
-}
-
-func (sdcc *Controller) removeSnapshot(ctx context.Context, scyllaClient *scyllaclient.Client, hosts, snapshotTags []string) error {
-	return parallel.ForEach(len(hosts), func(i int) error {
-		host := hosts[i]
-
-		snapshots, err := scyllaClient.Snapshots(ctx, host)
-		if err != nil {
-			return fmt.Errorf("can't list snapshots on host %q: %w", host, err)
-		}
-
-		snapshotSet := apimachineryutilsets.NewString(snapshots...)
-		for _, snapshotTag := range snapshotTags {
-			if !snapshotSet.Has(snapshotTag) {
-				continue
-			}
-
-			err := scyllaClient.DeleteSnapshot(ctx, host, snapshotTag)
-			if err != nil {
-				return fmt.Errorf("can't delete snapshot %q on host %q: %w", snapshotTag, host, err)
-			}
-		}
-
-		return nil
-	})
+	return controllerhelpers.NewScyllaClientForScyllaDBDatacenter(sdc, hosts, sdcc.secretLister)
 }
 
 // beforeUpgrade runs hooks before a cluster upgrade starts.
@@ -162,7 +95,7 @@ func (sdcc *Controller) beforeUpgrade(ctx context.Context, sdc *scyllav1alpha1.S
 	// Snapshot system tables.
 
 	klog.V(4).InfoS("Backing up system keyspaces", "ScyllaDBDatacenter", klog.KObj(sdc))
-	err = sdcc.backupKeyspaces(ctx, scyllaClient, hosts, systemKeyspaces, upgradeContext.SystemSnapshotTag)
+	err = controllerhelpers.SnapshotKeyspaces(ctx, scyllaClient, hosts, systemKeyspaces, upgradeContext.SystemSnapshotTag)
 	if err != nil {
 		return true, err
 	}
@@ -175,6 +108,10 @@ func (sdcc *Controller) afterUpgrade(ctx context.Context, sdc *scyllav1alpha1.Sc
 	klog.V(2).InfoS("Running post-upgrade hook", "ScyllaDBDatacenter", klog.KObj(sdc))
 	defer klog.V(2).InfoS("Finished running post-upgrade hook", "ScyllaDBDatacenter", klog.KObj(sdc))
 
+	if !shouldRemoveSnapshotsAfterUpgrade(sdc) {
+		return nil
+	}
+
 	hosts, err := controllerhelpers.GetRequiredScyllaHosts(sdc, services, sdcc.podLister)
 	if err != nil {
 		return err
@@ -187,7 +124,7 @@ func (sdcc *Controller) afterUpgrade(ctx context.Context, sdc *scyllav1alpha1.Sc
 	defer scyllaClient.Close()
 
 	// Clear system backup.
-	err = sdcc.removeSnapshot(ctx, scyllaClient, hosts, []string{upgradeContext.SystemSnapshotTag})
+	err = controllerhelpers.RemoveSnapshots(ctx, scyllaClient, hosts, []string{upgradeContext.SystemSnapshotTag})
 	if err != nil {
 		return err
 	}
@@ -264,14 +201,17 @@ func (sdcc *Controller) beforeNodeUpgrade(ctx context.Context, sdc *scyllav1alph
 		return true, fmt.Errorf("can't list keyspaces for host %q: %w", host, err)
 	}
 
-	keyspaceSet := apimachineryutilsets.NewString(allKeyspaces...)
-	keyspaceSet.Delete(systemKeyspaces...)
-	klog.V(4).InfoS("Backing up data keyspaces", "ScyllaDBDatacenter", klog.KObj(sdc), "Host", host)
-	err = sdcc.backupKeyspaces(ctx, scyllaClient, []string{host}, keyspaceSet.List(), upgradeContext.DataSnapshotTag)
-	if err != nil {
-		return true, err
+	// Data snapshots are disabled when the upgrade context has no data snapshot tag.
+	if len(upgradeContext.DataSnapshotTag) != 0 {
+		keyspaceSet := apimachineryutilsets.NewString(allKeyspaces...)
+		keyspaceSet.Delete(systemKeyspaces...)
+		klog.V(4).InfoS("Backing up data keyspaces", "ScyllaDBDatacenter", klog.KObj(sdc), "Host", host)
+		err = controllerhelpers.SnapshotKeyspaces(ctx, scyllaClient, []string{host}, keyspaceSet.List(), upgradeContext.DataSnapshotTag)
+		if err != nil {
+			return true, err
+		}
+		klog.V(4).InfoS("Backed up data keyspaces", "ScyllaDBDatacenter", klog.KObj(sdc), "Host", host)
 	}
-	klog.V(4).InfoS("Backed up data keyspaces", "ScyllaDBDatacenter", klog.KObj(sdc), "Host", host)
 
 	// Disable maintenance mode.
 	_, err = sdcc.kubeClient.CoreV1().Services(svc.Namespace).Patch(
@@ -306,6 +246,10 @@ func (sdcc *Controller) beforeNodeUpgrade(ctx context.Context, sdc *scyllav1alph
 }
 
 func (sdcc *Controller) afterNodeUpgrade(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, sts *appsv1.StatefulSet, ordinal int32, services map[string]*corev1.Service, upgradeContext *internalapi.DatacenterUpgradeContext) error {
+	if len(upgradeContext.DataSnapshotTag) == 0 || !shouldRemoveSnapshotsAfterUpgrade(sdc) {
+		return nil
+	}
+
 	svcName := fmt.Sprintf("%s-%d", sts.Name, ordinal)
 	svc, ok := services[svcName]
 	if !ok {
@@ -330,7 +274,7 @@ func (sdcc *Controller) afterNodeUpgrade(ctx context.Context, sdc *scyllav1alpha
 	defer scyllaClient.Close()
 
 	// Clear data backup.
-	err = sdcc.removeSnapshot(ctx, scyllaClient, []string{host}, []string{upgradeContext.DataSnapshotTag})
+	err = controllerhelpers.RemoveSnapshots(ctx, scyllaClient, []string{host}, []string{upgradeContext.DataSnapshotTag})
 	if err != nil {
 		return err
 	}
@@ -344,10 +288,16 @@ func (sdcc *Controller) pruneStatefulSets(
 	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
 	requiredStatefulSets []*appsv1.StatefulSet,
 	statefulSets map[string]*appsv1.StatefulSet,
+	services map[string]*corev1.Service,
+	maintenanceWindowGate *maintenanceWindowGate,
 ) ([]metav1.Condition, error) {
 	var errs []error
 	var progressingConditions []metav1.Condition
-	for _, sts := range statefulSets {
+
+	// Racks are removed one at a time, in a stable order.
+	stsNames := slices.Sorted(maps.Keys(statefulSets))
+	for _, stsName := range stsNames {
+		sts := statefulSets[stsName]
 		if sts.DeletionTimestamp != nil {
 			continue
 		}
@@ -362,7 +312,45 @@ func (sdcc *Controller) pruneStatefulSets(
 			continue
 		}
 
-		// TODO: Decommission the rack before removal.
+		rackName, found := sts.Labels[naming.RackNameLabel]
+		if found {
+			// Nodes of removed racks have to be decommissioned before the rack can be deleted.
+			if *sts.Spec.Replicas > 0 {
+				rackStatus := &scyllav1alpha1.RackStatus{
+					Name: rackName,
+				}
+				idx := slices.IndexFunc(status.Racks, func(rs scyllav1alpha1.RackStatus) bool {
+					return rs.Name == rackName
+				})
+				if idx >= 0 {
+					rackStatus = &status.Racks[idx]
+				}
+
+				decommissionProgressingConditions, err := sdcc.decommissionRemovedRack(ctx, sdc, rackStatus, sts, statefulSets, services, maintenanceWindowGate)
+				progressingConditions = append(progressingConditions, decommissionProgressingConditions...)
+				return progressingConditions, err
+			}
+
+			// Wait for the services of the decommissioned nodes to be cleaned up, along with their PVCs.
+			hasServices := false
+			for _, svc := range services {
+				if svc.Labels[naming.RackNameLabel] == rackName {
+					hasServices = true
+					break
+				}
+			}
+			if hasServices {
+				klog.V(4).InfoS("Waiting for services of removed rack to be cleaned up", "ScyllaDBDatacenter", klog.KObj(sdc), "Rack", rackName)
+				progressingConditions = append(progressingConditions, metav1.Condition{
+					Type:               statefulSetControllerProgressingCondition,
+					Status:             metav1.ConditionTrue,
+					Reason:             "WaitingForRemovedRackCleanup",
+					Message:            fmt.Sprintf("Waiting for services of removed rack %q to be cleaned up.", rackName),
+					ObservedGeneration: sdc.Generation,
+				})
+				return progressingConditions, nil
+			}
+		}
 
 		propagationPolicy := metav1.DeletePropagationBackground
 		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, sts, "delete", sdc.Generation)
@@ -377,7 +365,6 @@ func (sdcc *Controller) pruneStatefulSets(
 			continue
 		}
 
-		rackName, found := sts.Labels[naming.RackNameLabel]
 		if !found {
 			klog.ErrorS(errors.New("statefulset is missing a rack label"),
 				"Can't clean rack status for deleted StatefulSet",
@@ -409,9 +396,19 @@ func (sdcc *Controller) createMissingStatefulSets(
 		// Check the adopted set.
 		sts, found := statefulSets[req.Name]
 		if !found {
+			// Volumes seeded from snapshots have to exist before the StatefulSet creates empty ones.
+			seedProgressingConditions, err := sdcc.seedRackVolumes(ctx, sdc, req)
+			progressingConditions = append(progressingConditions, seedProgressingConditions...)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("can't seed volumes of statefulset %q: %w", naming.ObjRef(req), err))
+				continue
+			}
+			if len(seedProgressingConditions) > 0 {
+				return progressingConditions, apimachineryutilerrors.NewAggregate(errs)
+			}
+
 			klog.V(2).InfoS("Creating missing StatefulSet", "StatefulSet", klog.KObj(req))
 			var changed bool
-			var err error
 			sts, changed, err = resourceapply.ApplyStatefulSet(ctx, sdcc.kubeClient.AppsV1(), sdcc.statefulSetLister, sdcc.eventRecorder, req, resourceapply.ApplyOptions{})
 			if err != nil {
 				errs = append(errs, fmt.Errorf("can't create missing statefulset: %w", err))
@@ -478,10 +475,17 @@ func (sdcc *Controller) syncStatefulSets(
 	statefulSets map[string]*appsv1.StatefulSet,
 	services map[string]*corev1.Service,
 	configMaps map[string]*corev1.ConfigMap,
+	maintenanceWindowGate *maintenanceWindowGate,
 ) ([]metav1.Condition, error) {
 	var err error
 	var progressingConditions []metav1.Condition
 
+	// Upgrades through the upgrade path are carried out one image at a time.
+	sdc, err = sdcc.applyUpgradePath(sdc, statefulSets, configMaps)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't apply upgrade path: %w", err)
+	}
+
 	managedScyllaDBConfigCMName := naming.GetScyllaDBManagedConfigCMName(sdc.Name)
 	managedScyllaDBConfigCM, found := configMaps[managedScyllaDBConfigCMName]
 	if !found {
@@ -514,7 +518,7 @@ func (sdcc *Controller) syncStatefulSets(
 
 	// Delete any excessive StatefulSets.
 	// Delete has to be the first action to avoid getting stuck on quota.
-	pruneProgressingConditions, err := sdcc.pruneStatefulSets(ctx, sdc, status, requiredStatefulSets, statefulSets)
+	pruneProgressingConditions, err := sdcc.pruneStatefulSets(ctx, sdc, status, requiredStatefulSets, statefulSets, services, maintenanceWindowGate)
 	progressingConditions = append(progressingConditions, pruneProgressingConditions...)
 	if err != nil {
 		return progressingConditions, fmt.Errorf("can't delete StatefulSet(s): %w", err)
@@ -552,6 +556,26 @@ func (sdcc *Controller) syncStatefulSets(
 		return progressingConditions, nil
 	}
 
+	// Expand storage before any update, volume claim templates have to match for StatefulSet updates to succeed.
+	storageProgressingConditions, err := sdcc.syncStorageExpansion(ctx, sdc, status, requiredStatefulSets, statefulSets)
+	progressingConditions = append(progressingConditions, storageProgressingConditions...)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't expand storage: %w", err)
+	}
+	if len(storageProgressingConditions) > 0 {
+		return progressingConditions, nil
+	}
+
+	// Migrate racks to a different storage class before any update, so the volume claim templates match.
+	storageMigrationProgressingConditions, err := sdcc.syncStorageMigration(ctx, sdc, status, requiredStatefulSets, statefulSets, services, maintenanceWindowGate)
+	progressingConditions = append(progressingConditions, storageMigrationProgressingConditions...)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't migrate storage: %w", err)
+	}
+	if len(storageMigrationProgressingConditions) > 0 {
+		return progressingConditions, nil
+	}
+
 	// Scale before the update.
 	for _, req := range requiredStatefulSets {
 		sts := statefulSets[req.Name]
@@ -642,6 +666,14 @@ func (sdcc *Controller) syncStatefulSets(
 			}
 
 			if len(lastSvc.Labels[naming.DecommissionedLabel]) == 0 {
+				// Emptying the datacenter would lose the data of keyspaces still replicated to it.
+				if controllerhelpers.IsScyllaDBDatacenterScaledToZero(sdc) {
+					err := sdcc.verifyReplication(ctx, sdc, 0, statefulSets, services)
+					if err != nil {
+						return progressingConditions, fmt.Errorf("can't scale the datacenter down to zero nodes: %w", err)
+					}
+				}
+
 				lastSvcCopy := lastSvc.DeepCopy()
 				// Record the intent to decommission the member.
 				// TODO: Move this into syncServices so it reconciles properly. This is edge triggered
@@ -665,6 +697,16 @@ func (sdcc *Controller) syncStatefulSets(
 		return progressingConditions, err
 	}
 
+	// Failed upgrades have to be handled before waiting for the racks, as the failed nodes never become ready.
+	upgradeFailureProgressingConditions, upgradeFailed, err := sdcc.syncUpgradeFailure(ctx, key, sdc, status, statefulSets, configMaps, inputsHash)
+	progressingConditions = append(progressingConditions, upgradeFailureProgressingConditions...)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't sync upgrade failure: %w", err)
+	}
+	if upgradeFailed || len(upgradeFailureProgressingConditions) > 0 {
+		return progressingConditions, nil
+	}
+
 	// TODO: This blocks unstucking by an update.
 	//  	 Also blocks lowering resources when the cluster is running low.
 	// Wait for all racks to be up and ready.
@@ -689,6 +731,11 @@ func (sdcc *Controller) syncStatefulSets(
 		}
 	}
 
+	err = sdcc.syncRolloutStatus(sdc, status, requiredStatefulSets, statefulSets)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't sync rollout status: %w", err)
+	}
+
 	upgradeContextConfigMap, ok := configMaps[naming.UpgradeContextConfigMapName(sdc)]
 	// Run hooks if an upgrade is in progress.
 	if ok {
@@ -863,10 +910,23 @@ func (sdcc *Controller) syncStatefulSets(
 					continue
 				}
 
+				// Hold the rollout once the canary nodes are updated.
+				if sdcc.holdRolloutForCanary(key, sdc, status.Rollout, getPartitionedNodes(requiredStatefulSets)) {
+					return progressingConditions, nil
+				}
+
 				nextPartition := partition - 1
 
 				klog.V(4).InfoS("Upgrade is running a rollout", "Partition", partition, "NextPartition", nextPartition)
 
+				// Record the node upgrade start, so nodes that don't become ready in time can fail the upgrade.
+				upgradingNode := fmt.Sprintf("%s-%d", sts.Name, nextPartition)
+				if currentUpgradeContext.UpgradingNode != upgradingNode {
+					currentUpgradeContext.UpgradingNode = upgradingNode
+					currentUpgradeContext.NodeUpgradeStartTime = pointer.Ptr(time.Now())
+					return sdcc.applyUpgradeContext(ctx, sdc, currentUpgradeContext)
+				}
+
 				// TODO: Move the pre-node-upgrade hook into a Job.
 				done, err := sdcc.beforeNodeUpgrade(ctx, sdc, sts, nextPartition, services, currentUpgradeContext)
 				if err != nil {
@@ -932,11 +992,24 @@ func (sdcc *Controller) syncStatefulSets(
 			return progressingConditions, nil
 
 		case internalapi.PostHooksUpgradePhase:
+			if isUpgradeSSTablesEnabled(sdc) {
+				upgradeSSTablesProgressingConditions, err := sdcc.syncUpgradeSSTables(ctx, sdc, services, currentUpgradeContext, maintenanceWindowGate)
+				progressingConditions = append(progressingConditions, upgradeSSTablesProgressingConditions...)
+				if err != nil {
+					return progressingConditions, fmt.Errorf("can't upgrade SSTables: %w", err)
+				}
+				if len(upgradeSSTablesProgressingConditions) > 0 {
+					return progressingConditions, nil
+				}
+			}
+
 			err = sdcc.afterUpgrade(ctx, sdc, services, currentUpgradeContext)
 			if err != nil {
 				return progressingConditions, err
 			}
 
+			completeUpgradeSnapshotStatus(sdc, status, currentUpgradeContext, time.Now())
+
 			cmName := naming.UpgradeContextConfigMapName(sdc)
 			cm, ok := configMaps[cmName]
 			if !ok {
@@ -956,6 +1029,10 @@ func (sdcc *Controller) syncStatefulSets(
 
 			return progressingConditions, nil
 
+		case internalapi.RollbackUpgradePhase, internalapi.FailedUpgradePhase:
+			// Failed upgrades are handled by syncUpgradeFailure.
+			return progressingConditions, nil
+
 		default:
 			// An old cluster with an old state machine can still be going through an update, or stuck.
 			// Given have to be reentrant we'll just start again to be sure no step is missed, even a new one.
@@ -986,6 +1063,13 @@ func (sdcc *Controller) syncStatefulSets(
 			time.Sleep(artificialDelayForCachesToCatchUp)
 		}
 	}()
+	canary := getCanaryRolloutStrategy(sdc)
+	var canaryPartitions map[string]int32
+	if canary != nil && status.Rollout != nil && !status.Rollout.CanaryCompleted {
+		canaryPartitions = getCanaryPartitions(requiredStatefulSets, canary.Nodes)
+	}
+	anyRolloutQueued := false
+	var updatedStatefulSets []*appsv1.StatefulSet
 	for _, required := range requiredStatefulSets {
 		// Check for version upgrades first.
 		existing, existingFound := statefulSets[required.Name]
@@ -1005,6 +1089,11 @@ func (sdcc *Controller) syncStatefulSets(
 
 				if requiredVersion.Major != existingVersion.Major ||
 					requiredVersion.Minor != existingVersion.Minor {
+					if !maintenanceWindowGate.allows(fmt.Sprintf("upgrade from %q to %q", existingVersionString, requiredVersionString)) {
+						klog.V(4).InfoS("Upgrade is queued until the next maintenance window", "ScyllaDBDatacenter", klog.KObj(sdc), "FromVersion", existingVersionString, "ToVersion", requiredVersionString)
+						return progressingConditions, nil
+					}
+
 					// We need to run hooks for version upgrades.
 					sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "UpgradeStarted", "Version changed from %q to %q", existingVersionString, requiredVersionString)
 
@@ -1019,13 +1108,22 @@ func (sdcc *Controller) syncStatefulSets(
 					// Initiate the upgrade. This triggers a state machine to run hooks first.
 					now := time.Now()
 
-					cm, err := MakeUpgradeContextConfigMap(sdc, &internalapi.DatacenterUpgradeContext{
+					upgradeContext := &internalapi.DatacenterUpgradeContext{
 						State:             internalapi.PreHooksUpgradePhase,
 						FromVersion:       existingVersionString,
 						ToVersion:         requiredVersionString,
 						SystemSnapshotTag: snapshotTag("system", now),
-						DataSnapshotTag:   snapshotTag("data", now),
-					})
+					}
+					if isUpgradeDataSnapshotEnabled(sdc) {
+						upgradeContext.DataSnapshotTag = snapshotTag("data", now)
+					}
+
+					upgradeContext.FromImage, err = getScyllaDBImage(existing)
+					if err != nil {
+						return progressingConditions, fmt.Errorf("can't get ScyllaDB image: %w", err)
+					}
+
+					cm, err := MakeUpgradeContextConfigMap(sdc, upgradeContext)
 					if err != nil {
 						return progressingConditions, fmt.Errorf("can't make upgrade context ConfigMap: %w", err)
 					}
@@ -1038,15 +1136,40 @@ func (sdcc *Controller) syncStatefulSets(
 						return progressingConditions, fmt.Errorf("can't apply upgrade context ConfigMap: %w", err)
 					}
 
+					setUpgradeSnapshotStatus(status, upgradeContext)
+
 					return progressingConditions, nil
 				}
 			}
 		}
 
+		// Changes to the pod template restart all nodes of the rack, they can only start within a maintenance window.
+		// StatefulSets applied before the pod template hash was tracked are never held back.
+		if existingFound {
+			existingPodTemplateHash, ok := existing.Annotations[naming.PodTemplateHashAnnotation]
+			if ok && existingPodTemplateHash != required.Annotations[naming.PodTemplateHashAnnotation] &&
+				!maintenanceWindowGate.allows(fmt.Sprintf("rolling restart of StatefulSet %q", naming.ObjRef(required))) {
+				klog.V(4).InfoS("StatefulSet rollout is queued until the next maintenance window", "ScyllaDBDatacenter", klog.KObj(sdc), "StatefulSet", klog.KObj(required))
+				anyRolloutQueued = true
+				continue
+			}
+		}
+
+		switch {
+		case canaryPartitions != nil:
+			// Update only the canary nodes.
+			required.Spec.UpdateStrategy.RollingUpdate.Partition = pointer.Ptr(canaryPartitions[required.Name])
+
+		case canary != nil || sdc.Status.Rollout != nil:
+			// Release the partitions held for the canary nodes.
+			required.Spec.UpdateStrategy.RollingUpdate.Partition = pointer.Ptr(int32(0))
+		}
+
 		updatedSts, changed, err := resourceapply.ApplyStatefulSet(ctx, sdcc.kubeClient.AppsV1(), sdcc.statefulSetLister, sdcc.eventRecorder, required, resourceapply.ApplyOptions{})
 		if err != nil {
 			return progressingConditions, fmt.Errorf("can't apply statefulset update: %w", err)
 		}
+		updatedStatefulSets = append(updatedStatefulSets, updatedSts)
 
 		if changed {
 			anyStsChanged = true
@@ -1072,7 +1195,12 @@ func (sdcc *Controller) syncStatefulSets(
 		}
 
 		// Wait for the StatefulSet to roll out.
-		rolledOut, err := controllerhelpers.IsStatefulSetRolledOut(updatedSts)
+		var rolledOut bool
+		if canaryPartitions != nil {
+			rolledOut, err = isCanaryStatefulSetRolledOut(updatedSts)
+		} else {
+			rolledOut, err = controllerhelpers.IsStatefulSetRolledOut(updatedSts)
+		}
 		if err != nil {
 			return progressingConditions, err
 		}
@@ -1090,6 +1218,25 @@ func (sdcc *Controller) syncStatefulSets(
 		}
 	}
 
+	if anyRolloutQueued {
+		return progressingConditions, nil
+	}
+
+	if canaryPartitions != nil {
+		if areStatefulSetsFullyUpdated(updatedStatefulSets) {
+			// There are no nodes to update past the canary nodes.
+			status.Rollout.CanaryCompleted = true
+			return progressingConditions, nil
+		}
+
+		if sdcc.holdRolloutForCanary(key, sdc, status.Rollout, getPartitionedNodes(updatedStatefulSets)) {
+			return progressingConditions, nil
+		}
+
+		// Requeue to release the partitions.
+		sdcc.queue.Add(key)
+	}
+
 	return progressingConditions, nil
 }
 
@@ -0,0 +1,606 @@
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	"github.com/scylladb/scylla-operator/pkg/scyllafeatures"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+)
+
+// getDataVolumeClaimTemplate returns the data volume claim template of the StatefulSet.
+func getDataVolumeClaimTemplate(sts *appsv1.StatefulSet) (*corev1.PersistentVolumeClaim, error) {
+	pvc, _, ok := oslices.Find(sts.Spec.VolumeClaimTemplates, func(pvc corev1.PersistentVolumeClaim) bool {
+		return pvc.Name == naming.PVCTemplateName
+	})
+	if !ok {
+		return nil, fmt.Errorf("can't find data PVC template %q in StatefulSet %q spec", naming.PVCTemplateName, naming.ObjRef(sts))
+	}
+
+	return &pvc, nil
+}
+
+// getRequestedStorage returns the storage requested by the PVC, or a zero quantity when it's not set.
+func getRequestedStorage(pvc *corev1.PersistentVolumeClaim) resource.Quantity {
+	return pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+}
+
+// getPVCResizeFailure returns a message describing why expanding the PVC failed, or an empty string if it didn't.
+func getPVCResizeFailure(pvc *corev1.PersistentVolumeClaim) string {
+	for _, c := range pvc.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		switch c.Type {
+		case corev1.PersistentVolumeClaimControllerResizeError, corev1.PersistentVolumeClaimNodeResizeError:
+			return fmt.Sprintf("%s: %s", c.Type, c.Message)
+		}
+	}
+
+	resizeStatus, ok := pvc.Status.AllocatedResourceStatuses[corev1.ResourceStorage]
+	if ok {
+		switch resizeStatus {
+		case corev1.PersistentVolumeClaimControllerResizeInfeasible, corev1.PersistentVolumeClaimNodeResizeInfeasible:
+			return string(resizeStatus)
+		}
+	}
+
+	return ""
+}
+
+// calculateRackStorageResizeConditions computes the storage expansion conditions of a rack
+// from its PVCs and the capacity they are expected to have.
+func calculateRackStorageResizeConditions(pvcs []*corev1.PersistentVolumeClaim, requiredCapacity resource.Quantity, generation int64) []metav1.Condition {
+	var resizedPVCs int
+	var failures []string
+	for _, pvc := range pvcs {
+		capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]
+		if ok && capacity.Cmp(requiredCapacity) >= 0 {
+			resizedPVCs++
+			continue
+		}
+
+		failure := getPVCResizeFailure(pvc)
+		if len(failure) != 0 {
+			failures = append(failures, fmt.Sprintf("PersistentVolumeClaim %q: %s", naming.ObjRef(pvc), failure))
+		}
+	}
+
+	progressingCondition := metav1.Condition{
+		Type:               scyllav1alpha1.RackStorageResizeProgressingCondition,
+		Status:             metav1.ConditionFalse,
+		Reason:             internalapi.AsExpectedReason,
+		Message:            "",
+		ObservedGeneration: generation,
+	}
+	if resizedPVCs != len(pvcs) {
+		progressingCondition.Status = metav1.ConditionTrue
+		progressingCondition.Reason = "ExpandingPersistentVolumeClaims"
+		progressingCondition.Message = fmt.Sprintf("%d out of %d PersistentVolumeClaim(s) have been expanded to %s", resizedPVCs, len(pvcs), requiredCapacity.String())
+	}
+
+	degradedCondition := metav1.Condition{
+		Type:               scyllav1alpha1.RackStorageResizeDegradedCondition,
+		Status:             metav1.ConditionFalse,
+		Reason:             internalapi.AsExpectedReason,
+		Message:            "",
+		ObservedGeneration: generation,
+	}
+	if len(failures) > 0 {
+		degradedCondition.Status = metav1.ConditionTrue
+		degradedCondition.Reason = "PersistentVolumeClaimExpansionFailed"
+		degradedCondition.Message = strings.Join(failures, "\n")
+	}
+
+	return []metav1.Condition{progressingCondition, degradedCondition}
+}
+
+// getRackStatusForStatefulSet returns the rack name and a pointer to the status of the rack the StatefulSet belongs to.
+func getRackStatusForStatefulSet(sdc *scyllav1alpha1.ScyllaDBDatacenter, status *scyllav1alpha1.ScyllaDBDatacenterStatus, sts *appsv1.StatefulSet) (string, *scyllav1alpha1.RackStatus, error) {
+	rackName, ok := sts.Labels[naming.RackNameLabel]
+	if !ok {
+		return "", nil, fmt.Errorf("can't determine rack name: statefulset %s is missing label %q", naming.ObjRef(sts), naming.RackNameLabel)
+	}
+
+	_, rackStatusIdx, ok := oslices.Find(status.Racks, func(rackStatus scyllav1alpha1.RackStatus) bool {
+		return rackStatus.Name == rackName
+	})
+	if !ok {
+		return "", nil, fmt.Errorf("can't find rack %q status in %q ScyllaDBDatacenter", rackName, naming.ObjRef(sdc))
+	}
+
+	return rackName, &status.Racks[rackStatusIdx], nil
+}
+
+// getStatefulSetPVCs returns the existing data PVCs for all StatefulSet replicas.
+func (sdcc *Controller) getStatefulSetPVCs(sts *appsv1.StatefulSet) ([]*corev1.PersistentVolumeClaim, error) {
+	var pvcs []*corev1.PersistentVolumeClaim
+	for ord := int32(0); ord < *sts.Spec.Replicas; ord++ {
+		pvcName := naming.PVCNameForStatefulSet(sts.Name, ord)
+		pvc, err := sdcc.pvcLister.PersistentVolumeClaims(sts.Namespace).Get(pvcName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				// The PVC is created together with the Pod.
+				continue
+			}
+			return nil, fmt.Errorf("can't get PVC %q: %w", naming.ManualRef(sts.Namespace, pvcName), err)
+		}
+
+		pvcs = append(pvcs, pvc)
+	}
+
+	return pvcs, nil
+}
+
+// verifyStorageClassAllowsExpansion returns an error when PVCs of the given storage class can't be expanded.
+func (sdcc *Controller) verifyStorageClassAllowsExpansion(storageClassName *string) error {
+	if storageClassName == nil || len(*storageClassName) == 0 {
+		return fmt.Errorf("can't determine the StorageClass of the PersistentVolumeClaims")
+	}
+
+	storageClass, err := sdcc.storageClassLister.Get(*storageClassName)
+	if err != nil {
+		return fmt.Errorf("can't get StorageClass %q: %w", *storageClassName, err)
+	}
+
+	if storageClass.AllowVolumeExpansion == nil || !*storageClass.AllowVolumeExpansion {
+		return fmt.Errorf("StorageClass %q doesn't allow volume expansion", storageClass.Name)
+	}
+
+	return nil
+}
+
+// expandPVCs requests the required capacity for every PVC that asks for less.
+func (sdcc *Controller) expandPVCs(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, pvcs []*corev1.PersistentVolumeClaim, requiredCapacity resource.Quantity) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+	var errs []error
+	for _, pvc := range pvcs {
+		requestedStorage := getRequestedStorage(pvc)
+		if requestedStorage.Cmp(requiredCapacity) >= 0 {
+			continue
+		}
+
+		klog.V(2).InfoS("Expanding PVC", "ScyllaDBDatacenter", klog.KObj(sdc), "PVC", klog.KObj(pvc), "CurrentCapacity", requestedStorage.String(), "RequiredCapacity", requiredCapacity.String())
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, pvc, "patch", sdc.Generation)
+		_, err := sdcc.kubeClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).Patch(
+			ctx,
+			pvc.Name,
+			types.MergePatchType,
+			[]byte(fmt.Sprintf(`{"spec":{"resources":{"requests":{%q:%q}}}}`, corev1.ResourceStorage, requiredCapacity.String())),
+			metav1.PatchOptions{},
+		)
+		resourceapply.ReportUpdateEvent(sdcc.eventRecorder, pvc, err)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't expand PVC %q: %w", naming.ObjRef(pvc), err))
+			continue
+		}
+	}
+
+	return progressingConditions, apimachineryutilerrors.NewAggregate(errs)
+}
+
+// recreateStatefulSetOrphaningPods deletes the StatefulSet without deleting its Pods,
+// so it can be recreated with an updated volume claim template and adopt them back.
+func (sdcc *Controller) recreateStatefulSetOrphaningPods(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, sts *appsv1.StatefulSet, reason, message string) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	sdcc.eventRecorder.Event(sdc, corev1.EventTypeNormal, reason, message)
+	controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, sts, "delete", sdc.Generation)
+	err := sdcc.kubeClient.AppsV1().StatefulSets(sts.Namespace).Delete(ctx, sts.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{
+			UID: &sts.UID,
+		},
+		PropagationPolicy: pointer.Ptr(metav1.DeletePropagationOrphan),
+	})
+	resourceapply.ReportDeleteEvent(sdcc.eventRecorder, sts, err)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't delete StatefulSet %q: %w", naming.ObjRef(sts), err)
+	}
+
+	return progressingConditions, nil
+}
+
+// syncStorageExpansion expands the PVCs of racks whose requested storage capacity grew.
+// Because volume claim templates are immutable, the StatefulSet of such rack is deleted without deleting its Pods,
+// so it can be recreated with the updated template and adopt them back.
+// It returns progressing conditions if any action was taken and the caller should wait for the next sync.
+func (sdcc *Controller) syncStorageExpansion(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
+	requiredStatefulSets []*appsv1.StatefulSet,
+	statefulSets map[string]*appsv1.StatefulSet,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+	var errs []error
+
+	for _, req := range requiredStatefulSets {
+		sts, ok := statefulSets[req.Name]
+		if !ok {
+			continue
+		}
+
+		rackName, rackStatus, err := getRackStatusForStatefulSet(sdc, status, req)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		requiredPVCTemplate, err := getDataVolumeClaimTemplate(req)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		requiredCapacity := getRequestedStorage(requiredPVCTemplate)
+
+		existingPVCTemplate, err := getDataVolumeClaimTemplate(sts)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		existingCapacity := getRequestedStorage(existingPVCTemplate)
+
+		pvcs, err := sdcc.getStatefulSetPVCs(sts)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if requiredCapacity.Cmp(existingCapacity) <= 0 {
+			// The template is up to date. Make sure PVCs eventually catch up, in case we've failed to patch any of them before.
+			expandProgressingConditions, err := sdcc.expandPVCs(ctx, sdc, pvcs, existingCapacity)
+			progressingConditions = append(progressingConditions, expandProgressingConditions...)
+			if err != nil {
+				errs = append(errs, err)
+			}
+
+			for _, c := range calculateRackStorageResizeConditions(pvcs, existingCapacity, sdc.Generation) {
+				apimeta.SetStatusCondition(&rackStatus.Conditions, c)
+			}
+
+			continue
+		}
+
+		klog.V(2).InfoS("Storage capacity of a rack has increased", "ScyllaDBDatacenter", klog.KObj(sdc), "Rack", rackName, "CurrentCapacity", existingCapacity.String(), "RequiredCapacity", requiredCapacity.String())
+
+		storageClassName := requiredPVCTemplate.Spec.StorageClassName
+		if len(pvcs) > 0 && pvcs[0].Spec.StorageClassName != nil {
+			storageClassName = pvcs[0].Spec.StorageClassName
+		}
+		err = sdcc.verifyStorageClassAllowsExpansion(storageClassName)
+		if err != nil {
+			apimeta.SetStatusCondition(&rackStatus.Conditions, metav1.Condition{
+				Type:               scyllav1alpha1.RackStorageResizeDegradedCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "StorageClassDoesNotAllowExpansion",
+				Message:            fmt.Sprintf("Can't expand storage from %s to %s: %v", existingCapacity.String(), requiredCapacity.String(), err),
+				ObservedGeneration: sdc.Generation,
+			})
+			errs = append(errs, fmt.Errorf("can't expand storage of rack %q: %w", rackName, err))
+			continue
+		}
+
+		apimeta.SetStatusCondition(&rackStatus.Conditions, metav1.Condition{
+			Type:               scyllav1alpha1.RackStorageResizeProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "ExpandingPersistentVolumeClaims",
+			Message:            fmt.Sprintf("Expanding storage from %s to %s", existingCapacity.String(), requiredCapacity.String()),
+			ObservedGeneration: sdc.Generation,
+		})
+		apimeta.SetStatusCondition(&rackStatus.Conditions, metav1.Condition{
+			Type:               scyllav1alpha1.RackStorageResizeDegradedCondition,
+			Status:             metav1.ConditionFalse,
+			Reason:             internalapi.AsExpectedReason,
+			Message:            "",
+			ObservedGeneration: sdc.Generation,
+		})
+
+		expandProgressingConditions, err := sdcc.expandPVCs(ctx, sdc, pvcs, requiredCapacity)
+		progressingConditions = append(progressingConditions, expandProgressingConditions...)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		// Volume claim templates are immutable, so we have to recreate the StatefulSet.
+		recreateProgressingConditions, err := sdcc.recreateStatefulSetOrphaningPods(
+			ctx,
+			sdc,
+			sts,
+			"StorageExpansionStarted",
+			fmt.Sprintf("Recreating StatefulSet %q to expand storage of rack %q from %s to %s", naming.ObjRef(sts), rackName, existingCapacity.String(), requiredCapacity.String()),
+		)
+		progressingConditions = append(progressingConditions, recreateProgressingConditions...)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+	}
+
+	return progressingConditions, apimachineryutilerrors.NewAggregate(errs)
+}
+
+func isStorageMigrationPaused(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+	return sdc.Spec.StorageMigration != nil && sdc.Spec.StorageMigration.Paused != nil && *sdc.Spec.StorageMigration.Paused
+}
+
+// setRackStorageMigrationProgressingCondition sets the storage migration progressing condition of a rack
+// and clears the degraded one.
+func setRackStorageMigrationProgressingCondition(rackStatus *scyllav1alpha1.RackStatus, conditionStatus metav1.ConditionStatus, reason, message string, generation int64) {
+	apimeta.SetStatusCondition(&rackStatus.Conditions, metav1.Condition{
+		Type:               scyllav1alpha1.RackStorageMigrationProgressingCondition,
+		Status:             conditionStatus,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+	apimeta.SetStatusCondition(&rackStatus.Conditions, metav1.Condition{
+		Type:               scyllav1alpha1.RackStorageMigrationDegradedCondition,
+		Status:             metav1.ConditionFalse,
+		Reason:             internalapi.AsExpectedReason,
+		Message:            "",
+		ObservedGeneration: generation,
+	})
+}
+
+// syncStorageMigration migrates racks whose storage class changed to the new storage class.
+// Nodes are replaced one at a time using the Host ID based replace procedure, so they get fresh PVCs
+// created from the updated volume claim template and stream their data from the other replicas.
+// It returns progressing conditions if the caller should wait for the next sync.
+func (sdcc *Controller) syncStorageMigration(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
+	requiredStatefulSets []*appsv1.StatefulSet,
+	statefulSets map[string]*appsv1.StatefulSet,
+	services map[string]*corev1.Service,
+	maintenanceWindowGate *maintenanceWindowGate,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+	var errs []error
+
+	// Nodes are replaced one at a time across all racks, so the replace procedure driven by the member Services
+	// has to finish in every rack before the next node is replaced.
+	var replacedSvc *corev1.Service
+	for _, svcName := range slices.Sorted(maps.Keys(services)) {
+		_, ok := services[svcName].Labels[naming.ReplaceLabel]
+		if ok {
+			replacedSvc = services[svcName]
+			break
+		}
+	}
+
+	racksReady := true
+	for _, req := range requiredStatefulSets {
+		sts, ok := statefulSets[req.Name]
+		if !ok || sts.Status.ObservedGeneration < sts.Generation || sts.Status.ReadyReplicas < *sts.Spec.Replicas {
+			racksReady = false
+			break
+		}
+	}
+
+	for _, req := range requiredStatefulSets {
+		sts, ok := statefulSets[req.Name]
+		if !ok {
+			continue
+		}
+
+		rackName, rackStatus, err := getRackStatusForStatefulSet(sdc, status, req)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		requiredPVCTemplate, err := getDataVolumeClaimTemplate(req)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		existingPVCTemplate, err := getDataVolumeClaimTemplate(sts)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		requiredStorageClassName := requiredPVCTemplate.Spec.StorageClassName
+		if requiredStorageClassName == nil || len(*requiredStorageClassName) == 0 {
+			// Without an explicit storage class there is nothing to migrate to.
+			rackStatus.StorageMigration = nil
+			continue
+		}
+
+		existingStorageClassName := existingPVCTemplate.Spec.StorageClassName
+		if existingStorageClassName == nil || *existingStorageClassName != *requiredStorageClassName {
+			klog.V(2).InfoS("Storage class of a rack has changed", "ScyllaDBDatacenter", klog.KObj(sdc), "Rack", rackName, "StorageClass", *requiredStorageClassName)
+
+			setRackStorageMigrationProgressingCondition(
+				rackStatus,
+				metav1.ConditionTrue,
+				"UpdatingVolumeClaimTemplate",
+				fmt.Sprintf("Recreating StatefulSet %q with storage class %q", naming.ObjRef(sts), *requiredStorageClassName),
+				sdc.Generation,
+			)
+
+			// Volume claim templates are immutable, so we have to recreate the StatefulSet.
+			// Existing nodes keep their PVCs until they are replaced.
+			recreateProgressingConditions, err := sdcc.recreateStatefulSetOrphaningPods(
+				ctx,
+				sdc,
+				sts,
+				"StorageMigrationStarted",
+				fmt.Sprintf("Recreating StatefulSet %q to migrate rack %q to storage class %q", naming.ObjRef(sts), rackName, *requiredStorageClassName),
+			)
+			progressingConditions = append(progressingConditions, recreateProgressingConditions...)
+			if err != nil {
+				errs = append(errs, err)
+			}
+			continue
+		}
+
+		rackServices := map[string]*corev1.Service{}
+		for _, svc := range services {
+			if svc.Labels[naming.RackNameLabel] == rackName {
+				rackServices[svc.Name] = svc
+			}
+		}
+
+		var migratedNodes int32
+		var nodeToReplace *corev1.Service
+		for ord := *sts.Spec.Replicas - 1; ord >= 0; ord-- {
+			svcName := fmt.Sprintf("%s-%d", sts.Name, ord)
+			pvcName := naming.PVCNameForService(svcName)
+			pvc, err := sdcc.pvcLister.PersistentVolumeClaims(sts.Namespace).Get(pvcName)
+			if err != nil && !apierrors.IsNotFound(err) {
+				errs = append(errs, fmt.Errorf("can't get PVC %q: %w", naming.ManualRef(sts.Namespace, pvcName), err))
+				continue
+			}
+
+			// A node without a PVC isn't known to run on the new storage class, so it's replaced like any other
+			// which isn't migrated. The replacement gets a fresh PVC created from the updated template.
+			if pvc != nil && pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName == *requiredStorageClassName {
+				migratedNodes++
+				continue
+			}
+
+			svc, ok := rackServices[svcName]
+			if ok && nodeToReplace == nil {
+				nodeToReplace = svc
+			}
+		}
+
+		if migratedNodes == *sts.Spec.Replicas {
+			rackStatus.StorageMigration = nil
+			setRackStorageMigrationProgressingCondition(rackStatus, metav1.ConditionFalse, internalapi.AsExpectedReason, "", sdc.Generation)
+			continue
+		}
+
+		paused := isStorageMigrationPaused(sdc)
+		rackStatus.StorageMigration = &scyllav1alpha1.RackStorageMigrationStatus{
+			StorageClassName: *requiredStorageClassName,
+			Nodes:            *sts.Spec.Replicas,
+			MigratedNodes:    migratedNodes,
+			Paused:           paused,
+		}
+		progressMessage := fmt.Sprintf("%d out of %d node(s) have been migrated to storage class %q", migratedNodes, *sts.Spec.Replicas, *requiredStorageClassName)
+
+		if replacedSvc != nil {
+			if replacedSvc.Labels[naming.RackNameLabel] == rackName {
+				setRackStorageMigrationProgressingCondition(
+					rackStatus,
+					metav1.ConditionTrue,
+					"ReplacingNode",
+					fmt.Sprintf("Replacing node %q. %s", naming.ObjRef(replacedSvc), progressMessage),
+					sdc.Generation,
+				)
+			} else {
+				setRackStorageMigrationProgressingCondition(
+					rackStatus,
+					metav1.ConditionTrue,
+					"WaitingForNodeReplacement",
+					fmt.Sprintf("Waiting for node %q of another rack to be replaced. %s", naming.ObjRef(replacedSvc), progressMessage),
+					sdc.Generation,
+				)
+			}
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               statefulSetControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForStorageMigrationNodeReplacement",
+				Message:            fmt.Sprintf("Waiting for node %q to be replaced onto storage class %q.", naming.ObjRef(replacedSvc), *requiredStorageClassName),
+				ObservedGeneration: sdc.Generation,
+			})
+			continue
+		}
+
+		if paused {
+			setRackStorageMigrationProgressingCondition(rackStatus, metav1.ConditionFalse, "Paused", fmt.Sprintf("Storage migration is paused. %s", progressMessage), sdc.Generation)
+			continue
+		}
+
+		if !racksReady {
+			setRackStorageMigrationProgressingCondition(rackStatus, metav1.ConditionTrue, "WaitingForRacksToBecomeReady", fmt.Sprintf("Waiting for all nodes of all racks to become ready. %s", progressMessage), sdc.Generation)
+			continue
+		}
+
+		if nodeToReplace == nil {
+			setRackStorageMigrationProgressingCondition(rackStatus, metav1.ConditionTrue, "WaitingForMemberService", fmt.Sprintf("Waiting for the member Service of a node which isn't migrated. %s", progressMessage), sdc.Generation)
+			continue
+		}
+
+		supportsReplaceUsingHostID, err := scyllafeatures.Supports(sdc, scyllafeatures.ReplacingNodeUsingHostID)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't determine if ScyllaDBDatacenter %q supports replacing using hostID: %w", naming.ObjRef(sdc), err))
+			continue
+		}
+		if !supportsReplaceUsingHostID {
+			apimeta.SetStatusCondition(&rackStatus.Conditions, metav1.Condition{
+				Type:               scyllav1alpha1.RackStorageMigrationDegradedCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "ReplacingNodeUsingHostIDNotSupported",
+				Message:            "Migrating storage requires replacing nodes using Host ID, which isn't supported by the ScyllaDB version in use.",
+				ObservedGeneration: sdc.Generation,
+			})
+			errs = append(errs, fmt.Errorf("can't migrate storage of rack %q, ScyllaDB version of %q ScyllaDBDatacenter doesn't support HostID based replace procedure", rackName, naming.ObjRef(sdc)))
+			continue
+		}
+
+		if !maintenanceWindowGate.allows(fmt.Sprintf("storage migration of node %q", naming.ObjRef(nodeToReplace))) {
+			setRackStorageMigrationProgressingCondition(rackStatus, metav1.ConditionFalse, "WaitingForMaintenanceWindow", fmt.Sprintf("Waiting for a maintenance window to replace the next node. %s", progressMessage), sdc.Generation)
+			continue
+		}
+
+		klog.V(2).InfoS("Replacing node to migrate its storage", "ScyllaDBDatacenter", klog.KObj(sdc), "Rack", rackName, "Service", klog.KObj(nodeToReplace), "StorageClass", *requiredStorageClassName)
+		sdcc.eventRecorder.Eventf(
+			sdc,
+			corev1.EventTypeNormal,
+			"StorageMigrationReplacingNode",
+			"Replacing node %q to migrate it to storage class %q",
+			naming.ObjRef(nodeToReplace), *requiredStorageClassName,
+		)
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, nodeToReplace, "patch", sdc.Generation)
+		_, err = sdcc.kubeClient.CoreV1().Services(nodeToReplace.Namespace).Patch(
+			ctx,
+			nodeToReplace.Name,
+			types.MergePatchType,
+			[]byte(fmt.Sprintf(`{"metadata":{"labels":{%q:""}}}`, naming.ReplaceLabel)),
+			metav1.PatchOptions{},
+		)
+		resourceapply.ReportUpdateEvent(sdcc.eventRecorder, nodeToReplace, err)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't mark Service %q for replacement: %w", naming.ObjRef(nodeToReplace), err))
+			continue
+		}
+
+		setRackStorageMigrationProgressingCondition(
+			rackStatus,
+			metav1.ConditionTrue,
+			"ReplacingNode",
+			fmt.Sprintf("Replacing node %q. %s", naming.ObjRef(nodeToReplace), progressMessage),
+			sdc.Generation,
+		)
+
+		// Only a single node is replaced at a time, the remaining racks are migrated once it's done.
+		return progressingConditions, apimachineryutilerrors.NewAggregate(errs)
+	}
+
+	return progressingConditions, apimachineryutilerrors.NewAggregate(errs)
+}
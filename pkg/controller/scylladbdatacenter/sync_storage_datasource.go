@@ -0,0 +1,238 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+)
+
+const (
+	volumeSnapshotAPIGroup = "snapshot.storage.k8s.io"
+	volumeSnapshotKind     = "VolumeSnapshot"
+)
+
+// getRackStorageDataSource returns the storage data source of the rack, taking the rack template into account.
+func getRackStorageDataSource(sdc *scyllav1alpha1.ScyllaDBDatacenter, rack scyllav1alpha1.RackSpec) *scyllav1alpha1.StorageDataSource {
+	if rack.ScyllaDB != nil && rack.ScyllaDB.Storage != nil && rack.ScyllaDB.Storage.DataSource != nil {
+		return rack.ScyllaDB.Storage.DataSource
+	}
+
+	if sdc.Spec.RackTemplate != nil && sdc.Spec.RackTemplate.ScyllaDB != nil && sdc.Spec.RackTemplate.ScyllaDB.Storage != nil {
+		return sdc.Spec.RackTemplate.ScyllaDB.Storage.DataSource
+	}
+
+	return nil
+}
+
+// getSourceVolumeSnapshots returns the names of VolumeSnapshots of the source rack, ordered by the ordinal of the node they were taken from.
+func getSourceVolumeSnapshots(svs *scyllav1alpha1.ScyllaDBVolumeSnapshot, sourceRackName string) ([]string, error) {
+	type ordinalVolumeSnapshot struct {
+		ordinal int32
+		name    string
+	}
+
+	var volumeSnapshots []ordinalVolumeSnapshot
+	for _, vs := range svs.Status.VolumeSnapshots {
+		if vs.RackName != sourceRackName {
+			continue
+		}
+
+		ordinal, err := naming.IndexFromName(vs.PodName)
+		if err != nil {
+			return nil, fmt.Errorf("can't get ordinal of Pod %q: %w", vs.PodName, err)
+		}
+
+		volumeSnapshots = append(volumeSnapshots, ordinalVolumeSnapshot{
+			ordinal: ordinal,
+			name:    vs.VolumeSnapshotName,
+		})
+	}
+
+	slices.SortFunc(volumeSnapshots, func(a, b ordinalVolumeSnapshot) int {
+		return int(a.ordinal - b.ordinal)
+	})
+
+	return oslices.ConvertSlice(volumeSnapshots, func(vs ordinalVolumeSnapshot) string {
+		return vs.name
+	}), nil
+}
+
+// makeVolumeSnapshotSeededPVC returns a data PVC for the StatefulSet replica pre-populated from the VolumeSnapshot.
+// It has to match what the StatefulSet controller would create from the volume claim template, so it gets adopted.
+func makeVolumeSnapshotSeededPVC(sts *appsv1.StatefulSet, ordinal int32, volumeSnapshotName string) (*corev1.PersistentVolumeClaim, error) {
+	pvcTemplate, err := getDataVolumeClaimTemplate(sts)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := map[string]string{}
+	maps.Copy(labels, pvcTemplate.Labels)
+	maps.Copy(labels, sts.Spec.Selector.MatchLabels)
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        naming.PVCNameForStatefulSet(sts.Name, ordinal),
+			Namespace:   sts.Namespace,
+			Labels:      labels,
+			Annotations: maps.Clone(pvcTemplate.Annotations),
+		},
+		Spec: *pvcTemplate.Spec.DeepCopy(),
+	}
+	pvc.Spec.DataSource = &corev1.TypedLocalObjectReference{
+		APIGroup: pointer.Ptr(volumeSnapshotAPIGroup),
+		Kind:     volumeSnapshotKind,
+		Name:     volumeSnapshotName,
+	}
+
+	return pvc, nil
+}
+
+// isPVCSeededFromVolumeSnapshot returns whether the PVC was pre-populated from a VolumeSnapshot.
+func isPVCSeededFromVolumeSnapshot(pvc *corev1.PersistentVolumeClaim) bool {
+	dataSource := pvc.Spec.DataSource
+	return dataSource != nil &&
+		dataSource.APIGroup != nil &&
+		*dataSource.APIGroup == volumeSnapshotAPIGroup &&
+		dataSource.Kind == volumeSnapshotKind
+}
+
+// getVolumeSnapshotSeededNodes returns the names of the StatefulSet's nodes whose volumes were pre-populated from VolumeSnapshots.
+func (sdcc *Controller) getVolumeSnapshotSeededNodes(sts *appsv1.StatefulSet) ([]string, error) {
+	pvcs, err := sdcc.getStatefulSetPVCs(sts)
+	if err != nil {
+		return nil, err
+	}
+
+	var seededNodes []string
+	for _, pvc := range pvcs {
+		if !isPVCSeededFromVolumeSnapshot(pvc) {
+			continue
+		}
+
+		ordinal, err := naming.IndexFromName(pvc.Name)
+		if err != nil {
+			return nil, fmt.Errorf("can't get ordinal of PVC %q: %w", naming.ObjRef(pvc), err)
+		}
+
+		seededNodes = append(seededNodes, fmt.Sprintf("%s-%d", sts.Name, ordinal))
+	}
+
+	return seededNodes, nil
+}
+
+// seedRackVolumes pre-creates data PVCs of a rack that is about to be created, when it has a storage data source.
+// It returns progressing conditions when the rack has to wait for the volume snapshots.
+func (sdcc *Controller) seedRackVolumes(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	sts *appsv1.StatefulSet,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	rackName, ok := sts.Labels[naming.RackNameLabel]
+	if !ok {
+		return progressingConditions, fmt.Errorf("can't determine rack name: statefulset %s is missing label %q", naming.ObjRef(sts), naming.RackNameLabel)
+	}
+
+	rack, _, ok := oslices.Find(sdc.Spec.Racks, func(rack scyllav1alpha1.RackSpec) bool {
+		return rack.Name == rackName
+	})
+	if !ok {
+		return progressingConditions, fmt.Errorf("can't find rack %q in ScyllaDBDatacenter %q spec", rackName, naming.ObjRef(sdc))
+	}
+
+	dataSource := getRackStorageDataSource(sdc, rack)
+	if dataSource == nil {
+		return progressingConditions, nil
+	}
+
+	svsName := dataSource.ScyllaDBVolumeSnapshotRef.Name
+	svs, err := sdcc.scyllaDBVolumeSnapshotLister.ScyllaDBVolumeSnapshots(sdc.Namespace).Get(svsName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return progressingConditions, fmt.Errorf("can't get ScyllaDBVolumeSnapshot %q: %w", naming.ManualRef(sdc.Namespace, svsName), err)
+		}
+
+		svs = nil
+	}
+
+	if svs == nil || svs.Status.CompletionTime == nil {
+		klog.V(4).InfoS("Waiting for ScyllaDBVolumeSnapshot to complete", "ScyllaDBDatacenter", klog.KObj(sdc), "ScyllaDBVolumeSnapshot", klog.KRef(sdc.Namespace, svsName))
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               statefulSetControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForScyllaDBVolumeSnapshot",
+			Message:            fmt.Sprintf("Waiting for ScyllaDBVolumeSnapshot %q to complete before creating rack %q.", naming.ManualRef(sdc.Namespace, svsName), rackName),
+			ObservedGeneration: sdc.Generation,
+		})
+		return progressingConditions, nil
+	}
+
+	sourceRackName := rackName
+	if dataSource.RackName != nil {
+		sourceRackName = *dataSource.RackName
+	}
+
+	volumeSnapshotNames, err := getSourceVolumeSnapshots(svs, sourceRackName)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't get volume snapshots of rack %q from ScyllaDBVolumeSnapshot %q: %w", sourceRackName, naming.ObjRef(svs), err)
+	}
+
+	// Nodes without a corresponding volume snapshot start with empty volumes.
+	seededNodeCount := min(*sts.Spec.Replicas, int32(len(volumeSnapshotNames)))
+
+	var errs []error
+	for ordinal := int32(0); ordinal < seededNodeCount; ordinal++ {
+		pvcName := naming.PVCNameForStatefulSet(sts.Name, ordinal)
+		_, err := sdcc.pvcLister.PersistentVolumeClaims(sts.Namespace).Get(pvcName)
+		if err == nil {
+			// Never touch existing volumes.
+			continue
+		}
+		if !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("can't get PVC %q: %w", naming.ManualRef(sts.Namespace, pvcName), err))
+			continue
+		}
+
+		pvc, err := makeVolumeSnapshotSeededPVC(sts, ordinal, volumeSnapshotNames[ordinal])
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't make PVC %q: %w", naming.ManualRef(sts.Namespace, pvcName), err))
+			continue
+		}
+
+		klog.V(2).InfoS("Creating PVC from VolumeSnapshot", "ScyllaDBDatacenter", klog.KObj(sdc), "PVC", klog.KObj(pvc), "VolumeSnapshot", volumeSnapshotNames[ordinal])
+		_, err = sdcc.kubeClient.CoreV1().PersistentVolumeClaims(pvc.Namespace).Create(ctx, pvc, metav1.CreateOptions{})
+		if err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				continue
+			}
+			errs = append(errs, fmt.Errorf("can't create PVC %q: %w", naming.ObjRef(pvc), err))
+			continue
+		}
+
+		sdcc.eventRecorder.Eventf(
+			sdc,
+			corev1.EventTypeNormal,
+			"PersistentVolumeClaimSeeded",
+			"Created PersistentVolumeClaim %q from VolumeSnapshot %q",
+			naming.ObjRef(pvc),
+			volumeSnapshotNames[ordinal],
+		)
+	}
+
+	return progressingConditions, apimachineryutilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,193 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbdatacenter
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_getSourceVolumeSnapshots(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name           string
+		svs            *scyllav1alpha1.ScyllaDBVolumeSnapshot
+		sourceRackName string
+		expected       []string
+		expectedErr    bool
+	}{
+		{
+			name: "no volume snapshots of the source rack",
+			svs: &scyllav1alpha1.ScyllaDBVolumeSnapshot{
+				Status: scyllav1alpha1.ScyllaDBVolumeSnapshotStatus{
+					VolumeSnapshots: []scyllav1alpha1.ScyllaDBVolumeSnapshotVolumeStatus{
+						{
+							RackName:           "b",
+							PodName:            "basic-dc1-b-0",
+							VolumeSnapshotName: "snapshot-basic-dc1-b-0",
+						},
+					},
+				},
+			},
+			sourceRackName: "a",
+			expected:       []string{},
+		},
+		{
+			name: "volume snapshots of the source rack are ordered by node ordinal",
+			svs: &scyllav1alpha1.ScyllaDBVolumeSnapshot{
+				Status: scyllav1alpha1.ScyllaDBVolumeSnapshotStatus{
+					VolumeSnapshots: []scyllav1alpha1.ScyllaDBVolumeSnapshotVolumeStatus{
+						{
+							RackName:           "a",
+							PodName:            "basic-dc1-a-10",
+							VolumeSnapshotName: "snapshot-basic-dc1-a-10",
+						},
+						{
+							RackName:           "b",
+							PodName:            "basic-dc1-b-0",
+							VolumeSnapshotName: "snapshot-basic-dc1-b-0",
+						},
+						{
+							RackName:           "a",
+							PodName:            "basic-dc1-a-2",
+							VolumeSnapshotName: "snapshot-basic-dc1-a-2",
+						},
+						{
+							RackName:           "a",
+							PodName:            "basic-dc1-a-0",
+							VolumeSnapshotName: "snapshot-basic-dc1-a-0",
+						},
+					},
+				},
+			},
+			sourceRackName: "a",
+			expected: []string{
+				"snapshot-basic-dc1-a-0",
+				"snapshot-basic-dc1-a-2",
+				"snapshot-basic-dc1-a-10",
+			},
+		},
+		{
+			name: "malformed pod name",
+			svs: &scyllav1alpha1.ScyllaDBVolumeSnapshot{
+				Status: scyllav1alpha1.ScyllaDBVolumeSnapshotStatus{
+					VolumeSnapshots: []scyllav1alpha1.ScyllaDBVolumeSnapshotVolumeStatus{
+						{
+							RackName:           "a",
+							PodName:            "malformed",
+							VolumeSnapshotName: "snapshot-malformed",
+						},
+					},
+				},
+			},
+			sourceRackName: "a",
+			expectedErr:    true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := getSourceVolumeSnapshots(tc.svs, tc.sourceRackName)
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("expected error: %t, got %v", tc.expectedErr, err)
+			}
+
+			if !cmp.Equal(got, tc.expected) {
+				t.Errorf("expected and got volume snapshots differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func Test_makeVolumeSnapshotSeededPVC(t *testing.T) {
+	t.Parallel()
+
+	sts := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic-dc1-a",
+			Namespace: "scylla",
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"scylla/cluster": "basic",
+					"scylla/rack":    "a",
+				},
+			},
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "data",
+						Labels: map[string]string{
+							"user-label": "value",
+						},
+						Annotations: map[string]string{
+							"user-annotation": "value",
+						},
+					},
+					Spec: corev1.PersistentVolumeClaimSpec{
+						AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+						StorageClassName: pointer.Ptr("csi"),
+						Resources: corev1.VolumeResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceStorage: resource.MustParse("10Gi"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expected := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "data-basic-dc1-a-1",
+			Namespace: "scylla",
+			Labels: map[string]string{
+				"user-label":     "value",
+				"scylla/cluster": "basic",
+				"scylla/rack":    "a",
+			},
+			Annotations: map[string]string{
+				"user-annotation": "value",
+			},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			StorageClassName: pointer.Ptr("csi"),
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{
+					corev1.ResourceStorage: resource.MustParse("10Gi"),
+				},
+			},
+			DataSource: &corev1.TypedLocalObjectReference{
+				APIGroup: pointer.Ptr("snapshot.storage.k8s.io"),
+				Kind:     "VolumeSnapshot",
+				Name:     "snapshot-basic-dc1-a-1",
+			},
+		},
+	}
+
+	got, err := makeVolumeSnapshotSeededPVC(sts, 1, "snapshot-basic-dc1-a-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !isPVCSeededFromVolumeSnapshot(got) {
+		t.Errorf("expected PVC to be seeded from a volume snapshot")
+	}
+
+	if !cmp.Equal(got, expected) {
+		t.Errorf("expected and got PVCs differ:\n%s", cmp.Diff(expected, got))
+	}
+}
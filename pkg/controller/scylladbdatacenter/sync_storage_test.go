@@ -0,0 +1,832 @@
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	storagev1listers "k8s.io/client-go/listers/storage/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+func Test_calculateRackStorageResizeConditions(t *testing.T) {
+	t.Parallel()
+
+	newPVC := func(name string, capacity string, conditions ...corev1.PersistentVolumeClaimCondition) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+			},
+			Status: corev1.PersistentVolumeClaimStatus{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceStorage: resource.MustParse(capacity),
+				},
+				Conditions: conditions,
+			},
+		}
+	}
+
+	tt := []struct {
+		name               string
+		pvcs               []*corev1.PersistentVolumeClaim
+		requiredCapacity   resource.Quantity
+		expectedConditions []metav1.Condition
+	}{
+		{
+			name:             "no PVCs",
+			pvcs:             nil,
+			requiredCapacity: resource.MustParse("2Gi"),
+			expectedConditions: []metav1.Condition{
+				{
+					Type:               scyllav1alpha1.RackStorageResizeProgressingCondition,
+					Status:             metav1.ConditionFalse,
+					Reason:             "AsExpected",
+					ObservedGeneration: 42,
+				},
+				{
+					Type:               scyllav1alpha1.RackStorageResizeDegradedCondition,
+					Status:             metav1.ConditionFalse,
+					Reason:             "AsExpected",
+					ObservedGeneration: 42,
+				},
+			},
+		},
+		{
+			name: "all PVCs expanded",
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("data-0", "2Gi"),
+				newPVC("data-1", "3Gi"),
+			},
+			requiredCapacity: resource.MustParse("2Gi"),
+			expectedConditions: []metav1.Condition{
+				{
+					Type:               scyllav1alpha1.RackStorageResizeProgressingCondition,
+					Status:             metav1.ConditionFalse,
+					Reason:             "AsExpected",
+					ObservedGeneration: 42,
+				},
+				{
+					Type:               scyllav1alpha1.RackStorageResizeDegradedCondition,
+					Status:             metav1.ConditionFalse,
+					Reason:             "AsExpected",
+					ObservedGeneration: 42,
+				},
+			},
+		},
+		{
+			name: "some PVCs are being expanded",
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("data-0", "2Gi"),
+				newPVC("data-1", "1Gi", corev1.PersistentVolumeClaimCondition{
+					Type:   corev1.PersistentVolumeClaimFileSystemResizePending,
+					Status: corev1.ConditionTrue,
+				}),
+			},
+			requiredCapacity: resource.MustParse("2Gi"),
+			expectedConditions: []metav1.Condition{
+				{
+					Type:               scyllav1alpha1.RackStorageResizeProgressingCondition,
+					Status:             metav1.ConditionTrue,
+					Reason:             "ExpandingPersistentVolumeClaims",
+					Message:            "1 out of 2 PersistentVolumeClaim(s) have been expanded to 2Gi",
+					ObservedGeneration: 42,
+				},
+				{
+					Type:               scyllav1alpha1.RackStorageResizeDegradedCondition,
+					Status:             metav1.ConditionFalse,
+					Reason:             "AsExpected",
+					ObservedGeneration: 42,
+				},
+			},
+		},
+		{
+			name: "PVC expansion failed",
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("data-0", "1Gi", corev1.PersistentVolumeClaimCondition{
+					Type:    corev1.PersistentVolumeClaimControllerResizeError,
+					Status:  corev1.ConditionTrue,
+					Message: "volume is too big",
+				}),
+				newPVC("data-1", "1Gi"),
+			},
+			requiredCapacity: resource.MustParse("2Gi"),
+			expectedConditions: []metav1.Condition{
+				{
+					Type:               scyllav1alpha1.RackStorageResizeProgressingCondition,
+					Status:             metav1.ConditionTrue,
+					Reason:             "ExpandingPersistentVolumeClaims",
+					Message:            "0 out of 2 PersistentVolumeClaim(s) have been expanded to 2Gi",
+					ObservedGeneration: 42,
+				},
+				{
+					Type:               scyllav1alpha1.RackStorageResizeDegradedCondition,
+					Status:             metav1.ConditionTrue,
+					Reason:             "PersistentVolumeClaimExpansionFailed",
+					Message:            `PersistentVolumeClaim "default/data-0": ControllerResizeError: volume is too big`,
+					ObservedGeneration: 42,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := calculateRackStorageResizeConditions(tc.pvcs, tc.requiredCapacity, 42)
+			if !cmp.Equal(got, tc.expectedConditions) {
+				t.Errorf("expected and got conditions differ:\n%s", cmp.Diff(tc.expectedConditions, got))
+			}
+		})
+	}
+}
+
+func Test_verifyStorageClassAllowsExpansion(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name             string
+		storageClasses   []*storagev1.StorageClass
+		storageClassName *string
+		expectedErr      error
+	}{
+		{
+			name:             "unknown storage class name",
+			storageClassName: nil,
+			expectedErr:      fmt.Errorf("can't determine the StorageClass of the PersistentVolumeClaims"),
+		},
+		{
+			name:             "missing storage class",
+			storageClassName: pointer.Ptr("expandable"),
+			expectedErr:      fmt.Errorf(`can't get StorageClass "expandable": %w`, fmt.Errorf(`storageclass.storage.k8s.io "expandable" not found`)),
+		},
+		{
+			name: "storage class doesn't allow expansion",
+			storageClasses: []*storagev1.StorageClass{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fixed",
+					},
+				},
+			},
+			storageClassName: pointer.Ptr("fixed"),
+			expectedErr:      fmt.Errorf(`StorageClass "fixed" doesn't allow volume expansion`),
+		},
+		{
+			name: "storage class allows expansion",
+			storageClasses: []*storagev1.StorageClass{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "expandable",
+					},
+					AllowVolumeExpansion: pointer.Ptr(true),
+				},
+			},
+			storageClassName: pointer.Ptr("expandable"),
+			expectedErr:      nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			storageClassCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+			for _, sc := range tc.storageClasses {
+				err := storageClassCache.Add(sc)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			sdcc := &Controller{
+				storageClassLister: storagev1listers.NewStorageClassLister(storageClassCache),
+			}
+
+			err := sdcc.verifyStorageClassAllowsExpansion(tc.storageClassName)
+			if fmt.Sprint(err) != fmt.Sprint(tc.expectedErr) {
+				t.Errorf("expected error %v, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
+
+func Test_syncStorageMigration(t *testing.T) {
+	t.Parallel()
+
+	newScyllaDBDatacenter := func(image string, paused bool) *scyllav1alpha1.ScyllaDBDatacenter {
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "basic",
+				Namespace:  "scylla",
+				Generation: 42,
+			},
+			Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+				ScyllaDB: scyllav1alpha1.ScyllaDB{
+					Image: image,
+				},
+				StorageMigration: &scyllav1alpha1.StorageMigrationOptions{
+					Paused: pointer.Ptr(paused),
+				},
+			},
+		}
+	}
+
+	newStatefulSet := func(rackName string, storageClassName string, readyReplicas int32) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       fmt.Sprintf("basic-dc-%s", rackName),
+				Namespace:  "scylla",
+				UID:        "sts-uid",
+				Generation: 1,
+				Labels: map[string]string{
+					naming.RackNameLabel: rackName,
+				},
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: pointer.Ptr(int32(2)),
+				VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+					{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: naming.PVCTemplateName,
+						},
+						Spec: corev1.PersistentVolumeClaimSpec{
+							StorageClassName: pointer.Ptr(storageClassName),
+						},
+					},
+				},
+			},
+			Status: appsv1.StatefulSetStatus{
+				ObservedGeneration: 1,
+				ReadyReplicas:      readyReplicas,
+			},
+		}
+	}
+
+	newPVC := func(rackName string, ord int32, storageClassName string) *corev1.PersistentVolumeClaim {
+		return &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      naming.PVCNameForStatefulSet(fmt.Sprintf("basic-dc-%s", rackName), ord),
+				Namespace: "scylla",
+			},
+			Spec: corev1.PersistentVolumeClaimSpec{
+				StorageClassName: pointer.Ptr(storageClassName),
+			},
+		}
+	}
+
+	newService := func(rackName string, ord int32, replacing bool) *corev1.Service {
+		svc := &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("basic-dc-%s-%d", rackName, ord),
+				Namespace: "scylla",
+				Labels: map[string]string{
+					naming.RackNameLabel: rackName,
+				},
+			},
+		}
+		if replacing {
+			svc.Labels[naming.ReplaceLabel] = ""
+		}
+		return svc
+	}
+
+	newConditions := func(progressingStatus metav1.ConditionStatus, progressingReason, progressingMessage string) []metav1.Condition {
+		return []metav1.Condition{
+			{
+				Type:               scyllav1alpha1.RackStorageMigrationProgressingCondition,
+				Status:             progressingStatus,
+				Reason:             progressingReason,
+				Message:            progressingMessage,
+				ObservedGeneration: 42,
+			},
+			{
+				Type:               scyllav1alpha1.RackStorageMigrationDegradedCondition,
+				Status:             metav1.ConditionFalse,
+				Reason:             "AsExpected",
+				ObservedGeneration: 42,
+			},
+		}
+	}
+
+	newMigrationStatus := func(migratedNodes int32, paused bool) *scyllav1alpha1.RackStorageMigrationStatus {
+		return &scyllav1alpha1.RackStorageMigrationStatus{
+			StorageClassName: "new",
+			Nodes:            2,
+			MigratedNodes:    migratedNodes,
+			Paused:           paused,
+		}
+	}
+
+	tt := []struct {
+		name                       string
+		sdc                        *scyllav1alpha1.ScyllaDBDatacenter
+		rackStatuses               []scyllav1alpha1.RackStatus
+		existingStatefulSets       []*appsv1.StatefulSet
+		pvcs                       []*corev1.PersistentVolumeClaim
+		services                   []*corev1.Service
+		maintenanceWindowOpen      bool
+		kubeObjects                []runtime.Object
+		expectedProgressingReasons []string
+		expectedActions            []string
+		expectedRackStatuses       []scyllav1alpha1.RackStatus
+		expectedErr                error
+	}{
+		{
+			name:                 "StatefulSet with a different storage class is recreated",
+			sdc:                  newScyllaDBDatacenter("scylladb/scylla:6.2.0", false),
+			rackStatuses:         []scyllav1alpha1.RackStatus{{Name: "a"}},
+			existingStatefulSets: []*appsv1.StatefulSet{newStatefulSet("a", "old", 2)},
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("a", 0, "old"),
+				newPVC("a", 1, "old"),
+			},
+			services:                   []*corev1.Service{newService("a", 0, false), newService("a", 1, false)},
+			maintenanceWindowOpen:      true,
+			kubeObjects:                []runtime.Object{newStatefulSet("a", "old", 2)},
+			expectedProgressingReasons: []string{"Progressing"},
+			expectedActions:            []string{"delete statefulsets basic-dc-a"},
+			expectedRackStatuses: []scyllav1alpha1.RackStatus{
+				{
+					Name:       "a",
+					Conditions: newConditions(metav1.ConditionTrue, "UpdatingVolumeClaimTemplate", `Recreating StatefulSet "scylla/basic-dc-a" with storage class "new"`),
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:                 "failure to recreate the StatefulSet is reported",
+			sdc:                  newScyllaDBDatacenter("scylladb/scylla:6.2.0", false),
+			rackStatuses:         []scyllav1alpha1.RackStatus{{Name: "a"}},
+			existingStatefulSets: []*appsv1.StatefulSet{newStatefulSet("a", "old", 2)},
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("a", 0, "old"),
+				newPVC("a", 1, "old"),
+			},
+			services:                   []*corev1.Service{newService("a", 0, false), newService("a", 1, false)},
+			maintenanceWindowOpen:      true,
+			kubeObjects:                nil,
+			expectedProgressingReasons: []string{"Progressing"},
+			expectedActions:            []string{"delete statefulsets basic-dc-a"},
+			expectedRackStatuses: []scyllav1alpha1.RackStatus{
+				{
+					Name:       "a",
+					Conditions: newConditions(metav1.ConditionTrue, "UpdatingVolumeClaimTemplate", `Recreating StatefulSet "scylla/basic-dc-a" with storage class "new"`),
+				},
+			},
+			expectedErr: fmt.Errorf(`can't delete StatefulSet "scylla/basic-dc-a": statefulsets.apps "basic-dc-a" not found`),
+		},
+		{
+			name:                 "the highest ordinal node which isn't migrated is replaced",
+			sdc:                  newScyllaDBDatacenter("scylladb/scylla:6.2.0", false),
+			rackStatuses:         []scyllav1alpha1.RackStatus{{Name: "a"}},
+			existingStatefulSets: []*appsv1.StatefulSet{newStatefulSet("a", "new", 2)},
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("a", 0, "old"),
+				newPVC("a", 1, "old"),
+			},
+			services:                   []*corev1.Service{newService("a", 0, false), newService("a", 1, false)},
+			maintenanceWindowOpen:      true,
+			kubeObjects:                []runtime.Object{newService("a", 0, false), newService("a", 1, false)},
+			expectedProgressingReasons: []string{"Progressing"},
+			expectedActions:            []string{"patch services basic-dc-a-1"},
+			expectedRackStatuses: []scyllav1alpha1.RackStatus{
+				{
+					Name:             "a",
+					StorageMigration: newMigrationStatus(0, false),
+					Conditions:       newConditions(metav1.ConditionTrue, "ReplacingNode", `Replacing node "scylla/basic-dc-a-1". 0 out of 2 node(s) have been migrated to storage class "new"`),
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:                 "node with a missing PVC is replaced",
+			sdc:                  newScyllaDBDatacenter("scylladb/scylla:6.2.0", false),
+			rackStatuses:         []scyllav1alpha1.RackStatus{{Name: "a"}},
+			existingStatefulSets: []*appsv1.StatefulSet{newStatefulSet("a", "new", 2)},
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("a", 0, "new"),
+			},
+			services:                   []*corev1.Service{newService("a", 0, false), newService("a", 1, false)},
+			maintenanceWindowOpen:      true,
+			kubeObjects:                []runtime.Object{newService("a", 0, false), newService("a", 1, false)},
+			expectedProgressingReasons: []string{"Progressing"},
+			expectedActions:            []string{"patch services basic-dc-a-1"},
+			expectedRackStatuses: []scyllav1alpha1.RackStatus{
+				{
+					Name:             "a",
+					StorageMigration: newMigrationStatus(1, false),
+					Conditions:       newConditions(metav1.ConditionTrue, "ReplacingNode", `Replacing node "scylla/basic-dc-a-1". 1 out of 2 node(s) have been migrated to storage class "new"`),
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:                 "failure to mark a node for replacement is reported",
+			sdc:                  newScyllaDBDatacenter("scylladb/scylla:6.2.0", false),
+			rackStatuses:         []scyllav1alpha1.RackStatus{{Name: "a"}},
+			existingStatefulSets: []*appsv1.StatefulSet{newStatefulSet("a", "new", 2)},
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("a", 0, "old"),
+				newPVC("a", 1, "new"),
+			},
+			services:                   []*corev1.Service{newService("a", 0, false), newService("a", 1, false)},
+			maintenanceWindowOpen:      true,
+			kubeObjects:                nil,
+			expectedProgressingReasons: []string{"Progressing"},
+			expectedActions:            []string{"patch services basic-dc-a-0"},
+			expectedRackStatuses: []scyllav1alpha1.RackStatus{
+				{
+					Name:             "a",
+					StorageMigration: newMigrationStatus(1, false),
+				},
+			},
+			expectedErr: fmt.Errorf(`can't mark Service "scylla/basic-dc-a-0" for replacement: services "basic-dc-a-0" not found`),
+		},
+		{
+			name:                 "ongoing replacement is awaited",
+			sdc:                  newScyllaDBDatacenter("scylladb/scylla:6.2.0", false),
+			rackStatuses:         []scyllav1alpha1.RackStatus{{Name: "a"}},
+			existingStatefulSets: []*appsv1.StatefulSet{newStatefulSet("a", "new", 1)},
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("a", 0, "old"),
+			},
+			services:                   []*corev1.Service{newService("a", 0, false), newService("a", 1, true)},
+			maintenanceWindowOpen:      true,
+			expectedProgressingReasons: []string{"WaitingForStorageMigrationNodeReplacement"},
+			expectedActions:            nil,
+			expectedRackStatuses: []scyllav1alpha1.RackStatus{
+				{
+					Name:             "a",
+					StorageMigration: newMigrationStatus(0, false),
+					Conditions:       newConditions(metav1.ConditionTrue, "ReplacingNode", `Replacing node "scylla/basic-dc-a-1". 0 out of 2 node(s) have been migrated to storage class "new"`),
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:                 "paused migration doesn't replace nodes",
+			sdc:                  newScyllaDBDatacenter("scylladb/scylla:6.2.0", true),
+			rackStatuses:         []scyllav1alpha1.RackStatus{{Name: "a"}},
+			existingStatefulSets: []*appsv1.StatefulSet{newStatefulSet("a", "new", 2)},
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("a", 0, "old"),
+				newPVC("a", 1, "new"),
+			},
+			services:                   []*corev1.Service{newService("a", 0, false), newService("a", 1, false)},
+			maintenanceWindowOpen:      true,
+			expectedProgressingReasons: nil,
+			expectedActions:            nil,
+			expectedRackStatuses: []scyllav1alpha1.RackStatus{
+				{
+					Name:             "a",
+					StorageMigration: newMigrationStatus(1, true),
+					Conditions:       newConditions(metav1.ConditionFalse, "Paused", `Storage migration is paused. 1 out of 2 node(s) have been migrated to storage class "new"`),
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:                 "migration waits for the rack to become ready",
+			sdc:                  newScyllaDBDatacenter("scylladb/scylla:6.2.0", false),
+			rackStatuses:         []scyllav1alpha1.RackStatus{{Name: "a"}},
+			existingStatefulSets: []*appsv1.StatefulSet{newStatefulSet("a", "new", 1)},
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("a", 0, "old"),
+				newPVC("a", 1, "new"),
+			},
+			services:                   []*corev1.Service{newService("a", 0, false), newService("a", 1, false)},
+			maintenanceWindowOpen:      true,
+			expectedProgressingReasons: nil,
+			expectedActions:            nil,
+			expectedRackStatuses: []scyllav1alpha1.RackStatus{
+				{
+					Name:             "a",
+					StorageMigration: newMigrationStatus(1, false),
+					Conditions:       newConditions(metav1.ConditionTrue, "WaitingForRacksToBecomeReady", `Waiting for all nodes of all racks to become ready. 1 out of 2 node(s) have been migrated to storage class "new"`),
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:                 "migration waits for a maintenance window",
+			sdc:                  newScyllaDBDatacenter("scylladb/scylla:6.2.0", false),
+			rackStatuses:         []scyllav1alpha1.RackStatus{{Name: "a"}},
+			existingStatefulSets: []*appsv1.StatefulSet{newStatefulSet("a", "new", 2)},
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("a", 0, "old"),
+				newPVC("a", 1, "new"),
+			},
+			services:                   []*corev1.Service{newService("a", 0, false), newService("a", 1, false)},
+			maintenanceWindowOpen:      false,
+			expectedProgressingReasons: nil,
+			expectedActions:            nil,
+			expectedRackStatuses: []scyllav1alpha1.RackStatus{
+				{
+					Name:             "a",
+					StorageMigration: newMigrationStatus(1, false),
+					Conditions:       newConditions(metav1.ConditionFalse, "WaitingForMaintenanceWindow", `Waiting for a maintenance window to replace the next node. 1 out of 2 node(s) have been migrated to storage class "new"`),
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:                 "ScyllaDB version without Host ID based replace degrades the rack",
+			sdc:                  newScyllaDBDatacenter("scylladb/scylla:5.1.0", false),
+			rackStatuses:         []scyllav1alpha1.RackStatus{{Name: "a"}},
+			existingStatefulSets: []*appsv1.StatefulSet{newStatefulSet("a", "new", 2)},
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("a", 0, "old"),
+				newPVC("a", 1, "new"),
+			},
+			services:                   []*corev1.Service{newService("a", 0, false), newService("a", 1, false)},
+			maintenanceWindowOpen:      true,
+			expectedProgressingReasons: nil,
+			expectedActions:            nil,
+			expectedRackStatuses: []scyllav1alpha1.RackStatus{
+				{
+					Name:             "a",
+					StorageMigration: newMigrationStatus(1, false),
+					Conditions: []metav1.Condition{
+						{
+							Type:               scyllav1alpha1.RackStorageMigrationDegradedCondition,
+							Status:             metav1.ConditionTrue,
+							Reason:             "ReplacingNodeUsingHostIDNotSupported",
+							Message:            "Migrating storage requires replacing nodes using Host ID, which isn't supported by the ScyllaDB version in use.",
+							ObservedGeneration: 42,
+						},
+					},
+				},
+			},
+			expectedErr: fmt.Errorf(`can't migrate storage of rack "a", ScyllaDB version of "scylla/basic" ScyllaDBDatacenter doesn't support HostID based replace procedure`),
+		},
+		{
+			name: "migration finishes once all nodes are migrated",
+			sdc:  newScyllaDBDatacenter("scylladb/scylla:6.2.0", false),
+			rackStatuses: []scyllav1alpha1.RackStatus{
+				{
+					Name:             "a",
+					StorageMigration: newMigrationStatus(1, false),
+				},
+			},
+			existingStatefulSets: []*appsv1.StatefulSet{newStatefulSet("a", "new", 2)},
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("a", 0, "new"),
+				newPVC("a", 1, "new"),
+			},
+			services:                   []*corev1.Service{newService("a", 0, false), newService("a", 1, false)},
+			maintenanceWindowOpen:      true,
+			expectedProgressingReasons: nil,
+			expectedActions:            nil,
+			expectedRackStatuses: []scyllav1alpha1.RackStatus{
+				{
+					Name:       "a",
+					Conditions: newConditions(metav1.ConditionFalse, "AsExpected", ""),
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:                 "node without a member Service isn't replaced",
+			sdc:                  newScyllaDBDatacenter("scylladb/scylla:6.2.0", false),
+			rackStatuses:         []scyllav1alpha1.RackStatus{{Name: "a"}},
+			existingStatefulSets: []*appsv1.StatefulSet{newStatefulSet("a", "new", 2)},
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("a", 0, "new"),
+				newPVC("a", 1, "old"),
+			},
+			services:                   []*corev1.Service{newService("a", 0, false)},
+			maintenanceWindowOpen:      true,
+			expectedProgressingReasons: nil,
+			expectedActions:            nil,
+			expectedRackStatuses: []scyllav1alpha1.RackStatus{
+				{
+					Name:             "a",
+					StorageMigration: newMigrationStatus(1, false),
+					Conditions:       newConditions(metav1.ConditionTrue, "WaitingForMemberService", `Waiting for the member Service of a node which isn't migrated. 1 out of 2 node(s) have been migrated to storage class "new"`),
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:         "only a single node is replaced across racks",
+			sdc:          newScyllaDBDatacenter("scylladb/scylla:6.2.0", false),
+			rackStatuses: []scyllav1alpha1.RackStatus{{Name: "a"}, {Name: "b"}},
+			existingStatefulSets: []*appsv1.StatefulSet{
+				newStatefulSet("a", "new", 2),
+				newStatefulSet("b", "new", 2),
+			},
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("a", 0, "old"),
+				newPVC("a", 1, "old"),
+				newPVC("b", 0, "old"),
+				newPVC("b", 1, "old"),
+			},
+			services: []*corev1.Service{
+				newService("a", 0, false),
+				newService("a", 1, false),
+				newService("b", 0, false),
+				newService("b", 1, false),
+			},
+			maintenanceWindowOpen:      true,
+			kubeObjects:                []runtime.Object{newService("a", 1, false), newService("b", 1, false)},
+			expectedProgressingReasons: []string{"Progressing"},
+			expectedActions:            []string{"patch services basic-dc-a-1"},
+			expectedRackStatuses: []scyllav1alpha1.RackStatus{
+				{
+					Name:             "a",
+					StorageMigration: newMigrationStatus(0, false),
+					Conditions:       newConditions(metav1.ConditionTrue, "ReplacingNode", `Replacing node "scylla/basic-dc-a-1". 0 out of 2 node(s) have been migrated to storage class "new"`),
+				},
+				{
+					Name: "b",
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:         "ongoing replacement in another rack is awaited",
+			sdc:          newScyllaDBDatacenter("scylladb/scylla:6.2.0", false),
+			rackStatuses: []scyllav1alpha1.RackStatus{{Name: "a"}, {Name: "b"}},
+			existingStatefulSets: []*appsv1.StatefulSet{
+				newStatefulSet("a", "new", 2),
+				newStatefulSet("b", "new", 1),
+			},
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("a", 0, "old"),
+				newPVC("a", 1, "old"),
+				newPVC("b", 0, "old"),
+			},
+			services: []*corev1.Service{
+				newService("a", 0, false),
+				newService("a", 1, false),
+				newService("b", 0, false),
+				newService("b", 1, true),
+			},
+			maintenanceWindowOpen:      true,
+			expectedProgressingReasons: []string{"WaitingForStorageMigrationNodeReplacement", "WaitingForStorageMigrationNodeReplacement"},
+			expectedActions:            nil,
+			expectedRackStatuses: []scyllav1alpha1.RackStatus{
+				{
+					Name:             "a",
+					StorageMigration: newMigrationStatus(0, false),
+					Conditions:       newConditions(metav1.ConditionTrue, "WaitingForNodeReplacement", `Waiting for node "scylla/basic-dc-b-1" of another rack to be replaced. 0 out of 2 node(s) have been migrated to storage class "new"`),
+				},
+				{
+					Name:             "b",
+					StorageMigration: newMigrationStatus(0, false),
+					Conditions:       newConditions(metav1.ConditionTrue, "ReplacingNode", `Replacing node "scylla/basic-dc-b-1". 0 out of 2 node(s) have been migrated to storage class "new"`),
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:         "migration waits for other racks to become ready",
+			sdc:          newScyllaDBDatacenter("scylladb/scylla:6.2.0", false),
+			rackStatuses: []scyllav1alpha1.RackStatus{{Name: "a"}, {Name: "b"}},
+			existingStatefulSets: []*appsv1.StatefulSet{
+				newStatefulSet("a", "new", 2),
+				newStatefulSet("b", "new", 1),
+			},
+			pvcs: []*corev1.PersistentVolumeClaim{
+				newPVC("a", 0, "old"),
+				newPVC("a", 1, "old"),
+				newPVC("b", 0, "new"),
+				newPVC("b", 1, "new"),
+			},
+			services: []*corev1.Service{
+				newService("a", 0, false),
+				newService("a", 1, false),
+				newService("b", 0, false),
+				newService("b", 1, false),
+			},
+			maintenanceWindowOpen:      true,
+			expectedProgressingReasons: nil,
+			expectedActions:            nil,
+			expectedRackStatuses: []scyllav1alpha1.RackStatus{
+				{
+					Name:             "a",
+					StorageMigration: newMigrationStatus(0, false),
+					Conditions:       newConditions(metav1.ConditionTrue, "WaitingForRacksToBecomeReady", `Waiting for all nodes of all racks to become ready. 0 out of 2 node(s) have been migrated to storage class "new"`),
+				},
+				{
+					Name:       "b",
+					Conditions: newConditions(metav1.ConditionFalse, "AsExpected", ""),
+				},
+			},
+			expectedErr: nil,
+		},
+		{
+			name:                       "missing rack status is reported",
+			sdc:                        newScyllaDBDatacenter("scylladb/scylla:6.2.0", false),
+			rackStatuses:               []scyllav1alpha1.RackStatus{{Name: "b"}},
+			existingStatefulSets:       []*appsv1.StatefulSet{newStatefulSet("a", "new", 2)},
+			maintenanceWindowOpen:      true,
+			expectedProgressingReasons: nil,
+			expectedActions:            nil,
+			expectedRackStatuses: []scyllav1alpha1.RackStatus{
+				{
+					Name: "b",
+				},
+			},
+			expectedErr: fmt.Errorf(`can't find rack "a" status in "scylla/basic" ScyllaDBDatacenter`),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, ctxCancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer ctxCancel()
+
+			pvcCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			for _, pvc := range tc.pvcs {
+				err := pvcCache.Add(pvc)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			kubeClient := fake.NewSimpleClientset(tc.kubeObjects...)
+			sdcc := &Controller{
+				kubeClient:    kubeClient,
+				pvcLister:     corev1listers.NewPersistentVolumeClaimLister(pvcCache),
+				eventRecorder: record.NewFakeRecorder(10),
+			}
+
+			services := map[string]*corev1.Service{}
+			for _, svc := range tc.services {
+				services[svc.Name] = svc
+			}
+
+			status := &scyllav1alpha1.ScyllaDBDatacenterStatus{
+				Racks: tc.rackStatuses,
+			}
+
+			var requiredStatefulSets []*appsv1.StatefulSet
+			existingStatefulSets := map[string]*appsv1.StatefulSet{}
+			for _, sts := range tc.existingStatefulSets {
+				requiredStatefulSets = append(requiredStatefulSets, newStatefulSet(sts.Labels[naming.RackNameLabel], "new", 0))
+				existingStatefulSets[sts.Name] = sts
+			}
+
+			progressingConditions, err := sdcc.syncStorageMigration(
+				ctx,
+				tc.sdc,
+				status,
+				requiredStatefulSets,
+				existingStatefulSets,
+				services,
+				&maintenanceWindowGate{open: tc.maintenanceWindowOpen},
+			)
+			if !cmp.Equal(fmt.Sprint(err), fmt.Sprint(tc.expectedErr)) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			var gotProgressingReasons []string
+			for _, c := range progressingConditions {
+				gotProgressingReasons = append(gotProgressingReasons, c.Reason)
+			}
+			if !cmp.Equal(gotProgressingReasons, tc.expectedProgressingReasons) {
+				t.Errorf("expected and got progressing reasons differ:\n%s", cmp.Diff(tc.expectedProgressingReasons, gotProgressingReasons))
+			}
+
+			var gotActions []string
+			for _, action := range kubeClient.Actions() {
+				namedAction, ok := action.(interface{ GetName() string })
+				if !ok {
+					t.Fatalf("unexpected action %#v", action)
+				}
+				gotActions = append(gotActions, fmt.Sprintf("%s %s %s", action.GetVerb(), action.GetResource().Resource, namedAction.GetName()))
+			}
+			if !cmp.Equal(gotActions, tc.expectedActions) {
+				t.Errorf("expected and got actions differ:\n%s", cmp.Diff(tc.expectedActions, gotActions))
+			}
+
+			for i := range status.Racks {
+				for j := range status.Racks[i].Conditions {
+					status.Racks[i].Conditions[j].LastTransitionTime = metav1.Time{}
+				}
+			}
+			if !cmp.Equal(status.Racks, tc.expectedRackStatuses) {
+				t.Errorf("expected and got rack statuses differ:\n%s", cmp.Diff(tc.expectedRackStatuses, status.Racks))
+			}
+		})
+	}
+}
@@ -0,0 +1,377 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+)
+
+func getUpgradeFailureAction(policy *scyllav1alpha1.UpgradeFailurePolicy) scyllav1alpha1.UpgradeFailureAction {
+	if len(policy.Action) == 0 {
+		return scyllav1alpha1.HaltUpgradeFailureAction
+	}
+
+	return policy.Action
+}
+
+// getScyllaDBImage returns the ScyllaDB image of the StatefulSet's pod template.
+func getScyllaDBImage(sts *appsv1.StatefulSet) (string, error) {
+	for _, c := range sts.Spec.Template.Spec.Containers {
+		if c.Name == naming.ScyllaContainerName {
+			return c.Image, nil
+		}
+	}
+
+	return "", fmt.Errorf("statefulset %q is missing container %q", naming.ObjRef(sts), naming.ScyllaContainerName)
+}
+
+// isNodeUpgraded returns true when the node runs the update revision of its StatefulSet and is ready.
+func (sdcc *Controller) isNodeUpgraded(sdc *scyllav1alpha1.ScyllaDBDatacenter, statefulSets map[string]*appsv1.StatefulSet, podName string) (bool, error) {
+	pod, err := sdcc.podLister.Pods(sdc.Namespace).Get(podName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("can't get pod %q: %w", naming.ManualRef(sdc.Namespace, podName), err)
+	}
+
+	controllerRef := metav1.GetControllerOf(pod)
+	if controllerRef == nil {
+		return false, fmt.Errorf("pod %q has no controller", naming.ObjRef(pod))
+	}
+
+	sts, ok := statefulSets[controllerRef.Name]
+	if !ok {
+		return false, fmt.Errorf("can't find StatefulSet %q of pod %q", naming.ManualRef(sdc.Namespace, controllerRef.Name), naming.ObjRef(pod))
+	}
+
+	if sts.Status.ObservedGeneration < sts.Generation || len(sts.Status.UpdateRevision) == 0 {
+		return false, nil
+	}
+
+	return pod.Labels[appsv1.ControllerRevisionHashLabelKey] == sts.Status.UpdateRevision && controllerhelpers.IsPodReady(pod), nil
+}
+
+func (sdcc *Controller) applyUpgradeContext(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, uc *internalapi.DatacenterUpgradeContext) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	cm, err := MakeUpgradeContextConfigMap(sdc, uc)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't make upgrade context ConfigMap: %w", err)
+	}
+
+	cm, changed, err := resourceapply.ApplyConfigMap(ctx, sdcc.kubeClient.CoreV1(), sdcc.configMapLister, sdcc.eventRecorder, cm, resourceapply.ApplyOptions{})
+	if changed {
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, cm, "apply", sdc.Generation)
+	}
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't apply upgrade context ConfigMap: %w", err)
+	}
+
+	return progressingConditions, nil
+}
+
+// syncUpgradeFailure detects upgraded nodes that don't become ready in time and handles failed upgrades.
+// It returns true when the upgrade has failed and the caller mustn't continue with the upgrade.
+func (sdcc *Controller) syncUpgradeFailure(
+	ctx context.Context,
+	key string,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
+	statefulSets map[string]*appsv1.StatefulSet,
+	configMaps map[string]*corev1.ConfigMap,
+	inputsHash string,
+) ([]metav1.Condition, bool, error) {
+	var progressingConditions []metav1.Condition
+
+	upgradeContextConfigMap, ok := configMaps[naming.UpgradeContextConfigMapName(sdc)]
+	if !ok {
+		return progressingConditions, false, nil
+	}
+
+	uc, err := sdcc.decodeUpgradeContext(upgradeContextConfigMap)
+	if err != nil {
+		return progressingConditions, false, fmt.Errorf("can't decode upgrade context for ScyllaDBDatacenter %q: %w", naming.ObjRef(sdc), err)
+	}
+
+	switch uc.State {
+	case internalapi.RolloutRunUpgradePhase:
+		return sdcc.detectUpgradeFailure(ctx, key, sdc, statefulSets, uc)
+
+	case internalapi.RollbackUpgradePhase:
+		progressingConditions, err = sdcc.rollbackUpgrade(ctx, sdc, statefulSets, uc, inputsHash)
+		return progressingConditions, true, err
+
+	case internalapi.FailedUpgradePhase:
+		progressingConditions, err = sdcc.syncFailedUpgrade(ctx, sdc, status, upgradeContextConfigMap, uc)
+		return progressingConditions, true, err
+
+	default:
+		return progressingConditions, false, nil
+	}
+}
+
+// detectUpgradeFailure fails the upgrade when the node being upgraded doesn't become ready within the timeout.
+func (sdcc *Controller) detectUpgradeFailure(
+	ctx context.Context,
+	key string,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	statefulSets map[string]*appsv1.StatefulSet,
+	uc *internalapi.DatacenterUpgradeContext,
+) ([]metav1.Condition, bool, error) {
+	var progressingConditions []metav1.Condition
+
+	policy := sdc.Spec.UpgradeFailurePolicy
+	if policy == nil || len(uc.UpgradingNode) == 0 || uc.NodeUpgradeStartTime == nil {
+		return progressingConditions, false, nil
+	}
+
+	upgraded, err := sdcc.isNodeUpgraded(sdc, statefulSets, uc.UpgradingNode)
+	if err != nil {
+		return progressingConditions, false, fmt.Errorf("can't check if node %q is upgraded: %w", uc.UpgradingNode, err)
+	}
+	if upgraded {
+		return progressingConditions, false, nil
+	}
+
+	now := time.Now()
+	deadline := uc.NodeUpgradeStartTime.Add(policy.NodeReadinessTimeout.Duration)
+	if now.Before(deadline) {
+		sdcc.queue.AddAfter(key, deadline.Sub(now))
+		return progressingConditions, false, nil
+	}
+
+	uc.FailureMessage = fmt.Sprintf("Node %q didn't become ready within %s after its upgrade from %q to %q started.", uc.UpgradingNode, policy.NodeReadinessTimeout.Duration, uc.FromVersion, uc.ToVersion)
+	uc.State = internalapi.FailedUpgradePhase
+	if getUpgradeFailureAction(policy) == scyllav1alpha1.RollbackUpgradeFailureAction {
+		if len(uc.FromImage) != 0 {
+			uc.State = internalapi.RollbackUpgradePhase
+		} else {
+			uc.FailureMessage += " The upgrade can't be rolled back because the image it started from isn't known."
+		}
+	}
+
+	klog.V(2).InfoS("Upgrade has failed", "ScyllaDBDatacenter", klog.KObj(sdc), "Node", uc.UpgradingNode, "FromVersion", uc.FromVersion, "ToVersion", uc.ToVersion, "Phase", uc.State)
+	sdcc.eventRecorder.Event(sdc, corev1.EventTypeWarning, "UpgradeFailed", uc.FailureMessage)
+
+	progressingConditions, err = sdcc.applyUpgradeContext(ctx, sdc, uc)
+	return progressingConditions, true, err
+}
+
+// rollbackUpgrade rolls all nodes back to the image the failed upgrade started from.
+func (sdcc *Controller) rollbackUpgrade(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	statefulSets map[string]*appsv1.StatefulSet,
+	uc *internalapi.DatacenterUpgradeContext,
+	inputsHash string,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	rollbackSDC := sdc.DeepCopy()
+	rollbackSDC.Spec.ScyllaDB.Image = uc.FromImage
+	rollbackStatefulSets, err := sdcc.makeRacks(rollbackSDC, statefulSets, inputsHash)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't make racks to roll back to %q: %w", uc.FromImage, err)
+	}
+
+	var errs []error
+	anyStsChanged := false
+	for _, required := range rollbackStatefulSets {
+		existing, ok := statefulSets[required.Name]
+		if !ok {
+			// Racks added after the upgrade started are created with the required image.
+			continue
+		}
+
+		required.ResourceVersion = existing.ResourceVersion
+		required.Spec.Replicas = pointer.Ptr(*existing.Spec.Replicas)
+		required.Spec.UpdateStrategy.RollingUpdate.Partition = pointer.Ptr[int32](0)
+		_, changed, err := resourceapply.ApplyStatefulSet(ctx, sdcc.kubeClient.AppsV1(), sdcc.statefulSetLister, sdcc.eventRecorder, required, resourceapply.ApplyOptions{})
+		if changed {
+			anyStsChanged = true
+			controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, required, "apply", sdc.Generation)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't apply statefulset to roll back: %w", err))
+		}
+	}
+	err = apimachineryutilerrors.NewAggregate(errs)
+	if err != nil {
+		return progressingConditions, err
+	}
+	if anyStsChanged {
+		// TODO: Add expectations, not to reconcile sooner then we see this new StatefulSet in our caches. (#682)
+		time.Sleep(artificialDelayForCachesToCatchUp)
+		return progressingConditions, nil
+	}
+
+	for _, required := range rollbackStatefulSets {
+		sts, ok := statefulSets[required.Name]
+		if !ok {
+			continue
+		}
+
+		rolledOut, err := controllerhelpers.IsStatefulSetRolledOut(sts)
+		if err != nil {
+			return progressingConditions, err
+		}
+		if rolledOut {
+			continue
+		}
+
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               statefulSetControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "RollingBackUpgrade",
+			Message:            fmt.Sprintf("Waiting for StatefulSet %q to roll back to %q.", naming.ObjRef(sts), uc.FromImage),
+			ObservedGeneration: sdc.Generation,
+		})
+
+		if sts.Status.ObservedGeneration < sts.Generation {
+			return progressingConditions, nil
+		}
+
+		// StatefulSet controller won't replace pods that aren't ready, so the failed nodes have to be deleted manually.
+		// https://github.com/kubernetes/kubernetes/issues/67250
+		for ord := int32(0); ord < *sts.Spec.Replicas; ord++ {
+			podName := fmt.Sprintf("%s-%d", sts.Name, ord)
+			pod, err := sdcc.podLister.Pods(sts.Namespace).Get(podName)
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return progressingConditions, fmt.Errorf("can't get pod %q: %w", naming.ManualRef(sts.Namespace, podName), err)
+			}
+
+			if pod.Labels[appsv1.ControllerRevisionHashLabelKey] == sts.Status.UpdateRevision || controllerhelpers.IsPodReady(pod) {
+				continue
+			}
+
+			klog.V(2).InfoS("Deleting failed Pod to roll it back", "ScyllaDBDatacenter", klog.KObj(sdc), "Pod", klog.KObj(pod))
+			controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, pod, "delete", sdc.Generation)
+			err = sdcc.kubeClient.CoreV1().Pods(pod.Namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{
+				Preconditions: &metav1.Preconditions{
+					UID: &pod.UID,
+				},
+			})
+			if err != nil && !apierrors.IsNotFound(err) {
+				return progressingConditions, fmt.Errorf("can't delete pod %q: %w", naming.ObjRef(pod), err)
+			}
+		}
+
+		return progressingConditions, nil
+	}
+
+	klog.V(2).InfoS("Failed upgrade has been rolled back", "ScyllaDBDatacenter", klog.KObj(sdc), "FromVersion", uc.FromVersion, "ToVersion", uc.ToVersion)
+	sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "UpgradeRolledBack", "Rolled back failed upgrade from %q to %q", uc.FromVersion, uc.ToVersion)
+
+	uc.State = internalapi.FailedUpgradePhase
+	uc.RolledBack = true
+	return sdcc.applyUpgradeContext(ctx, sdc, uc)
+}
+
+// syncFailedUpgrade keeps the failed upgrade halted until the ScyllaDB image is changed.
+// Reverting the image to the version the upgrade started from rolls the upgrade back,
+// changing it to any other version abandons the failed upgrade.
+func (sdcc *Controller) syncFailedUpgrade(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
+	upgradeContextConfigMap *corev1.ConfigMap,
+	uc *internalapi.DatacenterUpgradeContext,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	requiredVersion, err := naming.ImageToVersion(sdc.Spec.ScyllaDB.Image)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't get version of image %q: %w", sdc.Spec.ScyllaDB.Image, err)
+	}
+
+	switch {
+	case requiredVersion == uc.ToVersion:
+		klog.V(4).InfoS("Upgrade has failed, waiting for the image to change", "ScyllaDBDatacenter", klog.KObj(sdc), "FromVersion", uc.FromVersion, "ToVersion", uc.ToVersion)
+		return progressingConditions, nil
+
+	case requiredVersion == uc.FromVersion && !uc.RolledBack && len(uc.FromImage) != 0:
+		sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "UpgradeRollbackStarted", "Rolling back failed upgrade from %q to %q", uc.FromVersion, uc.ToVersion)
+		uc.State = internalapi.RollbackUpgradePhase
+		return sdcc.applyUpgradeContext(ctx, sdc, uc)
+
+	default:
+		// Snapshots of the failed upgrade are subject to the retention from now on.
+		retainUpgradeSnapshotStatus(sdc, status, uc, time.Now())
+
+		sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "FailedUpgradeAbandoned", "Abandoned failed upgrade from %q to %q", uc.FromVersion, uc.ToVersion)
+		controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, upgradeContextConfigMap, "delete", sdc.Generation)
+		err = sdcc.kubeClient.CoreV1().ConfigMaps(sdc.Namespace).Delete(ctx, upgradeContextConfigMap.Name, metav1.DeleteOptions{
+			Preconditions: &metav1.Preconditions{
+				UID: &upgradeContextConfigMap.UID,
+			},
+			PropagationPolicy: pointer.Ptr(metav1.DeletePropagationBackground),
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return progressingConditions, fmt.Errorf("can't delete upgrade context ConfigMap %q: %w", naming.ObjRef(upgradeContextConfigMap), err)
+		}
+
+		return progressingConditions, nil
+	}
+}
+
+// syncUpgradeFailureStatus reports failed upgrades.
+func (sdcc *Controller) syncUpgradeFailureStatus(
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
+	configMaps map[string]*corev1.ConfigMap,
+) error {
+	condition := metav1.Condition{
+		Type:               scyllav1alpha1.UpgradeDegradedCondition,
+		Status:             metav1.ConditionFalse,
+		Reason:             internalapi.AsExpectedReason,
+		ObservedGeneration: sdc.Generation,
+	}
+
+	upgradeContextConfigMap, ok := configMaps[naming.UpgradeContextConfigMapName(sdc)]
+	if ok {
+		uc, err := sdcc.decodeUpgradeContext(upgradeContextConfigMap)
+		if err != nil {
+			return fmt.Errorf("can't decode upgrade context for ScyllaDBDatacenter %q: %w", naming.ObjRef(sdc), err)
+		}
+
+		switch uc.State {
+		case internalapi.RollbackUpgradePhase:
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = "RollingBackUpgrade"
+			condition.Message = fmt.Sprintf("%s Rolling back to %q.", uc.FailureMessage, uc.FromImage)
+
+		case internalapi.FailedUpgradePhase:
+			condition.Status = metav1.ConditionTrue
+			condition.Reason = "UpgradeFailed"
+			condition.Message = uc.FailureMessage
+			if uc.RolledBack {
+				condition.Reason = "UpgradeRolledBack"
+				condition.Message = fmt.Sprintf("%s Rolled back to %q.", uc.FailureMessage, uc.FromImage)
+			}
+		}
+	}
+
+	apimeta.SetStatusCondition(&status.Conditions, condition)
+
+	return nil
+}
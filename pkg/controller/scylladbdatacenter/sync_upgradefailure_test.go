@@ -0,0 +1,142 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbdatacenter
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_syncUpgradeFailureStatus(t *testing.T) {
+	t.Parallel()
+
+	sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "basic",
+			Namespace:  "scylla",
+			Generation: 2,
+		},
+	}
+
+	newConfigMaps := func(uc *internalapi.DatacenterUpgradeContext) map[string]*corev1.ConfigMap {
+		cm, err := MakeUpgradeContextConfigMap(sdc, uc)
+		if err != nil {
+			t.Fatalf("can't make upgrade context ConfigMap: %v", err)
+		}
+
+		return map[string]*corev1.ConfigMap{
+			cm.Name: cm,
+		}
+	}
+
+	tt := []struct {
+		name       string
+		configMaps map[string]*corev1.ConfigMap
+		expected   metav1.Condition
+	}{
+		{
+			name:       "no upgrade",
+			configMaps: map[string]*corev1.ConfigMap{},
+			expected: metav1.Condition{
+				Type:               scyllav1alpha1.UpgradeDegradedCondition,
+				Status:             metav1.ConditionFalse,
+				Reason:             internalapi.AsExpectedReason,
+				ObservedGeneration: 2,
+			},
+		},
+		{
+			name: "upgrade in progress",
+			configMaps: newConfigMaps(&internalapi.DatacenterUpgradeContext{
+				State:       internalapi.RolloutRunUpgradePhase,
+				FromVersion: "2024.1.0",
+				ToVersion:   "2025.1.0",
+			}),
+			expected: metav1.Condition{
+				Type:               scyllav1alpha1.UpgradeDegradedCondition,
+				Status:             metav1.ConditionFalse,
+				Reason:             internalapi.AsExpectedReason,
+				ObservedGeneration: 2,
+			},
+		},
+		{
+			name: "halted upgrade",
+			configMaps: newConfigMaps(&internalapi.DatacenterUpgradeContext{
+				State:          internalapi.FailedUpgradePhase,
+				FromVersion:    "2024.1.0",
+				ToVersion:      "2025.1.0",
+				FromImage:      "scylladb/scylla:2024.1.0",
+				FailureMessage: "Node failed.",
+			}),
+			expected: metav1.Condition{
+				Type:               scyllav1alpha1.UpgradeDegradedCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "UpgradeFailed",
+				Message:            "Node failed.",
+				ObservedGeneration: 2,
+			},
+		},
+		{
+			name: "upgrade being rolled back",
+			configMaps: newConfigMaps(&internalapi.DatacenterUpgradeContext{
+				State:          internalapi.RollbackUpgradePhase,
+				FromVersion:    "2024.1.0",
+				ToVersion:      "2025.1.0",
+				FromImage:      "scylladb/scylla:2024.1.0",
+				FailureMessage: "Node failed.",
+			}),
+			expected: metav1.Condition{
+				Type:               scyllav1alpha1.UpgradeDegradedCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "RollingBackUpgrade",
+				Message:            `Node failed. Rolling back to "scylladb/scylla:2024.1.0".`,
+				ObservedGeneration: 2,
+			},
+		},
+		{
+			name: "rolled back upgrade",
+			configMaps: newConfigMaps(&internalapi.DatacenterUpgradeContext{
+				State:          internalapi.FailedUpgradePhase,
+				FromVersion:    "2024.1.0",
+				ToVersion:      "2025.1.0",
+				FromImage:      "scylladb/scylla:2024.1.0",
+				FailureMessage: "Node failed.",
+				RolledBack:     true,
+			}),
+			expected: metav1.Condition{
+				Type:               scyllav1alpha1.UpgradeDegradedCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "UpgradeRolledBack",
+				Message:            `Node failed. Rolled back to "scylladb/scylla:2024.1.0".`,
+				ObservedGeneration: 2,
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			status := &scyllav1alpha1.ScyllaDBDatacenterStatus{}
+			err := (&Controller{}).syncUpgradeFailureStatus(sdc, status, tc.configMaps)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := apimeta.FindStatusCondition(status.Conditions, scyllav1alpha1.UpgradeDegradedCondition)
+			if got == nil {
+				t.Fatalf("missing %q condition", scyllav1alpha1.UpgradeDegradedCondition)
+			}
+			got.LastTransitionTime = metav1.Time{}
+
+			if !cmp.Equal(*got, tc.expected) {
+				t.Errorf("expected and got conditions differ:\n%s", cmp.Diff(tc.expected, *got))
+			}
+		})
+	}
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbdatacenter
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	scyllasemver "github.com/scylladb/scylla-operator/pkg/semver"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// getUpgradePathImage returns the image the datacenter should be upgraded to next.
+// An upgrade or a rollout in progress always finishes with the image it started with. Otherwise, the first image
+// from the upgrade path that is newer than the lowest version the racks run is used.
+// Datacenters without any racks are created with the final image directly.
+func getUpgradePathImage(sdc *scyllav1alpha1.ScyllaDBDatacenter, statefulSets map[string]*appsv1.StatefulSet, uc *internalapi.DatacenterUpgradeContext) string {
+	if len(sdc.Spec.UpgradePath) == 0 {
+		return sdc.Spec.ScyllaDB.Image
+	}
+
+	finalVersion, err := scyllasemver.ImageToVersion(sdc.Spec.ScyllaDB.Image)
+	if err != nil {
+		return sdc.Spec.ScyllaDB.Image
+	}
+
+	if uc != nil {
+		for _, image := range sdc.Spec.UpgradePath {
+			version, err := naming.ImageToVersion(image)
+			if err == nil && version == uc.ToVersion {
+				return image
+			}
+		}
+
+		return sdc.Spec.ScyllaDB.Image
+	}
+
+	var currentVersion, rolloutVersion *semver.Version
+	for _, sts := range statefulSets {
+		version, err := semver.Parse(sts.Labels[naming.ScyllaVersionLabel])
+		if err != nil {
+			continue
+		}
+
+		if currentVersion == nil || version.LT(*currentVersion) {
+			currentVersion = &version
+		}
+
+		rolledOut, err := controllerhelpers.IsStatefulSetRolledOut(sts)
+		if err != nil || !rolledOut {
+			if rolloutVersion == nil || version.GT(*rolloutVersion) {
+				rolloutVersion = &version
+			}
+		}
+	}
+	if currentVersion == nil {
+		return sdc.Spec.ScyllaDB.Image
+	}
+
+	// Wait for the image being rolled out to be rolled out fully before moving on to the next one.
+	if rolloutVersion != nil {
+		for _, image := range sdc.Spec.UpgradePath {
+			version, err := scyllasemver.ImageToVersion(image)
+			if err == nil && version.EQ(*rolloutVersion) {
+				return image
+			}
+		}
+
+		return sdc.Spec.ScyllaDB.Image
+	}
+
+	for _, image := range sdc.Spec.UpgradePath {
+		version, err := scyllasemver.ImageToVersion(image)
+		if err != nil {
+			continue
+		}
+
+		if version.GT(*currentVersion) && version.LT(finalVersion) {
+			return image
+		}
+	}
+
+	return sdc.Spec.ScyllaDB.Image
+}
+
+// applyUpgradePath returns a copy of the ScyllaDBDatacenter using the image it should be upgraded to next.
+func (sdcc *Controller) applyUpgradePath(sdc *scyllav1alpha1.ScyllaDBDatacenter, statefulSets map[string]*appsv1.StatefulSet, configMaps map[string]*corev1.ConfigMap) (*scyllav1alpha1.ScyllaDBDatacenter, error) {
+	if len(sdc.Spec.UpgradePath) == 0 {
+		return sdc, nil
+	}
+
+	var uc *internalapi.DatacenterUpgradeContext
+	upgradeContextConfigMap, ok := configMaps[naming.UpgradeContextConfigMapName(sdc)]
+	if ok {
+		var err error
+		uc, err = sdcc.decodeUpgradeContext(upgradeContextConfigMap)
+		if err != nil {
+			return nil, fmt.Errorf("can't decode upgrade context for ScyllaDBDatacenter %q: %w", naming.ObjRef(sdc), err)
+		}
+	}
+
+	image := getUpgradePathImage(sdc, statefulSets, uc)
+	if image == sdc.Spec.ScyllaDB.Image {
+		return sdc, nil
+	}
+
+	sdcCopy := sdc.DeepCopy()
+	sdcCopy.Spec.ScyllaDB.Image = image
+
+	return sdcCopy, nil
+}
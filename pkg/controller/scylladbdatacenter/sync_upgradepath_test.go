@@ -0,0 +1,288 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbdatacenter
+
+import (
+	"testing"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+func Test_getUpgradePathImage(t *testing.T) {
+	t.Parallel()
+
+	newScyllaDBDatacenter := func(image string, upgradePath []string) *scyllav1alpha1.ScyllaDBDatacenter {
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "basic",
+				Namespace: "scylla",
+			},
+			Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+				ScyllaDB: scyllav1alpha1.ScyllaDB{
+					Image: image,
+				},
+				UpgradePath: upgradePath,
+			},
+		}
+	}
+
+	newStatefulSet := func(name, version string, rolledOut bool) *appsv1.StatefulSet {
+		sts := &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  "scylla",
+				Generation: 1,
+				Labels: map[string]string{
+					naming.ScyllaVersionLabel: version,
+				},
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: pointer.Ptr[int32](1),
+				UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+					Type: appsv1.RollingUpdateStatefulSetStrategyType,
+				},
+			},
+			Status: appsv1.StatefulSetStatus{
+				ObservedGeneration: 1,
+				Replicas:           1,
+				ReadyReplicas:      1,
+				AvailableReplicas:  1,
+				CurrentRevision:    "a",
+				UpdateRevision:     "a",
+			},
+		}
+		if !rolledOut {
+			sts.Status.UpdateRevision = "b"
+		}
+
+		return sts
+	}
+
+	upgradePath := []string{
+		"scylladb/scylla:6.1.0",
+		"scylladb/scylla:6.2.0",
+	}
+
+	tt := []struct {
+		name          string
+		sdc           *scyllav1alpha1.ScyllaDBDatacenter
+		statefulSets  map[string]*appsv1.StatefulSet
+		uc            *internalapi.DatacenterUpgradeContext
+		expectedImage string
+	}{
+		{
+			name:          "no upgrade path",
+			sdc:           newScyllaDBDatacenter("scylladb/scylla:2025.1.0", nil),
+			statefulSets:  map[string]*appsv1.StatefulSet{"a": newStatefulSet("a", "6.0.0", true)},
+			expectedImage: "scylladb/scylla:2025.1.0",
+		},
+		{
+			name:          "new datacenter uses the final image",
+			sdc:           newScyllaDBDatacenter("scylladb/scylla:2025.1.0", upgradePath),
+			statefulSets:  map[string]*appsv1.StatefulSet{},
+			expectedImage: "scylladb/scylla:2025.1.0",
+		},
+		{
+			name: "first hop",
+			sdc:  newScyllaDBDatacenter("scylladb/scylla:2025.1.0", upgradePath),
+			statefulSets: map[string]*appsv1.StatefulSet{
+				"a": newStatefulSet("a", "6.0.0", true),
+				"b": newStatefulSet("b", "6.0.0", true),
+			},
+			expectedImage: "scylladb/scylla:6.1.0",
+		},
+		{
+			name: "next hop once the previous one is rolled out",
+			sdc:  newScyllaDBDatacenter("scylladb/scylla:2025.1.0", upgradePath),
+			statefulSets: map[string]*appsv1.StatefulSet{
+				"a": newStatefulSet("a", "6.1.0", true),
+				"b": newStatefulSet("b", "6.1.0", true),
+			},
+			expectedImage: "scylladb/scylla:6.2.0",
+		},
+		{
+			name: "hop being rolled out",
+			sdc:  newScyllaDBDatacenter("scylladb/scylla:2025.1.0", upgradePath),
+			statefulSets: map[string]*appsv1.StatefulSet{
+				"a": newStatefulSet("a", "6.1.0", false),
+				"b": newStatefulSet("b", "6.1.0", true),
+			},
+			expectedImage: "scylladb/scylla:6.1.0",
+		},
+		{
+			name: "final image once all hops are rolled out",
+			sdc:  newScyllaDBDatacenter("scylladb/scylla:2025.1.0", upgradePath),
+			statefulSets: map[string]*appsv1.StatefulSet{
+				"a": newStatefulSet("a", "6.2.0", true),
+			},
+			expectedImage: "scylladb/scylla:2025.1.0",
+		},
+		{
+			name: "hops older than the current version are skipped",
+			sdc:  newScyllaDBDatacenter("scylladb/scylla:2025.1.0", upgradePath),
+			statefulSets: map[string]*appsv1.StatefulSet{
+				"a": newStatefulSet("a", "6.1.5", true),
+			},
+			expectedImage: "scylladb/scylla:6.2.0",
+		},
+		{
+			name: "upgrade in progress",
+			sdc:  newScyllaDBDatacenter("scylladb/scylla:2025.1.0", upgradePath),
+			statefulSets: map[string]*appsv1.StatefulSet{
+				"a": newStatefulSet("a", "6.2.0", true),
+			},
+			uc: &internalapi.DatacenterUpgradeContext{
+				State:       internalapi.RolloutRunUpgradePhase,
+				FromVersion: "6.1.0",
+				ToVersion:   "6.2.0",
+			},
+			expectedImage: "scylladb/scylla:6.2.0",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getUpgradePathImage(tc.sdc, tc.statefulSets, tc.uc)
+			if got != tc.expectedImage {
+				t.Errorf("expected image %q, got %q", tc.expectedImage, got)
+			}
+		})
+	}
+}
+
+func Test_calculateStatus_UpdatedVersion(t *testing.T) {
+	t.Parallel()
+
+	newScyllaDBDatacenter := func(upgradePath []string) *scyllav1alpha1.ScyllaDBDatacenter {
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "basic",
+				Namespace: "scylla",
+			},
+			Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+				ScyllaDB: scyllav1alpha1.ScyllaDB{
+					Image: "scylladb/scylla:6.2.0",
+				},
+				UpgradePath: upgradePath,
+				Racks: []scyllav1alpha1.RackSpec{
+					{
+						Name: "a",
+					},
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name                   string
+		sdc                    *scyllav1alpha1.ScyllaDBDatacenter
+		expectedUpdatedVersion string
+		expectedCurrentVersion string
+	}{
+		{
+			name:                   "racks are updated to the image from spec without an upgrade path",
+			sdc:                    newScyllaDBDatacenter(nil),
+			expectedUpdatedVersion: "6.2.0",
+			expectedCurrentVersion: "6.0.0",
+		},
+		{
+			name:                   "racks are updated to the intermediate image from the upgrade path",
+			sdc:                    newScyllaDBDatacenter([]string{"scylladb/scylla:6.1.0"}),
+			expectedUpdatedVersion: "6.1.0",
+			expectedCurrentVersion: "6.0.0",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			sts := &appsv1.StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       naming.StatefulSetNameForRack(tc.sdc.Spec.Racks[0], tc.sdc),
+					Namespace:  "scylla",
+					UID:        "sts-uid",
+					Generation: 1,
+					Labels: map[string]string{
+						naming.RackNameLabel:      "a",
+						naming.ScyllaVersionLabel: "6.0.0",
+					},
+				},
+				Spec: appsv1.StatefulSetSpec{
+					Replicas: pointer.Ptr[int32](1),
+					UpdateStrategy: appsv1.StatefulSetUpdateStrategy{
+						Type: appsv1.RollingUpdateStatefulSetStrategyType,
+					},
+				},
+				Status: appsv1.StatefulSetStatus{
+					ObservedGeneration: 1,
+					Replicas:           1,
+					ReadyReplicas:      1,
+					AvailableReplicas:  1,
+					CurrentReplicas:    1,
+					UpdatedReplicas:    1,
+					CurrentRevision:    "a",
+					UpdateRevision:     "a",
+				},
+			}
+
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      sts.Name + "-0",
+					Namespace: "scylla",
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: "apps/v1",
+							Kind:       "StatefulSet",
+							Name:       sts.Name,
+							UID:        sts.UID,
+							Controller: pointer.Ptr(true),
+						},
+					},
+				},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name:  naming.ScyllaContainerName,
+							Image: "scylladb/scylla:6.0.0",
+						},
+					},
+				},
+			}
+
+			podCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			err := podCache.Add(pod)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pvcCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+
+			sdcc := &Controller{
+				podLister: corev1listers.NewPodLister(podCache),
+				pvcLister: corev1listers.NewPersistentVolumeClaimLister(pvcCache),
+			}
+
+			status := sdcc.calculateStatus(tc.sdc, map[string]*appsv1.StatefulSet{sts.Name: sts}, nil)
+			if len(status.Racks) != 1 {
+				t.Fatalf("expected 1 rack status, got %d", len(status.Racks))
+			}
+
+			if status.Racks[0].UpdatedVersion != tc.expectedUpdatedVersion {
+				t.Errorf("expected updated version %q, got %q", tc.expectedUpdatedVersion, status.Racks[0].UpdatedVersion)
+			}
+			if status.Racks[0].CurrentVersion != tc.expectedCurrentVersion {
+				t.Errorf("expected current version %q, got %q", tc.expectedCurrentVersion, status.Racks[0].CurrentVersion)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+func isUpgradeDataSnapshotEnabled(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+	if sdc.Spec.UpgradeSnapshots == nil || sdc.Spec.UpgradeSnapshots.DataSnapshots == nil {
+		return true
+	}
+
+	return *sdc.Spec.UpgradeSnapshots.DataSnapshots
+}
+
+func getRetainedUpgrades(sdc *scyllav1alpha1.ScyllaDBDatacenter) int32 {
+	if sdc.Spec.UpgradeSnapshots == nil || sdc.Spec.UpgradeSnapshots.RetainedUpgrades == nil {
+		return 0
+	}
+
+	return *sdc.Spec.UpgradeSnapshots.RetainedUpgrades
+}
+
+// shouldRemoveSnapshotsAfterUpgrade returns whether the snapshots taken for an upgrade are removed as soon as it finishes.
+// Retained snapshots are removed by syncUpgradeSnapshots once they exceed the retention instead.
+func shouldRemoveSnapshotsAfterUpgrade(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+	return getRetainedUpgrades(sdc) == 0
+}
+
+// setUpgradeSnapshotStatus records the snapshots of the upgrade in the status, unless they are already present.
+func setUpgradeSnapshotStatus(status *scyllav1alpha1.ScyllaDBDatacenterStatus, uc *internalapi.DatacenterUpgradeContext) *scyllav1alpha1.UpgradeSnapshotStatus {
+	idx := slices.IndexFunc(status.UpgradeSnapshots, func(us scyllav1alpha1.UpgradeSnapshotStatus) bool {
+		return us.SystemSnapshotTag == uc.SystemSnapshotTag
+	})
+	if idx >= 0 {
+		return &status.UpgradeSnapshots[idx]
+	}
+
+	us := scyllav1alpha1.UpgradeSnapshotStatus{
+		FromVersion:       uc.FromVersion,
+		ToVersion:         uc.ToVersion,
+		SystemSnapshotTag: uc.SystemSnapshotTag,
+	}
+	if len(uc.DataSnapshotTag) != 0 {
+		us.DataSnapshotTag = pointer.Ptr(uc.DataSnapshotTag)
+	}
+	status.UpgradeSnapshots = append(status.UpgradeSnapshots, us)
+
+	return &status.UpgradeSnapshots[len(status.UpgradeSnapshots)-1]
+}
+
+// completeUpgradeSnapshotStatus marks the snapshots of the upgrade as retained, or drops them from the status
+// when they've already been removed by the upgrade hooks.
+func completeUpgradeSnapshotStatus(sdc *scyllav1alpha1.ScyllaDBDatacenter, status *scyllav1alpha1.ScyllaDBDatacenterStatus, uc *internalapi.DatacenterUpgradeContext, now time.Time) {
+	if getRetainedUpgrades(sdc) == 0 {
+		status.UpgradeSnapshots = slices.DeleteFunc(status.UpgradeSnapshots, func(us scyllav1alpha1.UpgradeSnapshotStatus) bool {
+			return us.SystemSnapshotTag == uc.SystemSnapshotTag
+		})
+		return
+	}
+
+	retainUpgradeSnapshotStatus(sdc, status, uc, now)
+}
+
+// retainUpgradeSnapshotStatus marks the snapshots of the upgrade as completed, making them subject to the retention.
+func retainUpgradeSnapshotStatus(sdc *scyllav1alpha1.ScyllaDBDatacenter, status *scyllav1alpha1.ScyllaDBDatacenterStatus, uc *internalapi.DatacenterUpgradeContext, now time.Time) {
+	us := setUpgradeSnapshotStatus(status, uc)
+	if us.CompletionTime != nil {
+		return
+	}
+
+	us.CompletionTime = pointer.Ptr(metav1.NewTime(now))
+	if sdc.Spec.UpgradeSnapshots != nil && sdc.Spec.UpgradeSnapshots.TTL != nil {
+		us.ExpirationTime = pointer.Ptr(metav1.NewTime(now.Add(sdc.Spec.UpgradeSnapshots.TTL.Duration)))
+	}
+}
+
+// getUpgradeSnapshotsToRemove returns the snapshots of completed upgrades which are either expired
+// or exceed the number of retained upgrades. Snapshots of the upgrade in progress are never removed.
+func getUpgradeSnapshotsToRemove(upgradeSnapshots []scyllav1alpha1.UpgradeSnapshotStatus, retainedUpgrades int32, now time.Time) []scyllav1alpha1.UpgradeSnapshotStatus {
+	var completed []scyllav1alpha1.UpgradeSnapshotStatus
+	for _, us := range upgradeSnapshots {
+		if us.CompletionTime != nil {
+			completed = append(completed, us)
+		}
+	}
+
+	// Most recent upgrades come first.
+	slices.SortStableFunc(completed, func(a, b scyllav1alpha1.UpgradeSnapshotStatus) int {
+		return b.CompletionTime.Time.Compare(a.CompletionTime.Time)
+	})
+
+	var toRemove []scyllav1alpha1.UpgradeSnapshotStatus
+	for i, us := range completed {
+		if int32(i) >= retainedUpgrades || (us.ExpirationTime != nil && !now.Before(us.ExpirationTime.Time)) {
+			toRemove = append(toRemove, us)
+		}
+	}
+
+	return toRemove
+}
+
+// syncUpgradeSnapshots removes the snapshots of completed upgrades which are no longer retained.
+func (sdcc *Controller) syncUpgradeSnapshots(
+	ctx context.Context,
+	key string,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
+	services map[string]*corev1.Service,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	now := time.Now()
+	toRemove := getUpgradeSnapshotsToRemove(status.UpgradeSnapshots, getRetainedUpgrades(sdc), now)
+
+	// Expiration isn't observable, requeue once the next retained snapshot is due to expire.
+	defer func() {
+		var nextExpiration *time.Time
+		for _, us := range status.UpgradeSnapshots {
+			if us.ExpirationTime != nil && (nextExpiration == nil || us.ExpirationTime.Time.Before(*nextExpiration)) {
+				nextExpiration = pointer.Ptr(us.ExpirationTime.Time)
+			}
+		}
+		if nextExpiration != nil {
+			sdcc.queue.AddAfter(key, max(nextExpiration.Sub(now), time.Second))
+		}
+	}()
+
+	if len(toRemove) == 0 {
+		return progressingConditions, nil
+	}
+
+	// Snapshots are removed from all nodes, wait for the nodes to be up.
+	if !apimeta.IsStatusConditionTrue(status.Conditions, statefulSetControllerAvailableCondition) {
+		klog.V(4).InfoS("Waiting for the ScyllaDBDatacenter to be available before removing upgrade snapshots", "ScyllaDBDatacenter", klog.KObj(sdc))
+		return progressingConditions, nil
+	}
+
+	hosts, err := controllerhelpers.GetRequiredScyllaHosts(sdc, services, sdcc.podLister)
+	if err != nil {
+		return progressingConditions, err
+	}
+
+	scyllaClient, err := sdcc.getScyllaClient(ctx, sdc, hosts)
+	if err != nil {
+		return progressingConditions, err
+	}
+	defer scyllaClient.Close()
+
+	for _, us := range toRemove {
+		snapshotTags := []string{us.SystemSnapshotTag}
+		if us.DataSnapshotTag != nil {
+			snapshotTags = append(snapshotTags, *us.DataSnapshotTag)
+		}
+
+		err = controllerhelpers.RemoveSnapshots(ctx, scyllaClient, hosts, snapshotTags)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't remove snapshots of upgrade from %q to %q: %w", us.FromVersion, us.ToVersion, err)
+		}
+
+		status.UpgradeSnapshots = slices.DeleteFunc(status.UpgradeSnapshots, func(existing scyllav1alpha1.UpgradeSnapshotStatus) bool {
+			return existing.SystemSnapshotTag == us.SystemSnapshotTag
+		})
+		sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "UpgradeSnapshotsRemoved", "Removed snapshots of upgrade from %q to %q", us.FromVersion, us.ToVersion)
+	}
+
+	return progressingConditions, nil
+}
@@ -0,0 +1,94 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbdatacenter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_getUpgradeSnapshotsToRemove(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	newUpgradeSnapshot := func(toVersion string, completionTime, expirationTime *time.Time) scyllav1alpha1.UpgradeSnapshotStatus {
+		us := scyllav1alpha1.UpgradeSnapshotStatus{
+			FromVersion:       "2024.1.0",
+			ToVersion:         toVersion,
+			SystemSnapshotTag: "so_system_" + toVersion,
+			DataSnapshotTag:   pointer.Ptr("so_data_" + toVersion),
+		}
+		if completionTime != nil {
+			us.CompletionTime = pointer.Ptr(metav1.NewTime(*completionTime))
+		}
+		if expirationTime != nil {
+			us.ExpirationTime = pointer.Ptr(metav1.NewTime(*expirationTime))
+		}
+		return us
+	}
+
+	tt := []struct {
+		name             string
+		upgradeSnapshots []scyllav1alpha1.UpgradeSnapshotStatus
+		retainedUpgrades int32
+		expected         []scyllav1alpha1.UpgradeSnapshotStatus
+	}{
+		{
+			name:             "no upgrade snapshots",
+			upgradeSnapshots: nil,
+			retainedUpgrades: 1,
+			expected:         nil,
+		},
+		{
+			name: "upgrade in progress is never removed",
+			upgradeSnapshots: []scyllav1alpha1.UpgradeSnapshotStatus{
+				newUpgradeSnapshot("2025.1.0", nil, nil),
+			},
+			retainedUpgrades: 0,
+			expected:         nil,
+		},
+		{
+			name: "oldest upgrades exceeding the retention are removed",
+			upgradeSnapshots: []scyllav1alpha1.UpgradeSnapshotStatus{
+				newUpgradeSnapshot("2025.1.0", pointer.Ptr(now.Add(-72*time.Hour)), nil),
+				newUpgradeSnapshot("2025.1.2", pointer.Ptr(now.Add(-24*time.Hour)), nil),
+				newUpgradeSnapshot("2025.1.1", pointer.Ptr(now.Add(-48*time.Hour)), nil),
+				newUpgradeSnapshot("2025.2.0", nil, nil),
+			},
+			retainedUpgrades: 2,
+			expected: []scyllav1alpha1.UpgradeSnapshotStatus{
+				newUpgradeSnapshot("2025.1.0", pointer.Ptr(now.Add(-72*time.Hour)), nil),
+			},
+		},
+		{
+			name: "expired upgrades are removed",
+			upgradeSnapshots: []scyllav1alpha1.UpgradeSnapshotStatus{
+				newUpgradeSnapshot("2025.1.0", pointer.Ptr(now.Add(-48*time.Hour)), pointer.Ptr(now.Add(-24*time.Hour))),
+				newUpgradeSnapshot("2025.1.1", pointer.Ptr(now.Add(-24*time.Hour)), pointer.Ptr(now)),
+				newUpgradeSnapshot("2025.1.2", pointer.Ptr(now.Add(-time.Hour)), pointer.Ptr(now.Add(time.Hour))),
+			},
+			retainedUpgrades: 3,
+			expected: []scyllav1alpha1.UpgradeSnapshotStatus{
+				newUpgradeSnapshot("2025.1.1", pointer.Ptr(now.Add(-24*time.Hour)), pointer.Ptr(now)),
+				newUpgradeSnapshot("2025.1.0", pointer.Ptr(now.Add(-48*time.Hour)), pointer.Ptr(now.Add(-24*time.Hour))),
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getUpgradeSnapshotsToRemove(tc.upgradeSnapshots, tc.retainedUpgrades, now)
+			if !cmp.Equal(got, tc.expected) {
+				t.Errorf("expected and got upgrade snapshots differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbdatacenter
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+func isUpgradeSSTablesEnabled(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+	return sdc.Spec.UpgradeSSTables != nil && *sdc.Spec.UpgradeSSTables
+}
+
+func isJobFailed(job *batchv1.Job) bool {
+	for _, c := range job.Status.Conditions {
+		if c.Type == batchv1.JobFailed && c.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+
+	return false
+}
+
+// syncUpgradeSSTables upgrades SSTables of the nodes using a Job per node, one node at a time.
+// Nodes with upgraded SSTables are recorded in the upgrade context.
+// It returns progressing conditions until SSTables of all nodes are upgraded.
+func (sdcc *Controller) syncUpgradeSSTables(
+	ctx context.Context,
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	services map[string]*corev1.Service,
+	uc *internalapi.DatacenterUpgradeContext,
+	maintenanceWindowGate *maintenanceWindowGate,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	for _, rack := range sdc.Spec.Racks {
+		rackNodes, err := controllerhelpers.GetRackNodeCount(sdc, rack.Name)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't get rack %q node count of ScyllaDBDatacenter %q: %w", rack.Name, naming.ObjRef(sdc), err)
+		}
+
+		for i := int32(0); i < *rackNodes; i++ {
+			svcName := naming.MemberServiceName(rack, sdc, int(i))
+			jobName := naming.UpgradeSSTablesJobForService(svcName)
+
+			job, err := sdcc.jobLister.Jobs(sdc.Namespace).Get(jobName)
+			if err != nil && !apierrors.IsNotFound(err) {
+				return progressingConditions, fmt.Errorf("can't get Job %q: %w", naming.ManualRef(sdc.Namespace, jobName), err)
+			}
+			jobExists := err == nil
+
+			if slices.Contains(uc.SSTablesUpgradedNodes, svcName) {
+				if !jobExists {
+					continue
+				}
+
+				controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, job, "delete", sdc.Generation)
+				err = sdcc.kubeClient.BatchV1().Jobs(sdc.Namespace).Delete(ctx, job.Name, metav1.DeleteOptions{
+					Preconditions: &metav1.Preconditions{
+						UID: &job.UID,
+					},
+					PropagationPolicy: pointer.Ptr(metav1.DeletePropagationBackground),
+				})
+				if err != nil && !apierrors.IsNotFound(err) {
+					return progressingConditions, fmt.Errorf("can't delete Job %q: %w", naming.ObjRef(job), err)
+				}
+
+				return progressingConditions, nil
+			}
+
+			if !jobExists {
+				svc, ok := services[svcName]
+				if !ok {
+					progressingConditions = append(progressingConditions, metav1.Condition{
+						Type:               statefulSetControllerProgressingCondition,
+						Status:             metav1.ConditionTrue,
+						Reason:             "WaitingForService",
+						Message:            fmt.Sprintf("Waiting for Service %q", naming.ManualRef(sdc.Namespace, svcName)),
+						ObservedGeneration: sdc.Generation,
+					})
+					return progressingConditions, nil
+				}
+
+				if !maintenanceWindowGate.allows(fmt.Sprintf("SSTables upgrade of node %q", svcName)) {
+					progressingConditions = append(progressingConditions, metav1.Condition{
+						Type:               statefulSetControllerProgressingCondition,
+						Status:             metav1.ConditionTrue,
+						Reason:             "WaitingForMaintenanceWindow",
+						Message:            fmt.Sprintf("Waiting for a maintenance window to upgrade SSTables of node %q.", svcName),
+						ObservedGeneration: sdc.Generation,
+					})
+					return progressingConditions, nil
+				}
+
+				podName := naming.PodNameFromService(svc)
+				pod, err := sdcc.podLister.Pods(sdc.Namespace).Get(podName)
+				if err != nil {
+					return progressingConditions, fmt.Errorf("can't get Pod %q: %w", naming.ManualRef(sdc.Namespace, podName), err)
+				}
+
+				nodeAddress, err := controllerhelpers.GetScyllaClientBroadcastHost(sdc, svc, pod)
+				if err != nil {
+					return progressingConditions, fmt.Errorf("can't get node address of %q Pod: %w", naming.ObjRef(pod), err)
+				}
+
+				required := MakeUpgradeSSTablesJob(sdc, &rack, svc, nodeAddress, sdcc.operatorImage)
+				job, changed, err := resourceapply.ApplyJob(ctx, sdcc.kubeClient.BatchV1(), sdcc.jobLister, sdcc.eventRecorder, required, resourceapply.ApplyOptions{})
+				if changed {
+					controllerhelpers.AddGenericProgressingStatusCondition(&progressingConditions, statefulSetControllerProgressingCondition, required, "apply", sdc.Generation)
+				}
+				if err != nil {
+					return progressingConditions, fmt.Errorf("can't apply Job: %w", err)
+				}
+
+				klog.V(2).InfoS("Started SSTables upgrade", "ScyllaDBDatacenter", klog.KObj(sdc), "Node", svcName, "Job", klog.KObj(job))
+				return progressingConditions, nil
+			}
+
+			if isJobFailed(job) {
+				return progressingConditions, fmt.Errorf("job %q upgrading SSTables of node %q has failed, delete it to retry", naming.ObjRef(job), svcName)
+			}
+
+			if job.Status.CompletionTime == nil {
+				progressingConditions = append(progressingConditions, metav1.Condition{
+					Type:               statefulSetControllerProgressingCondition,
+					Status:             metav1.ConditionTrue,
+					Reason:             "WaitingForSSTablesUpgrade",
+					Message:            fmt.Sprintf("Waiting for Job %q to upgrade SSTables of node %q.", naming.ObjRef(job), svcName),
+					ObservedGeneration: sdc.Generation,
+				})
+				return progressingConditions, nil
+			}
+
+			uc.SSTablesUpgradedNodes = append(uc.SSTablesUpgradedNodes, svcName)
+			applyProgressingConditions, err := sdcc.applyUpgradeContext(ctx, sdc, uc)
+			progressingConditions = append(progressingConditions, applyProgressingConditions...)
+			if err != nil {
+				return progressingConditions, err
+			}
+
+			sdcc.eventRecorder.Eventf(sdc, corev1.EventTypeNormal, "SSTablesUpgraded", "Upgraded SSTables of node %q", svcName)
+			return progressingConditions, nil
+		}
+	}
+
+	return progressingConditions, nil
+}
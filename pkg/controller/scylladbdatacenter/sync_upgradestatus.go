@@ -0,0 +1,96 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbdatacenter
+
+import (
+	"fmt"
+	"slices"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// makeUpgradeStatus reflects the upgrade context in the status.
+// Nodes are considered upgraded once they are ready and run the version being upgraded to.
+// SSTables upgrade progress is only reported when SSTables are upgraded as part of the upgrade.
+func makeUpgradeStatus(uc *internalapi.DatacenterUpgradeContext, startTime metav1.Time, rackStatuses []scyllav1alpha1.RackStatus, pods []*corev1.Pod, upgradeSSTables bool) *scyllav1alpha1.UpgradeStatus {
+	upgradeStatus := &scyllav1alpha1.UpgradeStatus{
+		Phase:             scyllav1alpha1.UpgradePhase(uc.State),
+		FromVersion:       uc.FromVersion,
+		ToVersion:         uc.ToVersion,
+		StartTime:         pointer.Ptr(startTime),
+		SystemSnapshotTag: pointer.Ptr(uc.SystemSnapshotTag),
+	}
+
+	if len(uc.DataSnapshotTag) != 0 {
+		upgradeStatus.DataSnapshotTag = pointer.Ptr(uc.DataSnapshotTag)
+	}
+
+	if len(uc.UpgradingNode) != 0 && uc.State == internalapi.RolloutRunUpgradePhase {
+		upgradeStatus.UpgradingNode = pointer.Ptr(uc.UpgradingNode)
+	}
+
+	for _, rs := range rackStatuses {
+		rackUpgradeStatus := scyllav1alpha1.RackUpgradeStatus{
+			Name: rs.Name,
+		}
+		if rs.Nodes != nil {
+			rackUpgradeStatus.Nodes = *rs.Nodes
+		}
+
+		if upgradeSSTables {
+			rackUpgradeStatus.SSTablesUpgradedNodes = pointer.Ptr[int32](0)
+		}
+
+		for _, pod := range pods {
+			if pod.Labels[naming.RackNameLabel] != rs.Name {
+				continue
+			}
+
+			if upgradeSSTables && slices.Contains(uc.SSTablesUpgradedNodes, pod.Name) {
+				*rackUpgradeStatus.SSTablesUpgradedNodes++
+			}
+
+			if pod.Labels[naming.ScyllaVersionLabel] == uc.ToVersion && controllerhelpers.IsPodReady(pod) {
+				rackUpgradeStatus.UpgradedNodes++
+			}
+		}
+
+		upgradeStatus.Racks = append(upgradeStatus.Racks, rackUpgradeStatus)
+	}
+
+	return upgradeStatus
+}
+
+// syncUpgradeStatus reflects the progress of the upgrade in progress.
+func (sdcc *Controller) syncUpgradeStatus(
+	sdc *scyllav1alpha1.ScyllaDBDatacenter,
+	status *scyllav1alpha1.ScyllaDBDatacenterStatus,
+	configMaps map[string]*corev1.ConfigMap,
+) error {
+	upgradeContextConfigMap, ok := configMaps[naming.UpgradeContextConfigMapName(sdc)]
+	if !ok {
+		status.Upgrade = nil
+		return nil
+	}
+
+	uc, err := sdcc.decodeUpgradeContext(upgradeContextConfigMap)
+	if err != nil {
+		return fmt.Errorf("can't decode upgrade context for ScyllaDBDatacenter %q: %w", naming.ObjRef(sdc), err)
+	}
+
+	pods, err := sdcc.podLister.Pods(sdc.Namespace).List(labels.SelectorFromSet(naming.ClusterLabels(sdc)))
+	if err != nil {
+		return fmt.Errorf("can't list pods: %w", err)
+	}
+
+	status.Upgrade = makeUpgradeStatus(uc, upgradeContextConfigMap.CreationTimestamp, status.Racks, pods, isUpgradeSSTablesEnabled(sdc))
+
+	return nil
+}
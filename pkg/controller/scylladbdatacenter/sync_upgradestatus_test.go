@@ -0,0 +1,177 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbdatacenter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_makeUpgradeStatus(t *testing.T) {
+	t.Parallel()
+
+	startTime := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	rackStatuses := []scyllav1alpha1.RackStatus{
+		{
+			Name:  "a",
+			Nodes: pointer.Ptr[int32](2),
+		},
+		{
+			Name:  "b",
+			Nodes: pointer.Ptr[int32](1),
+		},
+	}
+
+	newPod := func(name, rackName, version string, ready bool) *corev1.Pod {
+		readyStatus := corev1.ConditionFalse
+		if ready {
+			readyStatus = corev1.ConditionTrue
+		}
+
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "scylla",
+				Labels: map[string]string{
+					naming.RackNameLabel:      rackName,
+					naming.ScyllaVersionLabel: version,
+				},
+			},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{
+					{
+						Type:   corev1.PodReady,
+						Status: readyStatus,
+					},
+				},
+			},
+		}
+	}
+
+	pods := []*corev1.Pod{
+		newPod("basic-dc1-a-0", "a", "2024.1.0", true),
+		newPod("basic-dc1-a-1", "a", "2025.1.0", true),
+		newPod("basic-dc1-b-0", "b", "2025.1.0", false),
+	}
+
+	tt := []struct {
+		name            string
+		uc              *internalapi.DatacenterUpgradeContext
+		upgradeSSTables bool
+		expected        *scyllav1alpha1.UpgradeStatus
+	}{
+		{
+			name: "upgrade running a rollout",
+			uc: &internalapi.DatacenterUpgradeContext{
+				State:             internalapi.RolloutRunUpgradePhase,
+				FromVersion:       "2024.1.0",
+				ToVersion:         "2025.1.0",
+				SystemSnapshotTag: "so_system_2025-01-01T00:00:00Z",
+				DataSnapshotTag:   "so_data_2025-01-01T00:00:00Z",
+				UpgradingNode:     "basic-dc1-b-0",
+			},
+			expected: &scyllav1alpha1.UpgradeStatus{
+				Phase:             scyllav1alpha1.RolloutRunUpgradePhase,
+				FromVersion:       "2024.1.0",
+				ToVersion:         "2025.1.0",
+				StartTime:         pointer.Ptr(startTime),
+				SystemSnapshotTag: pointer.Ptr("so_system_2025-01-01T00:00:00Z"),
+				DataSnapshotTag:   pointer.Ptr("so_data_2025-01-01T00:00:00Z"),
+				UpgradingNode:     pointer.Ptr("basic-dc1-b-0"),
+				Racks: []scyllav1alpha1.RackUpgradeStatus{
+					{
+						Name:          "a",
+						Nodes:         2,
+						UpgradedNodes: 1,
+					},
+					{
+						Name:          "b",
+						Nodes:         1,
+						UpgradedNodes: 0,
+					},
+				},
+			},
+		},
+		{
+			name: "upgrade without data snapshots running pre-hooks",
+			uc: &internalapi.DatacenterUpgradeContext{
+				State:             internalapi.PreHooksUpgradePhase,
+				FromVersion:       "2024.1.0",
+				ToVersion:         "2025.1.0",
+				SystemSnapshotTag: "so_system_2025-01-01T00:00:00Z",
+				UpgradingNode:     "basic-dc1-b-0",
+			},
+			expected: &scyllav1alpha1.UpgradeStatus{
+				Phase:             scyllav1alpha1.PreHooksUpgradePhase,
+				FromVersion:       "2024.1.0",
+				ToVersion:         "2025.1.0",
+				StartTime:         pointer.Ptr(startTime),
+				SystemSnapshotTag: pointer.Ptr("so_system_2025-01-01T00:00:00Z"),
+				Racks: []scyllav1alpha1.RackUpgradeStatus{
+					{
+						Name:          "a",
+						Nodes:         2,
+						UpgradedNodes: 1,
+					},
+					{
+						Name:          "b",
+						Nodes:         1,
+						UpgradedNodes: 0,
+					},
+				},
+			},
+		},
+		{
+			name: "upgrade upgrading SSTables",
+			uc: &internalapi.DatacenterUpgradeContext{
+				State:                 internalapi.PostHooksUpgradePhase,
+				FromVersion:           "2024.1.0",
+				ToVersion:             "2025.1.0",
+				SystemSnapshotTag:     "so_system_2025-01-01T00:00:00Z",
+				SSTablesUpgradedNodes: []string{"basic-dc1-a-1"},
+			},
+			upgradeSSTables: true,
+			expected: &scyllav1alpha1.UpgradeStatus{
+				Phase:             scyllav1alpha1.PostHooksUpgradePhase,
+				FromVersion:       "2024.1.0",
+				ToVersion:         "2025.1.0",
+				StartTime:         pointer.Ptr(startTime),
+				SystemSnapshotTag: pointer.Ptr("so_system_2025-01-01T00:00:00Z"),
+				Racks: []scyllav1alpha1.RackUpgradeStatus{
+					{
+						Name:                  "a",
+						Nodes:                 2,
+						UpgradedNodes:         1,
+						SSTablesUpgradedNodes: pointer.Ptr[int32](1),
+					},
+					{
+						Name:                  "b",
+						Nodes:                 1,
+						UpgradedNodes:         0,
+						SSTablesUpgradedNodes: pointer.Ptr[int32](0),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := makeUpgradeStatus(tc.uc, startTime, rackStatuses, pods, tc.upgradeSSTables)
+			if !cmp.Equal(got, tc.expected) {
+				t.Errorf("expected and got upgrade statuses differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
@@ -0,0 +1,243 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbmanagertask
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryutilvalidation "k8s.io/apimachinery/pkg/util/validation"
+)
+
+var invalidScyllaDBManagerTaskNameCharactersRe = regexp.MustCompile(`[^a-z0-9-]+`)
+
+type scyllaDBManagerClientBackupTaskProperties struct {
+	DC               []string `mapstructure:"dc"`
+	Keyspace         []string `mapstructure:"keyspace"`
+	Location         []string `mapstructure:"location"`
+	RateLimit        []string `mapstructure:"rate_limit"`
+	Retention        *int64   `mapstructure:"retention"`
+	SnapshotParallel []string `mapstructure:"snapshot_parallel"`
+	UploadParallel   []string `mapstructure:"upload_parallel"`
+}
+
+type scyllaDBManagerClientRepairTaskProperties struct {
+	DC                  []string `mapstructure:"dc"`
+	Keyspace            []string `mapstructure:"keyspace"`
+	FailFast            *bool    `mapstructure:"fail_fast"`
+	Host                *string  `mapstructure:"host"`
+	IgnoreDownHosts     *bool    `mapstructure:"ignore_down_hosts"`
+	Intensity           *float64 `mapstructure:"intensity"`
+	Parallel            *int64   `mapstructure:"parallel"`
+	SmallTableThreshold *int64   `mapstructure:"small_table_threshold"`
+}
+
+type scyllaDBManagerClientValidateBackupTaskProperties struct {
+	Location            []string `mapstructure:"location"`
+	DeleteOrphanedFiles *bool    `mapstructure:"delete_orphaned_files"`
+	Parallel            *int64   `mapstructure:"parallel"`
+}
+
+// IsAdoptableScyllaDBManagerClientTask returns whether a task existing in ScyllaDB Manager state can be adopted by a ScyllaDBManagerTask.
+// Tasks already owned by a ScyllaDBManagerTask, built-in healthcheck tasks and one-off restore tasks are not adoptable.
+func IsAdoptableScyllaDBManagerClientTask(managerTask *managerclient.TaskListItem) bool {
+	_, hasOwnerUIDLabel := managerTask.Labels[naming.OwnerUIDLabel]
+	if hasOwnerUIDLabel {
+		return false
+	}
+
+	switch managerTask.Type {
+	case managerclient.BackupTask, managerclient.RepairTask, managerclient.ValidateBackupTask:
+		return true
+
+	default:
+		return false
+
+	}
+}
+
+// MakeAdoptingScyllaDBManagerTask makes a ScyllaDBManagerTask reflecting the provided task existing in ScyllaDB Manager state
+// and annotated for its adoption.
+func MakeAdoptingScyllaDBManagerTask(namespace string, scyllaDBClusterRef scyllav1alpha1.LocalScyllaDBReference, managerTask *managerclient.TaskListItem) (*scyllav1alpha1.ScyllaDBManagerTask, error) {
+	name, err := adoptingScyllaDBManagerTaskName(scyllaDBClusterRef.Name, managerTask.Type, managerTask.Name)
+	if err != nil {
+		return nil, fmt.Errorf("can't generate ScyllaDBManagerTask name: %w", err)
+	}
+
+	smt := &scyllav1alpha1.ScyllaDBManagerTask{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: scyllav1alpha1.GroupVersion.String(),
+			Kind:       "ScyllaDBManagerTask",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Annotations: map[string]string{
+				naming.ScyllaDBManagerTaskAdoptAnnotation: managerTask.Name,
+			},
+		},
+		Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+			ScyllaDBClusterRef: scyllaDBClusterRef,
+		},
+	}
+
+	schedule, err := makeScyllaDBManagerTaskSchedule(managerTask.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("can't make schedule: %w", err)
+	}
+
+	if managerTask.Schedule != nil && len(managerTask.Schedule.Cron) == 0 && len(managerTask.Schedule.Interval) != 0 {
+		// Legacy interval-based schedules can only be expressed with an override annotation.
+		smt.Annotations[naming.ScyllaDBManagerTaskScheduleIntervalOverrideAnnotation] = managerTask.Schedule.Interval
+	}
+
+	switch managerTask.Type {
+	case managerclient.BackupTask:
+		properties := &scyllaDBManagerClientBackupTaskProperties{}
+		err = decodeScyllaDBManagerClientTaskProperties(managerTask.Properties, properties)
+		if err != nil {
+			return nil, err
+		}
+
+		smt.Spec.Type = scyllav1alpha1.ScyllaDBManagerTaskTypeBackup
+		smt.Spec.Backup = &scyllav1alpha1.ScyllaDBManagerBackupTaskOptions{
+			ScyllaDBManagerTaskSchedule: schedule,
+			DC:                          properties.DC,
+			Keyspace:                    properties.Keyspace,
+			Location:                    properties.Location,
+			RateLimit:                   properties.RateLimit,
+			Retention:                   properties.Retention,
+			SnapshotParallel:            properties.SnapshotParallel,
+			UploadParallel:              properties.UploadParallel,
+		}
+
+	case managerclient.RepairTask:
+		properties := &scyllaDBManagerClientRepairTaskProperties{}
+		err = decodeScyllaDBManagerClientTaskProperties(managerTask.Properties, properties)
+		if err != nil {
+			return nil, err
+		}
+
+		smt.Spec.Type = scyllav1alpha1.ScyllaDBManagerTaskTypeRepair
+		smt.Spec.Repair = &scyllav1alpha1.ScyllaDBManagerRepairTaskOptions{
+			ScyllaDBManagerTaskSchedule: schedule,
+			DC:                          properties.DC,
+			Keyspace:                    properties.Keyspace,
+			FailFast:                    properties.FailFast,
+			Host:                        properties.Host,
+			IgnoreDownHosts:             properties.IgnoreDownHosts,
+			Parallel:                    properties.Parallel,
+		}
+
+		if properties.Intensity != nil {
+			if *properties.Intensity == math.Trunc(*properties.Intensity) {
+				smt.Spec.Repair.Intensity = pointer.Ptr(int64(*properties.Intensity))
+			} else {
+				// Fractional intensities can only be expressed with an override annotation.
+				smt.Annotations[naming.ScyllaDBManagerTaskRepairIntensityOverrideAnnotation] = strconv.FormatFloat(*properties.Intensity, 'f', -1, 64)
+			}
+		}
+
+		if properties.SmallTableThreshold != nil {
+			smt.Spec.Repair.SmallTableThreshold = resource.NewQuantity(*properties.SmallTableThreshold, resource.BinarySI)
+		}
+
+	case managerclient.ValidateBackupTask:
+		properties := &scyllaDBManagerClientValidateBackupTaskProperties{}
+		err = decodeScyllaDBManagerClientTaskProperties(managerTask.Properties, properties)
+		if err != nil {
+			return nil, err
+		}
+
+		smt.Spec.Type = scyllav1alpha1.ScyllaDBManagerTaskTypeValidateBackup
+		smt.Spec.ValidateBackup = &scyllav1alpha1.ScyllaDBManagerValidateBackupTaskOptions{
+			ScyllaDBManagerTaskSchedule: schedule,
+			Location:                    properties.Location,
+			DeleteOrphanedFiles:         properties.DeleteOrphanedFiles,
+			Parallel:                    properties.Parallel,
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported ScyllaDB Manager client task type: %q", managerTask.Type)
+
+	}
+
+	return smt, nil
+}
+
+func decodeScyllaDBManagerClientTaskProperties(properties any, out any) error {
+	if properties == nil {
+		return nil
+	}
+
+	err := mapstructure.Decode(properties, out)
+	if err != nil {
+		return fmt.Errorf("can't decode properties: %w", err)
+	}
+
+	return nil
+}
+
+func makeScyllaDBManagerTaskSchedule(managerClientSchedule *managerclient.Schedule) (scyllav1alpha1.ScyllaDBManagerTaskSchedule, error) {
+	schedule := scyllav1alpha1.ScyllaDBManagerTaskSchedule{}
+
+	if managerClientSchedule == nil {
+		return schedule, nil
+	}
+
+	if len(managerClientSchedule.Cron) != 0 {
+		schedule.Cron = pointer.Ptr(managerClientSchedule.Cron)
+
+		if len(managerClientSchedule.Timezone) != 0 {
+			schedule.Timezone = pointer.Ptr(managerClientSchedule.Timezone)
+		}
+	}
+
+	if managerClientSchedule.NumRetries != 0 {
+		schedule.NumRetries = pointer.Ptr(managerClientSchedule.NumRetries)
+	}
+
+	if len(managerClientSchedule.RetryWait) != 0 {
+		retryWait, err := time.ParseDuration(managerClientSchedule.RetryWait)
+		if err != nil {
+			return schedule, fmt.Errorf("can't parse retry wait: %w", err)
+		}
+
+		schedule.RetryWait = &metav1.Duration{Duration: retryWait}
+	}
+
+	if managerClientSchedule.StartDate != nil && !time.Time(*managerClientSchedule.StartDate).IsZero() {
+		schedule.StartDate = pointer.Ptr(metav1.NewTime(time.Time(*managerClientSchedule.StartDate)))
+	}
+
+	return schedule, nil
+}
+
+// adoptingScyllaDBManagerTaskName generates a valid object name from the name of a task in ScyllaDB Manager state,
+// which doesn't have to be a valid DNS subdomain.
+func adoptingScyllaDBManagerTaskName(scyllaDBClusterName string, managerClientTaskType string, managerClientTaskName string) (string, error) {
+	nameSuffix, err := naming.GenerateNameHash(scyllaDBClusterName, managerClientTaskType, managerClientTaskName)
+	if err != nil {
+		return "", fmt.Errorf("can't generate name hash: %w", err)
+	}
+
+	sanitizedTaskName := strings.Trim(invalidScyllaDBManagerTaskNameCharactersRe.ReplaceAllString(strings.ToLower(managerClientTaskName), "-"), "-")
+	fullName := strings.ToLower(fmt.Sprintf("%s-%s", scyllaDBClusterName, strings.ReplaceAll(managerClientTaskType, "_", "-")))
+	if len(sanitizedTaskName) != 0 {
+		fullName = fmt.Sprintf("%s-%s", fullName, sanitizedTaskName)
+	}
+
+	fullNameWithSuffix := fmt.Sprintf("%s-%s", fullName[:min(len(fullName), apimachineryutilvalidation.DNS1123SubdomainMaxLength-len(nameSuffix)-1)], nameSuffix)
+	return fullNameWithSuffix, nil
+}
@@ -0,0 +1,243 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbmanagertask
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMakeAdoptingScyllaDBManagerTask(t *testing.T) {
+	t.Parallel()
+
+	startDate := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	scyllaDBClusterRef := scyllav1alpha1.LocalScyllaDBReference{
+		Kind: "ScyllaDBDatacenter",
+		Name: "basic",
+	}
+
+	tt := []struct {
+		name        string
+		managerTask *managerclient.TaskListItem
+		expected    *scyllav1alpha1.ScyllaDBManagerTask
+		expectedErr bool
+	}{
+		{
+			name: "backup with cron schedule",
+			managerTask: &managerclient.TaskListItem{
+				ID:   "task-id",
+				Name: "Daily backup",
+				Type: managerclient.BackupTask,
+				Schedule: &managerclient.Schedule{
+					Cron:       "0 2 * * *",
+					Timezone:   "Europe/Warsaw",
+					NumRetries: 3,
+					RetryWait:  "10m0s",
+					StartDate:  pointer.Ptr(strfmt.DateTime(startDate)),
+				},
+				Properties: map[string]any{
+					"location":   []any{"s3:backups"},
+					"dc":         []any{"dc1"},
+					"retention":  float64(7),
+					"rate_limit": []any{"100"},
+				},
+			},
+			expected: &scyllav1alpha1.ScyllaDBManagerTask{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "scylla.scylladb.com/v1alpha1",
+					Kind:       "ScyllaDBManagerTask",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-backup-daily-backup-3ngll",
+					Namespace: "scylla",
+					Annotations: map[string]string{
+						"scylla-operator.scylladb.com/scylladb-manager-task-adopt": "Daily backup",
+					},
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllaDBClusterRef,
+					Type:               scyllav1alpha1.ScyllaDBManagerTaskTypeBackup,
+					Backup: &scyllav1alpha1.ScyllaDBManagerBackupTaskOptions{
+						ScyllaDBManagerTaskSchedule: scyllav1alpha1.ScyllaDBManagerTaskSchedule{
+							Cron:       pointer.Ptr("0 2 * * *"),
+							Timezone:   pointer.Ptr("Europe/Warsaw"),
+							NumRetries: pointer.Ptr[int64](3),
+							RetryWait:  &metav1.Duration{Duration: 10 * time.Minute},
+							StartDate:  pointer.Ptr(metav1.NewTime(startDate)),
+						},
+						DC:        []string{"dc1"},
+						Location:  []string{"s3:backups"},
+						RateLimit: []string{"100"},
+						Retention: pointer.Ptr[int64](7),
+					},
+				},
+			},
+		},
+		{
+			name: "repair with legacy interval and fractional intensity",
+			managerTask: &managerclient.TaskListItem{
+				ID:   "task-id",
+				Name: "all-weekly",
+				Type: managerclient.RepairTask,
+				Schedule: &managerclient.Schedule{
+					Interval: "7d",
+				},
+				Properties: map[string]any{
+					"keyspace":              []any{"ks"},
+					"intensity":             float64(0.5),
+					"parallel":              float64(2),
+					"small_table_threshold": float64(1073741824),
+				},
+			},
+			expected: &scyllav1alpha1.ScyllaDBManagerTask{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "scylla.scylladb.com/v1alpha1",
+					Kind:       "ScyllaDBManagerTask",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-repair-all-weekly-3nj0i",
+					Namespace: "scylla",
+					Annotations: map[string]string{
+						"scylla-operator.scylladb.com/scylladb-manager-task-adopt":                               "all-weekly",
+						"internal.scylla-operator.scylladb.com/scylladb-manager-task-schedule-interval-override": "7d",
+						"internal.scylla-operator.scylladb.com/scylladb-manager-task-repair-intensity-override":  "0.5",
+					},
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllaDBClusterRef,
+					Type:               scyllav1alpha1.ScyllaDBManagerTaskTypeRepair,
+					Repair: &scyllav1alpha1.ScyllaDBManagerRepairTaskOptions{
+						Keyspace:            []string{"ks"},
+						Parallel:            pointer.Ptr[int64](2),
+						SmallTableThreshold: resource.NewQuantity(1073741824, resource.BinarySI),
+					},
+				},
+			},
+		},
+		{
+			name: "validate backup",
+			managerTask: &managerclient.TaskListItem{
+				ID:   "task-id",
+				Name: "validate",
+				Type: managerclient.ValidateBackupTask,
+				Schedule: &managerclient.Schedule{
+					Cron: "@weekly",
+				},
+				Properties: map[string]any{
+					"location":              []any{"gcs:backups"},
+					"delete_orphaned_files": true,
+				},
+			},
+			expected: &scyllav1alpha1.ScyllaDBManagerTask{
+				TypeMeta: metav1.TypeMeta{
+					APIVersion: "scylla.scylladb.com/v1alpha1",
+					Kind:       "ScyllaDBManagerTask",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "basic-validate-backup-validate-1dk1y",
+					Namespace: "scylla",
+					Annotations: map[string]string{
+						"scylla-operator.scylladb.com/scylladb-manager-task-adopt": "validate",
+					},
+				},
+				Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+					ScyllaDBClusterRef: scyllaDBClusterRef,
+					Type:               scyllav1alpha1.ScyllaDBManagerTaskTypeValidateBackup,
+					ValidateBackup: &scyllav1alpha1.ScyllaDBManagerValidateBackupTaskOptions{
+						ScyllaDBManagerTaskSchedule: scyllav1alpha1.ScyllaDBManagerTaskSchedule{
+							Cron: pointer.Ptr("@weekly"),
+						},
+						Location:            []string{"gcs:backups"},
+						DeleteOrphanedFiles: pointer.Ptr(true),
+					},
+				},
+			},
+		},
+		{
+			name: "unsupported healthcheck",
+			managerTask: &managerclient.TaskListItem{
+				ID:   "task-id",
+				Name: "cql",
+				Type: managerclient.HealthCheckTask,
+			},
+			expected:    nil,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := MakeAdoptingScyllaDBManagerTask("scylla", scyllaDBClusterRef, tc.managerTask)
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("expected error: %t, got %v", tc.expectedErr, err)
+			}
+
+			if !cmp.Equal(got, tc.expected) {
+				t.Errorf("expected and got ScyllaDBManagerTasks differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func TestIsAdoptableScyllaDBManagerClientTask(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name        string
+		managerTask *managerclient.TaskListItem
+		expected    bool
+	}{
+		{
+			name: "backup task without owner",
+			managerTask: &managerclient.TaskListItem{
+				Type: managerclient.BackupTask,
+			},
+			expected: true,
+		},
+		{
+			name: "repair task owned by a ScyllaDBManagerTask",
+			managerTask: &managerclient.TaskListItem{
+				Type: managerclient.RepairTask,
+				Labels: map[string]string{
+					"scylla-operator.scylladb.com/owner-uid": "uid",
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "healthcheck task",
+			managerTask: &managerclient.TaskListItem{
+				Type: managerclient.HealthCheckTask,
+			},
+			expected: false,
+		},
+		{
+			name: "restore task",
+			managerTask: &managerclient.TaskListItem{
+				Type: managerclient.RestoreTask,
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := IsAdoptableScyllaDBManagerClientTask(tc.managerTask)
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
@@ -123,6 +123,14 @@ func (smtc *Controller) sync(ctx context.Context, key string) error {
 		errs = append(errs, fmt.Errorf("can't update status: %w", err))
 	}
 
+	// ScyllaDB Manager state isn't observable, poll for the progress of the running task
+	// or refresh the status once the next run is due.
+	if isScyllaDBManagerTaskRunInProgress(status.LastRun) || isOnDemandRunAwaitingStart(status.OnDemandRun) {
+		smtc.queue.AddAfter(key, taskRunProgressPollInterval)
+	} else if status.NextActivation != nil {
+		smtc.queue.AddAfter(key, max(time.Until(status.NextActivation.Time), taskRunProgressPollInterval))
+	}
+
 	return apimachineryutilerrors.NewAggregate(errs)
 }
 
@@ -67,6 +67,20 @@ func (smtc *Controller) syncFinalizer(ctx context.Context, smt *scyllav1alpha1.S
 		return progressingConditions, fmt.Errorf("can't get ScyllaDB Manager client: %w", err)
 	}
 
+	if smt.Spec.Type == scyllav1alpha1.ScyllaDBManagerTaskTypeHealthcheck {
+		err = resetHealthcheckTask(ctx, smt, managerClient, clusterID)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't reset ScyllaDB Manager healthcheck task: %w", err)
+		}
+
+		err = smtc.removeFinalizer(ctx, smt)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't remove finalizer: %w", err)
+		}
+
+		return progressingConditions, nil
+	}
+
 	managerTask, found, err := getScyllaDBManagerClientTask(ctx, smt, clusterID, managerClient)
 	if err != nil {
 		return progressingConditions, fmt.Errorf("can't get ScyllaDB Manager client task: %w", err)
@@ -0,0 +1,201 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbmanagertask
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/helpers/managerclienterrors"
+	"github.com/scylladb/scylla-operator/pkg/util/duration"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	cronEveryPrefix = "@every "
+)
+
+// defaultHealthcheckIntervals reflect the intervals that ScyllaDB Manager creates healthcheck tasks with.
+var defaultHealthcheckIntervals = map[scyllav1alpha1.ScyllaDBManagerHealthcheckMode]time.Duration{
+	scyllav1alpha1.ScyllaDBManagerHealthcheckModeCQL:        15 * time.Second,
+	scyllav1alpha1.ScyllaDBManagerHealthcheckModeREST:       time.Minute,
+	scyllav1alpha1.ScyllaDBManagerHealthcheckModeAlternator: 15 * time.Second,
+}
+
+func syncHealthcheckTask(
+	ctx context.Context,
+	smt *scyllav1alpha1.ScyllaDBManagerTask,
+	status *scyllav1alpha1.ScyllaDBManagerTaskStatus,
+	managerClient *managerclient.Client,
+	clusterID string,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	// Healthchecks run every few seconds, their runs aren't worth reflecting.
+	status.NextActivation = nil
+	status.NextActivationInTimezone = nil
+	status.LastRun = nil
+	status.Backup = nil
+
+	managerTask, found, err := getScyllaDBManagerClientHealthcheckTask(ctx, smt.Spec.Healthcheck.Mode, managerClient, clusterID)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't get ScyllaDB Manager healthcheck task: %w", err)
+	}
+
+	if !found {
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               managerControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: smt.Generation,
+			Reason:             "AwaitingScyllaDBManagerHealthcheckTaskCreation",
+			Message:            fmt.Sprintf("Awaiting ScyllaDB Manager to create the %q healthcheck task.", smt.Spec.Healthcheck.Mode),
+		})
+
+		return progressingConditions, nil
+	}
+
+	status.TaskID = &managerTask.ID
+
+	interval, ok := getScyllaDBManagerClientScheduleInterval(managerTask.Schedule)
+	if ok && interval == smt.Spec.Healthcheck.Interval.Duration {
+		return progressingConditions, nil
+	}
+
+	err = updateHealthcheckTaskInterval(ctx, managerClient, clusterID, managerTask, smt.Spec.Healthcheck.Interval.Duration)
+	if err != nil {
+		return progressingConditions, err
+	}
+
+	progressingConditions = append(progressingConditions, metav1.Condition{
+		Type:               managerControllerProgressingCondition,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: smt.Generation,
+		Reason:             "UpdatedScyllaDBManagerHealthcheckTask",
+		Message:            fmt.Sprintf("Updated the interval of ScyllaDB Manager healthcheck task %s (%s) to %s.", managerTask.Name, managerTask.ID, smt.Spec.Healthcheck.Interval.Duration),
+	})
+
+	return progressingConditions, nil
+}
+
+// resetHealthcheckTask restores the default interval of the healthcheck task configured by the ScyllaDBManagerTask.
+func resetHealthcheckTask(ctx context.Context, smt *scyllav1alpha1.ScyllaDBManagerTask, managerClient *managerclient.Client, clusterID string) error {
+	defaultInterval, ok := defaultHealthcheckIntervals[smt.Spec.Healthcheck.Mode]
+	if !ok {
+		return fmt.Errorf("unsupported healthcheck mode: %q", smt.Spec.Healthcheck.Mode)
+	}
+
+	managerTask, found, err := getScyllaDBManagerClientHealthcheckTask(ctx, smt.Spec.Healthcheck.Mode, managerClient, clusterID)
+	if err != nil {
+		return fmt.Errorf("can't get ScyllaDB Manager healthcheck task: %w", err)
+	}
+
+	if !found {
+		klog.V(4).InfoS("ScyllaDB Manager healthcheck task doesn't exist, nothing to reset.", "ScyllaDBManagerTask", klog.KObj(smt), "ScyllaDBManagerClientClusterID", clusterID)
+		return nil
+	}
+
+	interval, ok := getScyllaDBManagerClientScheduleInterval(managerTask.Schedule)
+	if ok && interval == defaultInterval {
+		return nil
+	}
+
+	return updateHealthcheckTaskInterval(ctx, managerClient, clusterID, managerTask, defaultInterval)
+}
+
+func getScyllaDBManagerClientHealthcheckTask(ctx context.Context, mode scyllav1alpha1.ScyllaDBManagerHealthcheckMode, managerClient *managerclient.Client, clusterID string) (*managerclient.TaskListItem, bool, error) {
+	tasks, err := managerClient.ListTasks(ctx, clusterID, managerclient.HealthCheckTask, true, "", "")
+	if err != nil {
+		klog.V(4).InfoS("Failed to list ScyllaDB Manager client healthcheck tasks.", "ScyllaDBManagerClientClusterID", clusterID, "Error", err)
+		return nil, false, fmt.Errorf("can't list ScyllaDB Manager client healthcheck tasks: %s", managerclienterrors.GetPayloadMessage(err))
+	}
+
+	idx := slices.IndexFunc(tasks.TaskListItemSlice, func(item *managerclient.TaskListItem) bool {
+		return getScyllaDBManagerClientHealthcheckTaskMode(item.Properties) == strings.ToLower(string(mode))
+	})
+	if idx < 0 {
+		return nil, false, nil
+	}
+
+	return tasks.TaskListItemSlice[idx], true, nil
+}
+
+func getScyllaDBManagerClientHealthcheckTaskMode(properties any) string {
+	propertiesMap, ok := properties.(map[string]any)
+	if !ok {
+		return ""
+	}
+
+	mode, ok := propertiesMap["mode"].(string)
+	if !ok {
+		return ""
+	}
+
+	return mode
+}
+
+// getScyllaDBManagerClientScheduleInterval returns the interval of a schedule defined either with an "@every" cron macro or a legacy interval.
+func getScyllaDBManagerClientScheduleInterval(schedule *managerclient.Schedule) (time.Duration, bool) {
+	if schedule == nil {
+		return 0, false
+	}
+
+	if len(schedule.Cron) != 0 {
+		every, ok := strings.CutPrefix(schedule.Cron, cronEveryPrefix)
+		if !ok {
+			return 0, false
+		}
+
+		interval, err := time.ParseDuration(every)
+		if err != nil {
+			return 0, false
+		}
+
+		return interval, true
+	}
+
+	if len(schedule.Interval) != 0 {
+		interval, err := duration.ParseDuration(schedule.Interval)
+		if err != nil {
+			return 0, false
+		}
+
+		return interval.Duration(), true
+	}
+
+	return 0, false
+}
+
+func updateHealthcheckTaskInterval(ctx context.Context, managerClient *managerclient.Client, clusterID string, managerTaskListItem *managerclient.TaskListItem, interval time.Duration) error {
+	managerTaskID, err := uuid.Parse(managerTaskListItem.ID)
+	if err != nil {
+		return fmt.Errorf("can't parse ScyllaDB Manager client task ID %q: %w", managerTaskListItem.ID, err)
+	}
+
+	managerTask, err := managerClient.GetTask(ctx, clusterID, managerclient.HealthCheckTask, managerTaskID)
+	if err != nil {
+		klog.V(4).InfoS("Failed to get ScyllaDB Manager client healthcheck task.", "ScyllaDBManagerClientClusterID", clusterID, "ScyllaDBManagerClientTaskID", managerTaskListItem.ID, "Error", err)
+		return fmt.Errorf("can't get ScyllaDB Manager client healthcheck task %q: %s", managerTaskListItem.ID, managerclienterrors.GetPayloadMessage(err))
+	}
+
+	if managerTask.Schedule == nil {
+		managerTask.Schedule = &managerclient.Schedule{}
+	}
+	managerTask.Schedule.Cron = cronEveryPrefix + interval.String()
+	managerTask.Schedule.Interval = ""
+
+	klog.V(2).InfoS("Updating ScyllaDB Manager client healthcheck task.", "ScyllaDBManagerClientClusterID", clusterID, "ScyllaDBManagerClientTaskName", managerTask.Name, "ScyllaDBManagerClientTaskID", managerTask.ID, "Interval", interval)
+	err = managerClient.UpdateTask(ctx, clusterID, managerTask)
+	if err != nil {
+		klog.V(4).InfoS("Failed to update ScyllaDB Manager client healthcheck task.", "ScyllaDBManagerClientClusterID", clusterID, "ScyllaDBManagerClientTaskName", managerTask.Name, "ScyllaDBManagerClientTaskID", managerTask.ID, "Error", err)
+		return fmt.Errorf("can't update ScyllaDB Manager client healthcheck task %q: %s", managerTask.Name, managerclienterrors.GetPayloadMessage(err))
+	}
+
+	return nil
+}
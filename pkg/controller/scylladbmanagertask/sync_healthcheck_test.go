@@ -0,0 +1,116 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbmanagertask
+
+import (
+	"testing"
+	"time"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+)
+
+func Test_getScyllaDBManagerClientScheduleInterval(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name             string
+		schedule         *managerclient.Schedule
+		expectedInterval time.Duration
+		expectedOK       bool
+	}{
+		{
+			name:             "nil schedule",
+			schedule:         nil,
+			expectedInterval: 0,
+			expectedOK:       false,
+		},
+		{
+			name: "every cron macro",
+			schedule: &managerclient.Schedule{
+				Cron: "@every 15s",
+			},
+			expectedInterval: 15 * time.Second,
+			expectedOK:       true,
+		},
+		{
+			name: "every cron macro with a compound duration",
+			schedule: &managerclient.Schedule{
+				Cron: "@every 1m30s",
+			},
+			expectedInterval: 90 * time.Second,
+			expectedOK:       true,
+		},
+		{
+			name: "standard cron",
+			schedule: &managerclient.Schedule{
+				Cron: "*/15 * * * *",
+			},
+			expectedInterval: 0,
+			expectedOK:       false,
+		},
+		{
+			name: "legacy interval",
+			schedule: &managerclient.Schedule{
+				Interval: "1m",
+			},
+			expectedInterval: time.Minute,
+			expectedOK:       true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotInterval, gotOK := getScyllaDBManagerClientScheduleInterval(tc.schedule)
+			if gotOK != tc.expectedOK {
+				t.Errorf("expected ok %t, got %t", tc.expectedOK, gotOK)
+			}
+
+			if gotInterval != tc.expectedInterval {
+				t.Errorf("expected interval %v, got %v", tc.expectedInterval, gotInterval)
+			}
+		})
+	}
+}
+
+func Test_getScyllaDBManagerClientHealthcheckTaskMode(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name       string
+		properties any
+		expected   string
+	}{
+		{
+			name:       "nil properties",
+			properties: nil,
+			expected:   "",
+		},
+		{
+			name: "properties with mode",
+			properties: map[string]any{
+				"mode": "alternator",
+			},
+			expected: "alternator",
+		},
+		{
+			name: "properties without mode",
+			properties: map[string]any{
+				"location": "gcs:test",
+			},
+			expected: "",
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getScyllaDBManagerClientHealthcheckTaskMode(tc.properties)
+			if got != tc.expected {
+				t.Errorf("expected mode %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -84,6 +84,11 @@ func (smtc *Controller) syncManager(
 		return progressingConditions, fmt.Errorf("can't get manager client: %w", err)
 	}
 
+	if smt.Spec.Type == scyllav1alpha1.ScyllaDBManagerTaskTypeHealthcheck {
+		// Healthcheck tasks are created by ScyllaDB Manager for every registered cluster, so they can only be reconfigured.
+		return syncHealthcheckTask(ctx, smt, status, managerClient, clusterID)
+	}
+
 	managerTask, found, err := getScyllaDBManagerClientTask(ctx, smt, clusterID, managerClient)
 	if err != nil {
 		return progressingConditions, fmt.Errorf("can't get ScyllaDB Manager task: %w", err)
@@ -112,10 +117,16 @@ func (smtc *Controller) syncManager(
 
 	ownerUIDLabelValue, hasOwnerUIDLabel := managerTask.Labels[naming.OwnerUIDLabel]
 	_, hasMissingOwnerUIDForceAdoptAnnotation := smt.Annotations[naming.ScyllaDBManagerTaskMissingOwnerUIDForceAdoptAnnotation]
+	_, hasAdoptAnnotation := smt.Annotations[naming.ScyllaDBManagerTaskAdoptAnnotation]
+	isAdopting := false
 	if !hasOwnerUIDLabel && hasMissingOwnerUIDForceAdoptAnnotation {
 		// The task could have been created by the legacy component (manager-controller), in which case it does not have the owner UID label.
 		// For backward compatibility, we adopt it instead of recreating it if the internal annotation forcing adoption is set.
 		klog.Warningf("Task %q (%q) already exists in ScyllaDB Manager state with no owner UID label. ScyllaDBManagerTask %q will adopt it as it has the annotation forcing its adoption: %q.", managerTask.Name, managerTask.ID, klog.KObj(smt), naming.ScyllaDBManagerTaskMissingOwnerUIDForceAdoptAnnotation)
+	} else if !hasOwnerUIDLabel && hasAdoptAnnotation {
+		// The task has been created outside of the operator, e.g. manually with sctool, and the user requested its adoption.
+		klog.InfoS("Adopting a pre-existing ScyllaDB Manager client task.", "ScyllaDBManagerTask", klog.KObj(smt), "ScyllaDBManagerClientClusterID", clusterID, "ScyllaDBManagerClientTaskType", managerTask.Type, "ScyllaDBManagerClientTaskName", managerTask.Name, "ScyllaDBManagerClientTaskID", managerTask.ID)
+		isAdopting = true
 	} else if !hasOwnerUIDLabel {
 		var missingOwnerUIDProgressingConditions []metav1.Condition
 		missingOwnerUIDProgressingConditions, err = smtc.syncManagerClientTaskMissingOwnerUID(ctx, smt, managerClient, clusterID, managerTask)
@@ -135,13 +146,26 @@ func (smtc *Controller) syncManager(
 
 	status.TaskID = &managerTask.ID
 
+	taskRunProgressingConditions, err := syncTaskRunStatus(ctx, smt, status, managerClient, clusterID, managerTask)
+	progressingConditions = append(progressingConditions, taskRunProgressingConditions...)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't sync task run status: %w", err)
+	}
+
 	err = smtc.syncScyllaV1TaskStatusAnnotation(ctx, smt, managerTask)
 	if err != nil {
 		return progressingConditions, fmt.Errorf("can't sync scyllav1 task status annotation for ScyllaDBManagerTask %q: %w", naming.ObjRef(smt), err)
 	}
 
 	if ownerUIDLabelValue == string(smt.UID) && requiredManagerTask.Labels[naming.ManagedHash] == managerTask.Labels[naming.ManagedHash] {
-		// Cluster matches the desired state, nothing to do.
+		// Task matches the desired state, only on-demand runs are left to handle.
+		var runNowProgressingConditions []metav1.Condition
+		runNowProgressingConditions, err = syncRunNow(ctx, smt, status, managerClient, clusterID, managerTask)
+		progressingConditions = append(progressingConditions, runNowProgressingConditions...)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't sync on-demand run: %w", err)
+		}
+
 		return progressingConditions, nil
 	}
 
@@ -161,6 +185,18 @@ func (smtc *Controller) syncManager(
 		return progressingConditions, fmt.Errorf("can't update ScyllaDB Manager client task %q: %s", requiredManagerTask.Name, managerclienterrors.GetPayloadMessage(err))
 	}
 
+	if isAdopting {
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               managerControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: smt.Generation,
+			Reason:             "AdoptedScyllaDBManagerTask",
+			Message:            fmt.Sprintf("Adopted a pre-existing ScyllaDB Manager task: %s (%s).", managerTask.Name, managerTask.ID),
+		})
+
+		return progressingConditions, nil
+	}
+
 	progressingConditions = append(progressingConditions, metav1.Condition{
 		Type:               managerControllerProgressingCondition,
 		Status:             metav1.ConditionTrue,
@@ -377,6 +413,32 @@ func makeScyllaDBManagerClientTaskWithManagedHashFunc(smt *scyllav1alpha1.Scylla
 			return nil, fmt.Errorf("can't make ScyllaDB Manager client repair task properties: %w", err)
 		}
 
+	case scyllav1alpha1.ScyllaDBManagerTaskTypeRestore:
+		managerClientTaskType = managerclient.RestoreTask
+
+		managerClientTaskSchedule, err = makeScyllaDBManagerClientSchedule(&smt.Spec.Restore.ScyllaDBManagerTaskSchedule, scheduleOverrideOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("can't make ScyllaDB Manager client schedule: %w", err)
+		}
+
+		managerClientTaskProperties, err = makeScyllaDBManagerClientRestoreTaskProperties(smt.Spec.Restore)
+		if err != nil {
+			return nil, fmt.Errorf("can't make ScyllaDB Manager client restore task properties: %w", err)
+		}
+
+	case scyllav1alpha1.ScyllaDBManagerTaskTypeValidateBackup:
+		managerClientTaskType = managerclient.ValidateBackupTask
+
+		managerClientTaskSchedule, err = makeScyllaDBManagerClientSchedule(&smt.Spec.ValidateBackup.ScyllaDBManagerTaskSchedule, scheduleOverrideOptions...)
+		if err != nil {
+			return nil, fmt.Errorf("can't make ScyllaDB Manager client schedule: %w", err)
+		}
+
+		managerClientTaskProperties, err = makeScyllaDBManagerClientValidateBackupTaskProperties(smt.Spec.ValidateBackup)
+		if err != nil {
+			return nil, fmt.Errorf("can't make ScyllaDB Manager client validate backup task properties: %w", err)
+		}
+
 	default:
 		return nil, fmt.Errorf("unsupported ScyllaDBManagerTaskType: %q", smt.Spec.Type)
 
@@ -475,6 +537,10 @@ func makeScyllaDBManagerClientSchedule(scyllaDBManagerTaskSchedule *scyllav1alph
 		managerClientSchedule.Cron = *scyllaDBManagerTaskSchedule.Cron
 	}
 
+	if scyllaDBManagerTaskSchedule.Timezone != nil {
+		managerClientSchedule.Timezone = *scyllaDBManagerTaskSchedule.Timezone
+	}
+
 	if scyllaDBManagerTaskSchedule.StartDate != nil {
 		managerClientSchedule.StartDate = pointer.Ptr(strfmt.DateTime(scyllaDBManagerTaskSchedule.StartDate.Time))
 	}
@@ -645,6 +711,51 @@ func makeScyllaDBManagerClientRepairTaskProperties(options *scyllav1alpha1.Scyll
 	return managerClientTaskProperties, nil
 }
 
+func makeScyllaDBManagerClientRestoreTaskProperties(options *scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions) (map[string]any, error) {
+	managerClientTaskProperties := map[string]any{
+		"location":     options.Location,
+		"snapshot_tag": options.SnapshotTag,
+	}
+
+	if options.Keyspace != nil {
+		managerClientTaskProperties["keyspace"] = unescapeFilters(options.Keyspace)
+	}
+
+	if options.RestoreSchema != nil {
+		managerClientTaskProperties["restore_schema"] = *options.RestoreSchema
+	}
+
+	if options.RestoreTables != nil {
+		managerClientTaskProperties["restore_tables"] = *options.RestoreTables
+	}
+
+	if options.BatchSize != nil {
+		managerClientTaskProperties["batch_size"] = *options.BatchSize
+	}
+
+	if options.Parallel != nil {
+		managerClientTaskProperties["parallel"] = *options.Parallel
+	}
+
+	return managerClientTaskProperties, nil
+}
+
+func makeScyllaDBManagerClientValidateBackupTaskProperties(options *scyllav1alpha1.ScyllaDBManagerValidateBackupTaskOptions) (map[string]any, error) {
+	managerClientTaskProperties := map[string]any{
+		"location": options.Location,
+	}
+
+	if options.DeleteOrphanedFiles != nil {
+		managerClientTaskProperties["delete_orphaned_files"] = *options.DeleteOrphanedFiles
+	}
+
+	if options.Parallel != nil {
+		managerClientTaskProperties["parallel"] = *options.Parallel
+	}
+
+	return managerClientTaskProperties, nil
+}
+
 // unescapeFilters handles escaping bash expansions.
 // '\' can be removed safely as it's not a valid character in the keyspace or table names.
 func unescapeFilters(strs []string) []string {
@@ -663,6 +774,15 @@ func scyllaDBManagerClientTaskType(smt *scyllav1alpha1.ScyllaDBManagerTask) (str
 	case scyllav1alpha1.ScyllaDBManagerTaskTypeRepair:
 		return managerclient.RepairTask, nil
 
+	case scyllav1alpha1.ScyllaDBManagerTaskTypeRestore:
+		return managerclient.RestoreTask, nil
+
+	case scyllav1alpha1.ScyllaDBManagerTaskTypeValidateBackup:
+		return managerclient.ValidateBackupTask, nil
+
+	case scyllav1alpha1.ScyllaDBManagerTaskTypeHealthcheck:
+		return managerclient.HealthCheckTask, nil
+
 	default:
 		return "", fmt.Errorf("unsupported ScyllaDBManagerTask type: %q", smt.Spec.Type)
 
@@ -675,10 +795,21 @@ func scyllaDBManagerClientTaskName(smt *scyllav1alpha1.ScyllaDBManagerTask) stri
 		return nameOverrideAnnotationValue
 	}
 
+	adoptAnnotationValue, hasAdoptAnnotation := smt.Annotations[naming.ScyllaDBManagerTaskAdoptAnnotation]
+	if hasAdoptAnnotation {
+		// Adopted tasks retain their names in ScyllaDB Manager state.
+		return adoptAnnotationValue
+	}
+
 	return smt.Name
 }
 
 func (smtc *Controller) syncScyllaV1TaskStatusAnnotation(ctx context.Context, smt *scyllav1alpha1.ScyllaDBManagerTask, managerClientTask *managerclient.TaskListItem) error {
+	if managerClientTask.Type != managerclient.BackupTask && managerClientTask.Type != managerclient.RepairTask {
+		// scyllav1.ScyllaCluster only reflects backup and repair tasks.
+		return nil
+	}
+
 	scyllaV1TaskStatusAnnotationValue, err := makeScyllaV1TaskStatusAnnotationValue(managerClientTask)
 	if err != nil {
 		return fmt.Errorf("can't make scyllav1 task status annotation value: %w", err)
@@ -114,6 +114,75 @@ func Test_makeRequiredScyllaDBManagerClientTask(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		{
+			name:            "basic restore",
+			smt:             newRestoreScyllaDBManagerTaskWithScyllaDBDatacenterRef(),
+			clusterID:       "cluster-id",
+			overrideOptions: nil,
+			expected: &managerclient.Task{
+				ClusterID: "cluster-id",
+				Enabled:   true,
+				ID:        "",
+				Labels: map[string]string{
+					"scylla-operator.scylladb.com/managed-hash": "S94hXp8bzHXX3WuP916dLg6NEq+Ft41zcoKk7lNQ+oM7eX2OZFGiSEbJ2XEqFN+ijZxRyX0Wl/USID/fiGIqLQ==",
+					"scylla-operator.scylladb.com/owner-uid":    "uid",
+				},
+				Name: "restore",
+				Properties: map[string]any{
+					"location":       []string{"gcs:test"},
+					"snapshot_tag":   "sm_20250101000000UTC",
+					"keyspace":       []string{"keyspace", "!keyspace.table_prefix_*"},
+					"restore_tables": true,
+					"batch_size":     int64(2),
+					"parallel":       int64(1),
+				},
+				Schedule: &managerclient.Schedule{
+					Cron:       "",
+					Interval:   "",
+					NumRetries: 3,
+					RetryWait:  "1m0s",
+					StartDate:  pointer.Ptr(strfmt.DateTime(validTime)),
+					Timezone:   "",
+					Window:     nil,
+				},
+				Tags: nil,
+				Type: "restore",
+			},
+			expectedErr: nil,
+		},
+		{
+			name:            "basic validate backup",
+			smt:             newValidateBackupScyllaDBManagerTaskWithScyllaDBDatacenterRef(),
+			clusterID:       "cluster-id",
+			overrideOptions: nil,
+			expected: &managerclient.Task{
+				ClusterID: "cluster-id",
+				Enabled:   true,
+				ID:        "",
+				Labels: map[string]string{
+					"scylla-operator.scylladb.com/managed-hash": "hcq9dHZpLK9ymR4KqkIyLhfTe2Hgl9SVZySqrd4P8Ur74n651EKGnE8zH+4yMJsSoHvDbu4RBZPnsCMkVX9Rfg==",
+					"scylla-operator.scylladb.com/owner-uid":    "uid",
+				},
+				Name: "validate-backup",
+				Properties: map[string]any{
+					"location":              []string{"gcs:test"},
+					"delete_orphaned_files": true,
+					"parallel":              int64(1),
+				},
+				Schedule: &managerclient.Schedule{
+					Cron:       "0 23 * * SAT",
+					Interval:   "",
+					NumRetries: 3,
+					RetryWait:  "1m0s",
+					StartDate:  pointer.Ptr(strfmt.DateTime(validTime)),
+					Timezone:   "",
+					Window:     nil,
+				},
+				Tags: nil,
+				Type: "validate_backup",
+			},
+			expectedErr: nil,
+		},
 	}
 
 	for _, tc := range tt {
@@ -263,6 +332,50 @@ func Test_makeRequiredScyllaDBManagerClientTaskWithManagedHashFunc(t *testing.T)
 			},
 			expectedErr: nil,
 		},
+		{
+			name: "backup, sdc ref, with adopt annotation",
+			smt: func() *scyllav1alpha1.ScyllaDBManagerTask {
+				smt := newBackupScyllaDBManagerTaskWithScyllaDBDatacenterRef()
+
+				metav1.SetMetaDataAnnotation(&smt.ObjectMeta, naming.ScyllaDBManagerTaskAdoptAnnotation, "existing")
+
+				return smt
+			}(),
+			clusterID:       "cluster-id",
+			managedHashFunc: getMockManagedHash,
+			overrideOptions: nil,
+			expected: &managerclient.Task{
+				ClusterID: "cluster-id",
+				Enabled:   true,
+				ID:        "",
+				Labels: map[string]string{
+					"scylla-operator.scylladb.com/managed-hash": mockManagedHash,
+					"scylla-operator.scylladb.com/owner-uid":    "uid",
+				},
+				Name: "existing",
+				Properties: map[string]any{
+					"dc":                []string{"dc1", "!otherdc*"},
+					"keyspace":          []string{"keyspace", "!keyspace.table_prefix_*"},
+					"location":          []string{"gcs:test"},
+					"rate_limit":        []string{"dc1:1", "2"},
+					"retention":         pointer.Ptr[int64](3),
+					"snapshot_parallel": []string{"dc1:2", "3"},
+					"upload_parallel":   []string{"dc1:3", "4"},
+				},
+				Schedule: &managerclient.Schedule{
+					Cron:       "0 23 * * SAT",
+					Interval:   "",
+					NumRetries: 3,
+					RetryWait:  "1m0s",
+					StartDate:  pointer.Ptr(strfmt.DateTime(validTime)),
+					Timezone:   "",
+					Window:     nil,
+				},
+				Tags: nil,
+				Type: "backup",
+			},
+			expectedErr: nil,
+		},
 		{
 			name: "backup, sdc ref, with interval override annotation",
 			smt: func() *scyllav1alpha1.ScyllaDBManagerTask {
@@ -1284,6 +1397,68 @@ func newRepairScyllaDBManagerTaskWithScyllaDBDatacenterRef() *scyllav1alpha1.Scy
 	}
 }
 
+func newRestoreScyllaDBManagerTaskWithScyllaDBDatacenterRef() *scyllav1alpha1.ScyllaDBManagerTask {
+	return &scyllav1alpha1.ScyllaDBManagerTask{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "restore",
+			Namespace: "scylla",
+			UID:       "uid",
+		},
+		Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+			ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+				Kind: scyllav1alpha1.ScyllaDBDatacenterGVK.Kind,
+				Name: "basic",
+			},
+			Type: scyllav1alpha1.ScyllaDBManagerTaskTypeRestore,
+			Restore: &scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions{
+				ScyllaDBManagerTaskSchedule: scyllav1alpha1.ScyllaDBManagerTaskSchedule{
+					NumRetries: pointer.Ptr[int64](3),
+					RetryWait: &metav1.Duration{
+						Duration: 1 * time.Minute,
+					},
+					StartDate: pointer.Ptr(metav1.NewTime(validTime)),
+				},
+				Location:      []string{"gcs:test"},
+				SnapshotTag:   "sm_20250101000000UTC",
+				Keyspace:      []string{"keyspace", "!keyspace.table_prefix_*"},
+				RestoreTables: pointer.Ptr(true),
+				BatchSize:     pointer.Ptr[int64](2),
+				Parallel:      pointer.Ptr[int64](1),
+			},
+		},
+	}
+}
+
+func newValidateBackupScyllaDBManagerTaskWithScyllaDBDatacenterRef() *scyllav1alpha1.ScyllaDBManagerTask {
+	return &scyllav1alpha1.ScyllaDBManagerTask{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "validate-backup",
+			Namespace: "scylla",
+			UID:       "uid",
+		},
+		Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+			ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+				Kind: scyllav1alpha1.ScyllaDBDatacenterGVK.Kind,
+				Name: "basic",
+			},
+			Type: scyllav1alpha1.ScyllaDBManagerTaskTypeValidateBackup,
+			ValidateBackup: &scyllav1alpha1.ScyllaDBManagerValidateBackupTaskOptions{
+				ScyllaDBManagerTaskSchedule: scyllav1alpha1.ScyllaDBManagerTaskSchedule{
+					Cron:       pointer.Ptr("0 23 * * SAT"),
+					NumRetries: pointer.Ptr[int64](3),
+					RetryWait: &metav1.Duration{
+						Duration: 1 * time.Minute,
+					},
+					StartDate: pointer.Ptr(metav1.NewTime(validTime)),
+				},
+				Location:            []string{"gcs:test"},
+				DeleteOrphanedFiles: pointer.Ptr(true),
+				Parallel:            pointer.Ptr[int64](1),
+			},
+		},
+	}
+}
+
 func Test_parseByteCount(t *testing.T) {
 	t.Parallel()
 
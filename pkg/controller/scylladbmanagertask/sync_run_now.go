@@ -0,0 +1,124 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbmanagertask
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/v3/pkg/util/uuid"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/helpers/managerclienterrors"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// syncRunNow starts a run of the task in ScyllaDB Manager for every new value of the run-now annotation.
+// The schedule of the task is left intact.
+func syncRunNow(
+	ctx context.Context,
+	smt *scyllav1alpha1.ScyllaDBManagerTask,
+	status *scyllav1alpha1.ScyllaDBManagerTaskStatus,
+	managerClient *managerclient.Client,
+	clusterID string,
+	managerTask *managerclient.TaskListItem,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if status.OnDemandRun != nil && status.OnDemandRun.RunID == nil && status.LastRun != nil {
+		status.OnDemandRun.RunID = getOnDemandRunID(status.OnDemandRun, status.LastRun.ID)
+	}
+
+	request, ok := smt.Annotations[naming.ScyllaDBManagerTaskRunNowAnnotation]
+	if !ok || len(request) == 0 {
+		return progressingConditions, nil
+	}
+
+	if status.OnDemandRun != nil && status.OnDemandRun.Request == request {
+		if isOnDemandRunAwaitingStart(status.OnDemandRun) {
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               managerControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: smt.Generation,
+				Reason:             "AwaitingOnDemandRunStart",
+				Message:            fmt.Sprintf("Awaiting the start of the on-demand run requested with %q.", request),
+			})
+		}
+
+		return progressingConditions, nil
+	}
+
+	if isScyllaDBManagerTaskRunInProgress(status.LastRun) {
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               managerControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: smt.Generation,
+			Reason:             "AwaitingRunCompletion",
+			Message:            fmt.Sprintf("Awaiting the completion of run %q before starting the on-demand run requested with %q.", *status.LastRun.ID, request),
+		})
+
+		return progressingConditions, nil
+	}
+
+	managerTaskID, err := uuid.Parse(managerTask.ID)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't parse ScyllaDB Manager client task ID %q: %w", managerTask.ID, err)
+	}
+
+	klog.V(2).InfoS("Starting an on-demand run of ScyllaDB Manager client task.", "ScyllaDBManagerTask", klog.KObj(smt), "ScyllaDBManagerClientClusterID", clusterID, "ScyllaDBManagerClientTaskType", managerTask.Type, "ScyllaDBManagerClientTaskID", managerTask.ID, "Request", request)
+	triggerTime := time.Now()
+	err = managerClient.StartTask(ctx, clusterID, managerTask.Type, managerTaskID, false)
+	if err != nil {
+		klog.V(4).InfoS("Failed to start ScyllaDB Manager client task.", "ScyllaDBManagerTask", klog.KObj(smt), "ScyllaDBManagerClientClusterID", clusterID, "ScyllaDBManagerClientTaskType", managerTask.Type, "ScyllaDBManagerClientTaskID", managerTask.ID, "Error", err)
+		return progressingConditions, fmt.Errorf("can't start ScyllaDB Manager client task %q: %s", managerTask.ID, managerclienterrors.GetPayloadMessage(err))
+	}
+
+	onDemandRun := &scyllav1alpha1.ScyllaDBManagerTaskOnDemandRunStatus{
+		Request:     request,
+		TriggerTime: metav1.NewTime(triggerTime),
+	}
+	if status.LastRun != nil {
+		onDemandRun.PreviousRunID = status.LastRun.ID
+	}
+	status.OnDemandRun = onDemandRun
+
+	// The run is usually recorded by ScyllaDB Manager by the time it's started.
+	// Otherwise, it's matched once it shows up as the latest run.
+	runs, err := managerClient.GetTaskHistory(ctx, clusterID, managerTask.Type, managerTaskID, 1)
+	if err != nil {
+		klog.V(4).InfoS("Failed to get ScyllaDB Manager client task history.", "ScyllaDBManagerTask", klog.KObj(smt), "ScyllaDBManagerClientClusterID", clusterID, "ScyllaDBManagerClientTaskType", managerTask.Type, "ScyllaDBManagerClientTaskID", managerTask.ID, "Error", err)
+	} else if len(runs) > 0 {
+		onDemandRun.RunID = getOnDemandRunID(onDemandRun, pointer.Ptr(runs[0].ID))
+	}
+
+	progressingConditions = append(progressingConditions, metav1.Condition{
+		Type:               managerControllerProgressingCondition,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: smt.Generation,
+		Reason:             "StartedOnDemandRun",
+		Message:            fmt.Sprintf("Started an on-demand run of ScyllaDB Manager task %s requested with %q.", managerTask.ID, request),
+	})
+
+	return progressingConditions, nil
+}
+
+// getOnDemandRunID returns the ID of the latest run if it isn't the run that was the latest when the on-demand run was triggered.
+func getOnDemandRunID(onDemandRun *scyllav1alpha1.ScyllaDBManagerTaskOnDemandRunStatus, latestRunID *string) *string {
+	if latestRunID == nil || len(*latestRunID) == 0 {
+		return nil
+	}
+
+	if onDemandRun.PreviousRunID != nil && *onDemandRun.PreviousRunID == *latestRunID {
+		return nil
+	}
+
+	return latestRunID
+}
+
+func isOnDemandRunAwaitingStart(onDemandRun *scyllav1alpha1.ScyllaDBManagerTaskOnDemandRunStatus) bool {
+	return onDemandRun != nil && onDemandRun.RunID == nil
+}
@@ -0,0 +1,76 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbmanagertask
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_getOnDemandRunID(t *testing.T) {
+	t.Parallel()
+
+	triggerTime := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	tt := []struct {
+		name        string
+		onDemandRun *scyllav1alpha1.ScyllaDBManagerTaskOnDemandRunStatus
+		latestRunID *string
+		expected    *string
+	}{
+		{
+			name: "no runs",
+			onDemandRun: &scyllav1alpha1.ScyllaDBManagerTaskOnDemandRunStatus{
+				Request:     "migration-1",
+				TriggerTime: triggerTime,
+			},
+			latestRunID: nil,
+			expected:    nil,
+		},
+		{
+			name: "latest run is the run preceding the trigger",
+			onDemandRun: &scyllav1alpha1.ScyllaDBManagerTaskOnDemandRunStatus{
+				Request:       "migration-1",
+				TriggerTime:   triggerTime,
+				PreviousRunID: pointer.Ptr("scheduled-run-id"),
+			},
+			latestRunID: pointer.Ptr("scheduled-run-id"),
+			expected:    nil,
+		},
+		{
+			name: "latest run differs from the run preceding the trigger",
+			onDemandRun: &scyllav1alpha1.ScyllaDBManagerTaskOnDemandRunStatus{
+				Request:       "migration-1",
+				TriggerTime:   triggerTime,
+				PreviousRunID: pointer.Ptr("scheduled-run-id"),
+			},
+			latestRunID: pointer.Ptr("on-demand-run-id"),
+			expected:    pointer.Ptr("on-demand-run-id"),
+		},
+		{
+			name: "first run of the task",
+			onDemandRun: &scyllav1alpha1.ScyllaDBManagerTaskOnDemandRunStatus{
+				Request:     "migration-1",
+				TriggerTime: triggerTime,
+			},
+			latestRunID: pointer.Ptr("on-demand-run-id"),
+			expected:    pointer.Ptr("on-demand-run-id"),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getOnDemandRunID(tc.onDemandRun, tc.latestRunID)
+			if !cmp.Equal(got, tc.expected) {
+				t.Errorf("expected and got run IDs differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
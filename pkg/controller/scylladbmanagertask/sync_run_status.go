@@ -0,0 +1,272 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbmanagertask
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/v3/swagger/gen/scylla-manager/models"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/helpers/managerclienterrors"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// taskRunProgressPollInterval is the interval in which the progress of a running task is refreshed.
+	taskRunProgressPollInterval = 30 * time.Second
+
+	latestScyllaDBManagerClientTaskRunID = "latest"
+)
+
+func syncTaskRunStatus(
+	ctx context.Context,
+	smt *scyllav1alpha1.ScyllaDBManagerTask,
+	status *scyllav1alpha1.ScyllaDBManagerTaskStatus,
+	managerClient *managerclient.Client,
+	clusterID string,
+	managerTask *managerclient.TaskListItem,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	status.NextActivation = nil
+	if managerTask.NextActivation != nil {
+		status.NextActivation = convertDateTime(*managerTask.NextActivation)
+	}
+
+	status.NextActivationInTimezone = nil
+	if status.NextActivation != nil && managerTask.Schedule != nil && len(managerTask.Schedule.Timezone) != 0 {
+		nextActivationInTimezone, err := formatTimeInTimezone(status.NextActivation.Time, managerTask.Schedule.Timezone)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't format next activation in timezone: %w", err)
+		}
+
+		status.NextActivationInTimezone = pointer.Ptr(nextActivationInTimezone)
+	}
+
+	if smt.Spec.Type != scyllav1alpha1.ScyllaDBManagerTaskTypeBackup {
+		status.Backup = nil
+	}
+
+	lastRun, snapshotTag, err := getLatestScyllaDBManagerTaskRunStatus(ctx, smt.Spec.Type, managerClient, clusterID, managerTask.ID)
+	if err != nil {
+		if managerclienterrors.IsNotFound(err) {
+			klog.V(4).InfoS("ScyllaDB Manager task has no runs yet.", "ScyllaDBManagerTask", klog.KObj(smt), "ScyllaDBManagerClientClusterID", clusterID, "ScyllaDBManagerClientTaskID", managerTask.ID)
+			status.LastRun = nil
+			return progressingConditions, nil
+		}
+
+		klog.V(4).InfoS("Failed to get ScyllaDB Manager task progress.", "ScyllaDBManagerTask", klog.KObj(smt), "ScyllaDBManagerClientClusterID", clusterID, "ScyllaDBManagerClientTaskID", managerTask.ID, "Error", err)
+		return progressingConditions, fmt.Errorf("can't get ScyllaDB Manager task progress: %s", managerclienterrors.GetPayloadMessage(err))
+	}
+
+	status.LastRun = lastRun
+
+	if smt.Spec.Type == scyllav1alpha1.ScyllaDBManagerTaskTypeBackup &&
+		lastRun.Status != nil && *lastRun.Status == managerclient.TaskStatusDone &&
+		len(snapshotTag) != 0 {
+		status.Backup = &scyllav1alpha1.ScyllaDBManagerBackupTaskStatus{
+			LatestSnapshotTag: pointer.Ptr(snapshotTag),
+		}
+	}
+
+	// Restore tasks run once, so a running restore means that the task hasn't reached its desired state yet.
+	// Recurring tasks are considered to have reached it as soon as they are scheduled.
+	if smt.Spec.Type == scyllav1alpha1.ScyllaDBManagerTaskTypeRestore && isScyllaDBManagerTaskRunInProgress(lastRun) {
+		message := fmt.Sprintf("Restore run %q is in progress.", *lastRun.ID)
+		if lastRun.Progress != nil {
+			message = fmt.Sprintf("Restore run %q is in progress: %d%% restored.", *lastRun.ID, *lastRun.Progress)
+		}
+
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               managerControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: smt.Generation,
+			Reason:             "RestoreRunning",
+			Message:            message,
+		})
+	}
+
+	return progressingConditions, nil
+}
+
+// getLatestScyllaDBManagerTaskRunStatus returns the status of the latest run of the task.
+// For backup tasks, it also returns the snapshot tag of the run.
+func getLatestScyllaDBManagerTaskRunStatus(
+	ctx context.Context,
+	taskType scyllav1alpha1.ScyllaDBManagerTaskType,
+	managerClient *managerclient.Client,
+	clusterID string,
+	taskID string,
+) (*scyllav1alpha1.ScyllaDBManagerTaskRunStatus, string, error) {
+	switch taskType {
+	case scyllav1alpha1.ScyllaDBManagerTaskTypeBackup:
+		backupProgress, err := managerClient.BackupProgress(ctx, clusterID, taskID, latestScyllaDBManagerClientTaskRunID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		runStatus, snapshotTag := makeScyllaDBManagerBackupTaskRunStatus(backupProgress)
+		return runStatus, snapshotTag, nil
+
+	case scyllav1alpha1.ScyllaDBManagerTaskTypeRepair:
+		repairProgress, err := managerClient.RepairProgress(ctx, clusterID, taskID, latestScyllaDBManagerClientTaskRunID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return makeScyllaDBManagerRepairTaskRunStatus(repairProgress), "", nil
+
+	case scyllav1alpha1.ScyllaDBManagerTaskTypeRestore:
+		restoreProgress, err := managerClient.RestoreProgress(ctx, clusterID, taskID, latestScyllaDBManagerClientTaskRunID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return makeScyllaDBManagerRestoreTaskRunStatus(restoreProgress), "", nil
+
+	case scyllav1alpha1.ScyllaDBManagerTaskTypeValidateBackup:
+		validateBackupProgress, err := managerClient.ValidateBackupProgress(ctx, clusterID, taskID, latestScyllaDBManagerClientTaskRunID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return makeScyllaDBManagerValidateBackupTaskRunStatus(validateBackupProgress), "", nil
+
+	default:
+		return nil, "", fmt.Errorf("unsupported task type: %q", taskType)
+
+	}
+}
+
+func makeScyllaDBManagerBackupTaskRunStatus(backupProgress managerclient.BackupProgress) (*scyllav1alpha1.ScyllaDBManagerTaskRunStatus, string) {
+	if backupProgress.TaskRunBackupProgress == nil {
+		return &scyllav1alpha1.ScyllaDBManagerTaskRunStatus{}, ""
+	}
+
+	runStatus := makeScyllaDBManagerTaskRunStatus(backupProgress.Run)
+
+	var snapshotTag string
+	progress := backupProgress.Progress
+	if progress != nil {
+		if len(progress.Stage) > 0 {
+			runStatus.Stage = pointer.Ptr(progress.Stage)
+		}
+
+		if progress.Size > 0 {
+			runStatus.Progress = pointer.Ptr(int32((progress.Uploaded + progress.Skipped) * 100 / progress.Size))
+		}
+
+		snapshotTag = progress.SnapshotTag
+	}
+
+	return runStatus, snapshotTag
+}
+
+func makeScyllaDBManagerRepairTaskRunStatus(repairProgress managerclient.RepairProgress) *scyllav1alpha1.ScyllaDBManagerTaskRunStatus {
+	if repairProgress.TaskRunRepairProgress == nil {
+		return &scyllav1alpha1.ScyllaDBManagerTaskRunStatus{}
+	}
+
+	runStatus := makeScyllaDBManagerTaskRunStatus(repairProgress.Run)
+
+	progress := repairProgress.Progress
+	if progress != nil && progress.TokenRanges > 0 {
+		runStatus.Progress = pointer.Ptr(int32(progress.Success * 100 / progress.TokenRanges))
+	}
+
+	return runStatus
+}
+
+func makeScyllaDBManagerRestoreTaskRunStatus(restoreProgress managerclient.RestoreProgress) *scyllav1alpha1.ScyllaDBManagerTaskRunStatus {
+	if restoreProgress.TaskRunRestoreProgress == nil {
+		return &scyllav1alpha1.ScyllaDBManagerTaskRunStatus{}
+	}
+
+	runStatus := makeScyllaDBManagerTaskRunStatus(restoreProgress.Run)
+
+	progress := restoreProgress.Progress
+	if progress != nil {
+		if len(progress.Stage) > 0 {
+			runStatus.Stage = pointer.Ptr(progress.Stage)
+		}
+
+		if progress.Size > 0 {
+			runStatus.Progress = pointer.Ptr(int32(progress.Restored * 100 / progress.Size))
+		}
+	}
+
+	return runStatus
+}
+
+func makeScyllaDBManagerValidateBackupTaskRunStatus(validateBackupProgress managerclient.ValidateBackupProgress) *scyllav1alpha1.ScyllaDBManagerTaskRunStatus {
+	if validateBackupProgress.TaskRunValidateBackupProgress == nil {
+		return &scyllav1alpha1.ScyllaDBManagerTaskRunStatus{}
+	}
+
+	// Validation progress isn't measurable upfront, so only the run is reflected.
+	return makeScyllaDBManagerTaskRunStatus(validateBackupProgress.Run)
+}
+
+func makeScyllaDBManagerTaskRunStatus(run *models.TaskRun) *scyllav1alpha1.ScyllaDBManagerTaskRunStatus {
+	runStatus := &scyllav1alpha1.ScyllaDBManagerTaskRunStatus{}
+
+	if run == nil {
+		return runStatus
+	}
+
+	if len(run.ID) > 0 {
+		runStatus.ID = pointer.Ptr(run.ID)
+	}
+
+	if len(run.Status) > 0 {
+		runStatus.Status = pointer.Ptr(run.Status)
+	}
+
+	if len(run.Cause) > 0 {
+		runStatus.Cause = pointer.Ptr(run.Cause)
+	}
+
+	runStatus.StartTime = convertDateTime(run.StartTime)
+	runStatus.EndTime = convertDateTime(run.EndTime)
+
+	return runStatus
+}
+
+func isScyllaDBManagerTaskRunInProgress(runStatus *scyllav1alpha1.ScyllaDBManagerTaskRunStatus) bool {
+	if runStatus == nil || runStatus.ID == nil || runStatus.Status == nil {
+		return false
+	}
+
+	switch *runStatus.Status {
+	case managerclient.TaskStatusDone, managerclient.TaskStatusError, managerclient.TaskStatusAborted, managerclient.TaskStatusStopped:
+		return false
+
+	default:
+		return true
+
+	}
+}
+
+func formatTimeInTimezone(t time.Time, timezone string) (string, error) {
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return "", fmt.Errorf("can't load location %q: %w", timezone, err)
+	}
+
+	return t.In(location).Format(time.RFC3339), nil
+}
+
+func convertDateTime(dt strfmt.DateTime) *metav1.Time {
+	t := time.Time(dt)
+	if t.IsZero() {
+		return nil
+	}
+
+	return pointer.Ptr(metav1.NewTime(t))
+}
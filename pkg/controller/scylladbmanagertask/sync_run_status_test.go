@@ -0,0 +1,324 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbmanagertask
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	"github.com/scylladb/scylla-manager/v3/swagger/gen/scylla-manager/models"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_makeScyllaDBManagerBackupTaskRunStatus(t *testing.T) {
+	t.Parallel()
+
+	startTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(time.Hour)
+
+	tt := []struct {
+		name                string
+		backupProgress      managerclient.BackupProgress
+		expected            *scyllav1alpha1.ScyllaDBManagerTaskRunStatus
+		expectedSnapshotTag string
+		expectedInProgress  bool
+	}{
+		{
+			name:                "no progress",
+			backupProgress:      managerclient.BackupProgress{},
+			expected:            &scyllav1alpha1.ScyllaDBManagerTaskRunStatus{},
+			expectedSnapshotTag: "",
+			expectedInProgress:  false,
+		},
+		{
+			name: "running backup",
+			backupProgress: managerclient.BackupProgress{
+				TaskRunBackupProgress: &models.TaskRunBackupProgress{
+					Run: &models.TaskRun{
+						ID:        "run-id",
+						Status:    managerclient.TaskStatusRunning,
+						StartTime: strfmt.DateTime(startTime),
+					},
+					Progress: &models.BackupProgress{
+						Stage:       "UPLOAD",
+						Size:        400,
+						Uploaded:    100,
+						Skipped:     100,
+						SnapshotTag: "sm_20250101000000UTC",
+					},
+				},
+			},
+			expected: &scyllav1alpha1.ScyllaDBManagerTaskRunStatus{
+				ID:        pointer.Ptr("run-id"),
+				Status:    pointer.Ptr(managerclient.TaskStatusRunning),
+				Stage:     pointer.Ptr("UPLOAD"),
+				Progress:  pointer.Ptr[int32](50),
+				StartTime: pointer.Ptr(metav1.NewTime(startTime)),
+			},
+			expectedSnapshotTag: "sm_20250101000000UTC",
+			expectedInProgress:  true,
+		},
+		{
+			name: "completed backup",
+			backupProgress: managerclient.BackupProgress{
+				TaskRunBackupProgress: &models.TaskRunBackupProgress{
+					Run: &models.TaskRun{
+						ID:        "run-id",
+						Status:    managerclient.TaskStatusDone,
+						StartTime: strfmt.DateTime(startTime),
+						EndTime:   strfmt.DateTime(endTime),
+					},
+					Progress: &models.BackupProgress{
+						Stage:       "DONE",
+						Size:        400,
+						Uploaded:    400,
+						SnapshotTag: "sm_20250101000000UTC",
+					},
+				},
+			},
+			expected: &scyllav1alpha1.ScyllaDBManagerTaskRunStatus{
+				ID:        pointer.Ptr("run-id"),
+				Status:    pointer.Ptr(managerclient.TaskStatusDone),
+				Stage:     pointer.Ptr("DONE"),
+				Progress:  pointer.Ptr[int32](100),
+				StartTime: pointer.Ptr(metav1.NewTime(startTime)),
+				EndTime:   pointer.Ptr(metav1.NewTime(endTime)),
+			},
+			expectedSnapshotTag: "sm_20250101000000UTC",
+			expectedInProgress:  false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, gotSnapshotTag := makeScyllaDBManagerBackupTaskRunStatus(tc.backupProgress)
+			if !cmp.Equal(got, tc.expected) {
+				t.Errorf("expected and got run statuses differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+
+			if gotSnapshotTag != tc.expectedSnapshotTag {
+				t.Errorf("expected snapshot tag %q, got %q", tc.expectedSnapshotTag, gotSnapshotTag)
+			}
+
+			gotInProgress := isScyllaDBManagerTaskRunInProgress(got)
+			if gotInProgress != tc.expectedInProgress {
+				t.Errorf("expected in progress %t, got %t", tc.expectedInProgress, gotInProgress)
+			}
+		})
+	}
+}
+
+func Test_makeScyllaDBManagerRepairTaskRunStatus(t *testing.T) {
+	t.Parallel()
+
+	startTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(time.Hour)
+
+	tt := []struct {
+		name               string
+		repairProgress     managerclient.RepairProgress
+		expected           *scyllav1alpha1.ScyllaDBManagerTaskRunStatus
+		expectedInProgress bool
+	}{
+		{
+			name:               "no progress",
+			repairProgress:     managerclient.RepairProgress{},
+			expected:           &scyllav1alpha1.ScyllaDBManagerTaskRunStatus{},
+			expectedInProgress: false,
+		},
+		{
+			name: "repair waiting for retry",
+			repairProgress: managerclient.RepairProgress{
+				TaskRunRepairProgress: &models.TaskRunRepairProgress{
+					Run: &models.TaskRun{
+						ID:        "run-id",
+						Status:    managerclient.TaskStatusWaiting,
+						Cause:     "host is down",
+						StartTime: strfmt.DateTime(startTime),
+						EndTime:   strfmt.DateTime(endTime),
+					},
+					Progress: &models.RepairProgress{
+						TokenRanges: 300,
+						Success:     100,
+						Error:       10,
+					},
+				},
+			},
+			expected: &scyllav1alpha1.ScyllaDBManagerTaskRunStatus{
+				ID:        pointer.Ptr("run-id"),
+				Status:    pointer.Ptr(managerclient.TaskStatusWaiting),
+				Progress:  pointer.Ptr[int32](33),
+				Cause:     pointer.Ptr("host is down"),
+				StartTime: pointer.Ptr(metav1.NewTime(startTime)),
+				EndTime:   pointer.Ptr(metav1.NewTime(endTime)),
+			},
+			expectedInProgress: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := makeScyllaDBManagerRepairTaskRunStatus(tc.repairProgress)
+			if !cmp.Equal(got, tc.expected) {
+				t.Errorf("expected and got run statuses differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+
+			gotInProgress := isScyllaDBManagerTaskRunInProgress(got)
+			if gotInProgress != tc.expectedInProgress {
+				t.Errorf("expected in progress %t, got %t", tc.expectedInProgress, gotInProgress)
+			}
+		})
+	}
+}
+
+func Test_makeScyllaDBManagerRestoreTaskRunStatus(t *testing.T) {
+	t.Parallel()
+
+	startTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := startTime.Add(time.Hour)
+
+	tt := []struct {
+		name               string
+		restoreProgress    managerclient.RestoreProgress
+		expected           *scyllav1alpha1.ScyllaDBManagerTaskRunStatus
+		expectedInProgress bool
+	}{
+		{
+			name:               "no progress",
+			restoreProgress:    managerclient.RestoreProgress{},
+			expected:           &scyllav1alpha1.ScyllaDBManagerTaskRunStatus{},
+			expectedInProgress: false,
+		},
+		{
+			name: "running restore",
+			restoreProgress: managerclient.RestoreProgress{
+				TaskRunRestoreProgress: &models.TaskRunRestoreProgress{
+					Run: &models.TaskRun{
+						ID:        "run-id",
+						Status:    managerclient.TaskStatusRunning,
+						StartTime: strfmt.DateTime(startTime),
+					},
+					Progress: &models.RestoreProgress{
+						Stage:    "DATA",
+						Size:     400,
+						Restored: 100,
+					},
+				},
+			},
+			expected: &scyllav1alpha1.ScyllaDBManagerTaskRunStatus{
+				ID:        pointer.Ptr("run-id"),
+				Status:    pointer.Ptr(managerclient.TaskStatusRunning),
+				Stage:     pointer.Ptr("DATA"),
+				Progress:  pointer.Ptr[int32](25),
+				StartTime: pointer.Ptr(metav1.NewTime(startTime)),
+			},
+			expectedInProgress: true,
+		},
+		{
+			name: "failed restore",
+			restoreProgress: managerclient.RestoreProgress{
+				TaskRunRestoreProgress: &models.TaskRunRestoreProgress{
+					Run: &models.TaskRun{
+						ID:        "run-id",
+						Status:    managerclient.TaskStatusError,
+						Cause:     "no space left on device",
+						StartTime: strfmt.DateTime(startTime),
+						EndTime:   strfmt.DateTime(endTime),
+					},
+					Progress: &models.RestoreProgress{
+						Stage:    "DATA",
+						Size:     400,
+						Restored: 300,
+					},
+				},
+			},
+			expected: &scyllav1alpha1.ScyllaDBManagerTaskRunStatus{
+				ID:        pointer.Ptr("run-id"),
+				Status:    pointer.Ptr(managerclient.TaskStatusError),
+				Stage:     pointer.Ptr("DATA"),
+				Progress:  pointer.Ptr[int32](75),
+				Cause:     pointer.Ptr("no space left on device"),
+				StartTime: pointer.Ptr(metav1.NewTime(startTime)),
+				EndTime:   pointer.Ptr(metav1.NewTime(endTime)),
+			},
+			expectedInProgress: false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := makeScyllaDBManagerRestoreTaskRunStatus(tc.restoreProgress)
+			if !cmp.Equal(got, tc.expected) {
+				t.Errorf("expected and got run statuses differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+
+			gotInProgress := isScyllaDBManagerTaskRunInProgress(got)
+			if gotInProgress != tc.expectedInProgress {
+				t.Errorf("expected in progress %t, got %t", tc.expectedInProgress, gotInProgress)
+			}
+		})
+	}
+}
+
+func Test_formatTimeInTimezone(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name        string
+		time        time.Time
+		timezone    string
+		expected    string
+		expectedErr bool
+	}{
+		{
+			name:     "UTC",
+			time:     time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC),
+			timezone: "UTC",
+			expected: "2025-01-01T02:00:00Z",
+		},
+		{
+			name:     "winter time",
+			time:     time.Date(2025, 1, 1, 1, 0, 0, 0, time.UTC),
+			timezone: "Europe/Warsaw",
+			expected: "2025-01-01T02:00:00+01:00",
+		},
+		{
+			name:     "summer time",
+			time:     time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC),
+			timezone: "Europe/Warsaw",
+			expected: "2025-07-01T02:00:00+02:00",
+		},
+		{
+			name:        "unknown timezone",
+			time:        time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			timezone:    "invalid",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := formatTimeInTimezone(tc.time, tc.timezone)
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("expected error: %t, got %v", tc.expectedErr, err)
+			}
+
+			if got != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
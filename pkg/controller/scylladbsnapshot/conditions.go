@@ -0,0 +1,10 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbsnapshot
+
+const (
+	snapshotControllerProgressingCondition        = "SnapshotControllerProgressing"
+	snapshotControllerDegradedCondition           = "SnapshotControllerDegraded"
+	scyllaDBSnapshotFinalizerProgressingCondition = "ScyllaDBSnapshotFinalizerProgressing"
+	scyllaDBSnapshotFinalizerDegradedCondition    = "ScyllaDBSnapshotFinalizerDegraded"
+)
@@ -0,0 +1,279 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbsnapshot
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scyllav1alpha1client "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned/typed/scylla/v1alpha1"
+	scyllav1alpha1informers "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/scylla/v1alpha1"
+	scyllav1alpha1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/controllertools"
+	"github.com/scylladb/scylla-operator/pkg/kubeinterfaces"
+	"github.com/scylladb/scylla-operator/pkg/scheme"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	apimachineryutilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	apimachineryutilwait "k8s.io/apimachinery/pkg/util/wait"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+)
+
+const (
+	ControllerName = "ScyllaDBSnapshotController"
+
+	// maxSyncDuration enforces preemption. Do not raise the value! Controllers shouldn't actively wait,
+	// but rather use the queue.
+	// Flushing memtables and taking snapshots on every node can take a while, so this needs to be higher than usual.
+	maxSyncDuration = 2 * time.Minute
+)
+
+var (
+	keyFunc                       = cache.DeletionHandlingMetaNamespaceKeyFunc
+	scyllaDBSnapshotControllerGVK = scyllav1alpha1.GroupVersion.WithKind("ScyllaDBSnapshot")
+)
+
+type Controller struct {
+	kubeClient   kubernetes.Interface
+	scyllaClient scyllav1alpha1client.ScyllaV1alpha1Interface
+
+	scyllaDBSnapshotLister   scyllav1alpha1listers.ScyllaDBSnapshotLister
+	scyllaDBDatacenterLister scyllav1alpha1listers.ScyllaDBDatacenterLister
+	serviceLister            corev1listers.ServiceLister
+	podLister                corev1listers.PodLister
+	secretLister             corev1listers.SecretLister
+
+	cachesToSync []cache.InformerSynced
+
+	eventRecorder record.EventRecorder
+
+	queue    workqueue.TypedRateLimitingInterface[string]
+	handlers *controllerhelpers.Handlers[*scyllav1alpha1.ScyllaDBSnapshot]
+}
+
+func NewController(
+	kubeClient kubernetes.Interface,
+	scyllaClient scyllav1alpha1client.ScyllaV1alpha1Interface,
+	scyllaDBSnapshotInformer scyllav1alpha1informers.ScyllaDBSnapshotInformer,
+	scyllaDBDatacenterInformer scyllav1alpha1informers.ScyllaDBDatacenterInformer,
+	serviceInformer corev1informers.ServiceInformer,
+	podInformer corev1informers.PodInformer,
+	secretInformer corev1informers.SecretInformer,
+) (*Controller, error) {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartStructuredLogging(0)
+	eventBroadcaster.StartRecordingToSink(&corev1client.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+
+	ssc := &Controller{
+		kubeClient:   kubeClient,
+		scyllaClient: scyllaClient,
+
+		scyllaDBSnapshotLister:   scyllaDBSnapshotInformer.Lister(),
+		scyllaDBDatacenterLister: scyllaDBDatacenterInformer.Lister(),
+		serviceLister:            serviceInformer.Lister(),
+		podLister:                podInformer.Lister(),
+		secretLister:             secretInformer.Lister(),
+
+		cachesToSync: []cache.InformerSynced{
+			scyllaDBSnapshotInformer.Informer().HasSynced,
+			scyllaDBDatacenterInformer.Informer().HasSynced,
+			serviceInformer.Informer().HasSynced,
+			podInformer.Informer().HasSynced,
+			secretInformer.Informer().HasSynced,
+		},
+
+		eventRecorder: eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "scylladbsnapshot-controller"}),
+
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: "scylladbsnapshot",
+			},
+		),
+	}
+
+	var err error
+	ssc.handlers, err = controllerhelpers.NewHandlers[*scyllav1alpha1.ScyllaDBSnapshot](
+		ssc.queue,
+		keyFunc,
+		scheme.Scheme,
+		scyllaDBSnapshotControllerGVK,
+		kubeinterfaces.NamespacedGetList[*scyllav1alpha1.ScyllaDBSnapshot]{
+			GetFunc: func(namespace, name string) (*scyllav1alpha1.ScyllaDBSnapshot, error) {
+				return ssc.scyllaDBSnapshotLister.ScyllaDBSnapshots(namespace).Get(name)
+			},
+			ListFunc: func(namespace string, selector labels.Selector) (ret []*scyllav1alpha1.ScyllaDBSnapshot, err error) {
+				return ssc.scyllaDBSnapshotLister.ScyllaDBSnapshots(namespace).List(selector)
+			},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("can't create handlers: %w", err)
+	}
+
+	scyllaDBSnapshotInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    ssc.addScyllaDBSnapshot,
+		UpdateFunc: ssc.updateScyllaDBSnapshot,
+		DeleteFunc: ssc.deleteScyllaDBSnapshot,
+	})
+
+	scyllaDBDatacenterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    ssc.addScyllaDBDatacenter,
+		UpdateFunc: ssc.updateScyllaDBDatacenter,
+		DeleteFunc: ssc.deleteScyllaDBDatacenter,
+	})
+
+	return ssc, nil
+}
+
+func (ssc *Controller) processNextItem(ctx context.Context) bool {
+	key, quit := ssc.queue.Get()
+	if quit {
+		return false
+	}
+	defer ssc.queue.Done(key)
+
+	ctx, cancel := context.WithTimeout(ctx, maxSyncDuration)
+	defer cancel()
+	err := ssc.sync(ctx, key)
+	// TODO: Do smarter filtering then just Reduce to handle cases like 2 conflict errors.
+	err = apimachineryutilerrors.Reduce(err)
+	switch {
+	case err == nil:
+		ssc.queue.Forget(key)
+		return true
+
+	case apierrors.IsConflict(err):
+		klog.V(2).InfoS("Hit conflict, will retry in a bit", "Key", key, "Error", err)
+
+	case apierrors.IsAlreadyExists(err):
+		klog.V(2).InfoS("Hit already exists, will retry in a bit", "Key", key, "Error", err)
+
+	default:
+		if controllertools.IsNonRetriable(err) {
+			klog.InfoS("Hit non-retriable error. Dropping the item from the queue.", "Error", err)
+			ssc.queue.Forget(key)
+			return true
+		}
+
+		apimachineryutilruntime.HandleError(fmt.Errorf("syncing key '%v' failed: %v", key, err))
+
+	}
+
+	ssc.queue.AddRateLimited(key)
+
+	return true
+}
+
+func (ssc *Controller) runWorker(ctx context.Context) {
+	for ssc.processNextItem(ctx) {
+	}
+}
+
+func (ssc *Controller) Run(ctx context.Context, workers int) {
+	defer apimachineryutilruntime.HandleCrash()
+
+	klog.InfoS("Starting controller", "controller", ControllerName)
+
+	var wg sync.WaitGroup
+	defer func() {
+		klog.InfoS("Shutting down controller", "controller", ControllerName)
+		ssc.queue.ShutDown()
+		wg.Wait()
+		klog.InfoS("Shut down controller", "controller", ControllerName)
+	}()
+
+	if !cache.WaitForNamedCacheSync(ControllerName, ctx.Done(), ssc.cachesToSync...) {
+		return
+	}
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			apimachineryutilwait.UntilWithContext(ctx, ssc.runWorker, time.Second)
+		}()
+	}
+
+	<-ctx.Done()
+}
+
+func (ssc *Controller) addScyllaDBSnapshot(obj interface{}) {
+	ssc.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.ScyllaDBSnapshot),
+		ssc.handlers.Enqueue,
+	)
+}
+
+func (ssc *Controller) updateScyllaDBSnapshot(old, cur interface{}) {
+	ssc.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.ScyllaDBSnapshot),
+		cur.(*scyllav1alpha1.ScyllaDBSnapshot),
+		ssc.handlers.Enqueue,
+		ssc.deleteScyllaDBSnapshot,
+	)
+}
+
+func (ssc *Controller) deleteScyllaDBSnapshot(obj interface{}) {
+	ssc.handlers.HandleDelete(
+		obj,
+		ssc.handlers.Enqueue,
+	)
+}
+
+func (ssc *Controller) addScyllaDBDatacenter(obj interface{}) {
+	ssc.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.ScyllaDBDatacenter),
+		ssc.enqueueThroughScyllaDBDatacenter(obj.(*scyllav1alpha1.ScyllaDBDatacenter)),
+	)
+}
+
+func (ssc *Controller) updateScyllaDBDatacenter(old, cur interface{}) {
+	ssc.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.ScyllaDBDatacenter),
+		cur.(*scyllav1alpha1.ScyllaDBDatacenter),
+		ssc.enqueueThroughScyllaDBDatacenter(cur.(*scyllav1alpha1.ScyllaDBDatacenter)),
+		ssc.deleteScyllaDBDatacenter,
+	)
+}
+
+func (ssc *Controller) deleteScyllaDBDatacenter(obj interface{}) {
+	sdc, ok := obj.(*scyllav1alpha1.ScyllaDBDatacenter)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			apimachineryutilruntime.HandleError(fmt.Errorf("couldn't get object from tombstone %#v", obj))
+			return
+		}
+
+		sdc, ok = tombstone.Obj.(*scyllav1alpha1.ScyllaDBDatacenter)
+		if !ok {
+			apimachineryutilruntime.HandleError(fmt.Errorf("tombstone contained object that is not a ScyllaDBDatacenter %#v", obj))
+			return
+		}
+	}
+
+	ssc.handlers.HandleDelete(
+		obj,
+		ssc.enqueueThroughScyllaDBDatacenter(sdc),
+	)
+}
+
+func (ssc *Controller) enqueueThroughScyllaDBDatacenter(sdc *scyllav1alpha1.ScyllaDBDatacenter) controllerhelpers.EnqueueFuncType {
+	return ssc.handlers.EnqueueAllFunc(ssc.handlers.EnqueueWithFilterFunc(func(ss *scyllav1alpha1.ScyllaDBSnapshot) bool {
+		return ss.Spec.ScyllaDBDatacenterRef.Name == sdc.Name
+	}))
+}
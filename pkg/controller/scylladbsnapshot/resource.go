@@ -0,0 +1,70 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbsnapshot
+
+import (
+	"fmt"
+	"slices"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func scyllaDBSnapshotTag(ss *scyllav1alpha1.ScyllaDBSnapshot) string {
+	return fmt.Sprintf("so_snapshot_%s", ss.UID)
+}
+
+// makeNodeStatuses returns the initial statuses of all nodes of the ScyllaDBDatacenter the snapshot is taken on.
+func makeNodeStatuses(sdc *scyllav1alpha1.ScyllaDBDatacenter) ([]scyllav1alpha1.ScyllaDBSnapshotNodeStatus, error) {
+	var nodeStatuses []scyllav1alpha1.ScyllaDBSnapshotNodeStatus
+	for _, rack := range sdc.Spec.Racks {
+		rackNodeCount, err := controllerhelpers.GetRackNodeCount(sdc, rack.Name)
+		if err != nil {
+			return nil, fmt.Errorf("can't get rack %q node count of ScyllaDBDatacenter %q: %w", rack.Name, naming.ObjRef(sdc), err)
+		}
+
+		for ord := range *rackNodeCount {
+			nodeStatuses = append(nodeStatuses, scyllav1alpha1.ScyllaDBSnapshotNodeStatus{
+				RackName: rack.Name,
+				PodName:  naming.MemberServiceName(rack, sdc, int(ord)),
+			})
+		}
+	}
+
+	return nodeStatuses, nil
+}
+
+// getSnapshotKeyspaces returns the keyspaces and tables to snapshot.
+// All existing keyspaces are snapshotted when none are selected.
+func getSnapshotKeyspaces(selected []scyllav1alpha1.ScyllaDBSnapshotKeyspace, existing []string) ([]scyllav1alpha1.ScyllaDBSnapshotKeyspace, error) {
+	if len(selected) == 0 {
+		keyspaces := make([]scyllav1alpha1.ScyllaDBSnapshotKeyspace, 0, len(existing))
+		for _, keyspace := range existing {
+			keyspaces = append(keyspaces, scyllav1alpha1.ScyllaDBSnapshotKeyspace{
+				Name: keyspace,
+			})
+		}
+
+		return keyspaces, nil
+	}
+
+	for _, keyspace := range selected {
+		if !slices.Contains(existing, keyspace.Name) {
+			return nil, fmt.Errorf("keyspace %q doesn't exist", keyspace.Name)
+		}
+	}
+
+	return selected, nil
+}
+
+// getExpirationTime returns the time when the snapshot expires, or nil if it's retained indefinitely.
+func getExpirationTime(completionTime metav1.Time, ttl *metav1.Duration) *metav1.Time {
+	if ttl == nil {
+		return nil
+	}
+
+	expirationTime := metav1.NewTime(completionTime.Add(ttl.Duration))
+	return &expirationTime
+}
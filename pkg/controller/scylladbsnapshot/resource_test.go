@@ -0,0 +1,170 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbsnapshot
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMakeNodeStatuses(t *testing.T) {
+	t.Parallel()
+
+	sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic",
+			Namespace: "scylla",
+		},
+		Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+			ClusterName:    "basic",
+			DatacenterName: pointer.Ptr("dc1"),
+			Racks: []scyllav1alpha1.RackSpec{
+				{
+					Name: "a",
+					RackTemplate: scyllav1alpha1.RackTemplate{
+						Nodes: pointer.Ptr[int32](2),
+					},
+				},
+				{
+					Name: "b",
+					RackTemplate: scyllav1alpha1.RackTemplate{
+						Nodes: pointer.Ptr[int32](1),
+					},
+				},
+			},
+		},
+	}
+
+	expected := []scyllav1alpha1.ScyllaDBSnapshotNodeStatus{
+		{
+			RackName: "a",
+			PodName:  "basic-dc1-a-0",
+		},
+		{
+			RackName: "a",
+			PodName:  "basic-dc1-a-1",
+		},
+		{
+			RackName: "b",
+			PodName:  "basic-dc1-b-0",
+		},
+	}
+
+	got, err := makeNodeStatuses(sdc)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected and got node statuses differ:\n%s", cmp.Diff(expected, got))
+	}
+}
+
+func TestGetSnapshotKeyspaces(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name          string
+		selected      []scyllav1alpha1.ScyllaDBSnapshotKeyspace
+		existing      []string
+		expected      []scyllav1alpha1.ScyllaDBSnapshotKeyspace
+		expectedError error
+	}{
+		{
+			name:     "all keyspaces when none are selected",
+			selected: nil,
+			existing: []string{"system", "ks"},
+			expected: []scyllav1alpha1.ScyllaDBSnapshotKeyspace{
+				{
+					Name: "system",
+				},
+				{
+					Name: "ks",
+				},
+			},
+		},
+		{
+			name: "selected keyspaces",
+			selected: []scyllav1alpha1.ScyllaDBSnapshotKeyspace{
+				{
+					Name:   "ks",
+					Tables: []string{"t1"},
+				},
+			},
+			existing: []string{"system", "ks"},
+			expected: []scyllav1alpha1.ScyllaDBSnapshotKeyspace{
+				{
+					Name:   "ks",
+					Tables: []string{"t1"},
+				},
+			},
+		},
+		{
+			name: "missing keyspace",
+			selected: []scyllav1alpha1.ScyllaDBSnapshotKeyspace{
+				{
+					Name: "missing",
+				},
+			},
+			existing:      []string{"system", "ks"},
+			expected:      nil,
+			expectedError: errors.New(`keyspace "missing" doesn't exist`),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := getSnapshotKeyspaces(tc.selected, tc.existing)
+			if !reflect.DeepEqual(err, tc.expectedError) {
+				t.Fatalf("expected error %#v, got %#v", tc.expectedError, err)
+			}
+
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got keyspaces differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func TestGetExpirationTime(t *testing.T) {
+	t.Parallel()
+
+	completionTime := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	tt := []struct {
+		name     string
+		ttl      *metav1.Duration
+		expected *metav1.Time
+	}{
+		{
+			name:     "no ttl",
+			ttl:      nil,
+			expected: nil,
+		},
+		{
+			name:     "ttl",
+			ttl:      &metav1.Duration{Duration: 24 * time.Hour},
+			expected: pointer.Ptr(metav1.NewTime(time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC))),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := getExpirationTime(completionTime, tc.ttl)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected and got expiration times differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbsnapshot
+
+import (
+	"context"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+func (ssc *Controller) calculateStatus(ss *scyllav1alpha1.ScyllaDBSnapshot) *scyllav1alpha1.ScyllaDBSnapshotStatus {
+	status := ss.Status.DeepCopy()
+	status.ObservedGeneration = pointer.Ptr(ss.Generation)
+
+	return status
+}
+
+func (ssc *Controller) updateStatus(ctx context.Context, currentSS *scyllav1alpha1.ScyllaDBSnapshot, status *scyllav1alpha1.ScyllaDBSnapshotStatus) error {
+	if apiequality.Semantic.DeepEqual(&currentSS.Status, status) {
+		return nil
+	}
+
+	ss := currentSS.DeepCopy()
+	ss.Status = *status
+
+	klog.V(2).InfoS("Updating status", "ScyllaDBSnapshot", klog.KObj(ss))
+
+	_, err := ssc.scyllaClient.ScyllaDBSnapshots(ss.Namespace).UpdateStatus(ctx, ss, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	klog.V(2).InfoS("Status updated", "ScyllaDBSnapshot", klog.KObj(ss))
+
+	return nil
+}
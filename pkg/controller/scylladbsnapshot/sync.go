@@ -0,0 +1,151 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbsnapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+func (ssc *Controller) sync(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		klog.ErrorS(err, "Failed to split meta namespace cache key", "cacheKey", key)
+		return err
+	}
+
+	startTime := time.Now()
+	klog.V(4).InfoS("Started syncing ScyllaDBSnapshot", "ScyllaDBSnapshot", klog.KRef(namespace, name), "startTime", startTime)
+	defer func() {
+		klog.V(4).InfoS("Finished syncing ScyllaDBSnapshot", "ScyllaDBSnapshot", klog.KRef(namespace, name), "duration", time.Since(startTime))
+	}()
+
+	ss, err := ssc.scyllaDBSnapshotLister.ScyllaDBSnapshots(namespace).Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(2).InfoS("ScyllaDBSnapshot has been deleted", "ScyllaDBSnapshot", klog.KRef(namespace, name))
+			return nil
+		}
+
+		return fmt.Errorf("can't get ScyllaDBSnapshot %q: %w", naming.ManualRef(namespace, name), err)
+	}
+
+	status := ssc.calculateStatus(ss)
+
+	if ss.DeletionTimestamp != nil {
+		err = controllerhelpers.RunSync(
+			&status.Conditions,
+			scyllaDBSnapshotFinalizerProgressingCondition,
+			scyllaDBSnapshotFinalizerDegradedCondition,
+			ss.Generation,
+			func() ([]metav1.Condition, error) {
+				return ssc.syncFinalizer(ctx, ss)
+			},
+		)
+		return ssc.updateStatus(ctx, ss, status)
+	}
+
+	if !ssc.hasFinalizer(ss.GetFinalizers()) {
+		err = ssc.addFinalizer(ctx, ss)
+		if err != nil {
+			return fmt.Errorf("can't add finalizer: %w", err)
+		}
+		return nil
+	}
+
+	var errs []error
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		snapshotControllerProgressingCondition,
+		snapshotControllerDegradedCondition,
+		ss.Generation,
+		func() ([]metav1.Condition, error) {
+			return ssc.syncSnapshot(ctx, ss, status)
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync snapshot: %w", err))
+	}
+
+	var aggregationErrs []error
+	progressingCondition, err := controllerhelpers.AggregateStatusConditions(
+		controllerhelpers.FindStatusConditionsWithSuffix(status.Conditions, scyllav1alpha1.ProgressingCondition),
+		metav1.Condition{
+			Type:               scyllav1alpha1.ProgressingCondition,
+			Status:             metav1.ConditionFalse,
+			Reason:             internalapi.AsExpectedReason,
+			Message:            "",
+			ObservedGeneration: ss.Generation,
+		},
+	)
+	if err != nil {
+		aggregationErrs = append(aggregationErrs, fmt.Errorf("can't aggregate progressing conditions: %w", err))
+	}
+
+	degradedCondition, err := controllerhelpers.AggregateStatusConditions(
+		controllerhelpers.FindStatusConditionsWithSuffix(status.Conditions, scyllav1alpha1.DegradedCondition),
+		metav1.Condition{
+			Type:               scyllav1alpha1.DegradedCondition,
+			Status:             metav1.ConditionFalse,
+			Reason:             internalapi.AsExpectedReason,
+			Message:            "",
+			ObservedGeneration: ss.Generation,
+		},
+	)
+	if err != nil {
+		aggregationErrs = append(aggregationErrs, fmt.Errorf("can't aggregate degraded conditions: %w", err))
+	}
+
+	if len(aggregationErrs) > 0 {
+		errs = append(errs, aggregationErrs...)
+		return apimachineryutilerrors.NewAggregate(errs)
+	}
+
+	apimeta.SetStatusCondition(&status.Conditions, progressingCondition)
+	apimeta.SetStatusCondition(&status.Conditions, degradedCondition)
+	apimeta.SetStatusCondition(&status.Conditions, makeAvailableCondition(status, ss.Generation))
+
+	err = ssc.updateStatus(ctx, ss, status)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't update status: %w", err))
+	}
+
+	// Expiration isn't observable, requeue once the snapshot is due to expire.
+	if status.ExpirationTime != nil {
+		ssc.queue.AddAfter(key, time.Until(status.ExpirationTime.Time))
+	}
+
+	return apimachineryutilerrors.NewAggregate(errs)
+}
+
+func makeAvailableCondition(status *scyllav1alpha1.ScyllaDBSnapshotStatus, generation int64) metav1.Condition {
+	if status.CompletionTime == nil {
+		return metav1.Condition{
+			Type:               scyllav1alpha1.AvailableCondition,
+			Status:             metav1.ConditionFalse,
+			Reason:             "SnapshotNotTaken",
+			Message:            "Snapshot hasn't been taken on all nodes.",
+			ObservedGeneration: generation,
+		}
+	}
+
+	return metav1.Condition{
+		Type:               scyllav1alpha1.AvailableCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             internalapi.AsExpectedReason,
+		Message:            "",
+		ObservedGeneration: generation,
+	}
+}
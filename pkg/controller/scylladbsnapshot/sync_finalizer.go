@@ -0,0 +1,115 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbsnapshot
+
+import (
+	"context"
+	"fmt"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+// syncFinalizer removes the snapshot from the ScyllaDB nodes before the ScyllaDBSnapshot is deleted.
+func (ssc *Controller) syncFinalizer(ctx context.Context, ss *scyllav1alpha1.ScyllaDBSnapshot) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if !ssc.hasFinalizer(ss.GetFinalizers()) {
+		klog.V(4).InfoS("Object is already finalized", "ScyllaDBSnapshot", klog.KObj(ss), "UID", ss.UID)
+		return progressingConditions, nil
+	}
+
+	klog.V(4).InfoS("Finalizing object", "ScyllaDBSnapshot", klog.KObj(ss), "UID", ss.UID)
+
+	if ss.Status.SnapshotTag == nil || len(ss.Status.Nodes) == 0 {
+		klog.V(4).InfoS("Snapshot hasn't been taken, removing finalizer.", "ScyllaDBSnapshot", klog.KObj(ss), "UID", ss.UID)
+		return progressingConditions, ssc.removeFinalizer(ctx, ss)
+	}
+
+	sdc, err := ssc.scyllaDBDatacenterLister.ScyllaDBDatacenters(ss.Namespace).Get(ss.Spec.ScyllaDBDatacenterRef.Name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return progressingConditions, fmt.Errorf("can't get ScyllaDBDatacenter %q: %w", naming.ManualRef(ss.Namespace, ss.Spec.ScyllaDBDatacenterRef.Name), err)
+		}
+
+		klog.V(4).InfoS("ScyllaDBDatacenter referenced by ScyllaDBSnapshot does not exist, removing finalizer.", "ScyllaDBSnapshot", klog.KObj(ss), "UID", ss.UID, "ScyllaDBDatacenter", klog.KRef(ss.Namespace, ss.Spec.ScyllaDBDatacenterRef.Name))
+		return progressingConditions, ssc.removeFinalizer(ctx, ss)
+	}
+
+	// Nodes which have been scaled down since the snapshot was taken no longer hold it.
+	var nodeStatuses []scyllav1alpha1.ScyllaDBSnapshotNodeStatus
+	for _, nodeStatus := range ss.Status.Nodes {
+		_, err = ssc.podLister.Pods(ss.Namespace).Get(nodeStatus.PodName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+
+			return progressingConditions, fmt.Errorf("can't get pod %q: %w", naming.ManualRef(ss.Namespace, nodeStatus.PodName), err)
+		}
+
+		nodeStatuses = append(nodeStatuses, nodeStatus)
+	}
+
+	if len(nodeStatuses) != 0 {
+		hosts, err := ssc.getScyllaDBHosts(sdc, nodeStatuses)
+		if err != nil {
+			return progressingConditions, err
+		}
+
+		scyllaClient, err := controllerhelpers.NewScyllaClientForScyllaDBDatacenter(sdc, hosts, ssc.secretLister)
+		if err != nil {
+			return progressingConditions, err
+		}
+		defer scyllaClient.Close()
+
+		err = controllerhelpers.RemoveSnapshots(ctx, scyllaClient, hosts, []string{*ss.Status.SnapshotTag})
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't remove snapshot %q: %w", *ss.Status.SnapshotTag, err)
+		}
+
+		klog.V(2).InfoS("Removed snapshot", "ScyllaDBSnapshot", klog.KObj(ss), "SnapshotTag", *ss.Status.SnapshotTag)
+	}
+
+	return progressingConditions, ssc.removeFinalizer(ctx, ss)
+}
+
+func (ssc *Controller) hasFinalizer(finalizers []string) bool {
+	return oslices.ContainsItem(finalizers, naming.ScyllaDBSnapshotFinalizer)
+}
+
+func (ssc *Controller) addFinalizer(ctx context.Context, ss *scyllav1alpha1.ScyllaDBSnapshot) error {
+	patch, err := controllerhelpers.AddFinalizerPatch(ss, naming.ScyllaDBSnapshotFinalizer)
+	if err != nil {
+		return fmt.Errorf("can't create add finalizer patch: %w", err)
+	}
+
+	_, err = ssc.scyllaClient.ScyllaDBSnapshots(ss.Namespace).Patch(ctx, ss.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("can't patch ScyllaDBSnapshot %q: %w", naming.ObjRef(ss), err)
+	}
+
+	klog.V(2).InfoS("Added finalizer to ScyllaDBSnapshot", "ScyllaDBSnapshot", klog.KObj(ss))
+	return nil
+}
+
+func (ssc *Controller) removeFinalizer(ctx context.Context, ss *scyllav1alpha1.ScyllaDBSnapshot) error {
+	patch, err := controllerhelpers.RemoveFinalizerPatch(ss, naming.ScyllaDBSnapshotFinalizer)
+	if err != nil {
+		return fmt.Errorf("can't create remove finalizer patch: %w", err)
+	}
+
+	_, err = ssc.scyllaClient.ScyllaDBSnapshots(ss.Namespace).Patch(ctx, ss.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("can't patch ScyllaDBSnapshot %q: %w", naming.ObjRef(ss), err)
+	}
+
+	klog.V(2).InfoS("Removed finalizer from ScyllaDBSnapshot", "ScyllaDBSnapshot", klog.KObj(ss))
+	return nil
+}
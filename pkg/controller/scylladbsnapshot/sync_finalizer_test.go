@@ -0,0 +1,191 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbsnapshot
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scyllafake "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned/fake"
+	scyllav1alpha1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestController_syncFinalizer(t *testing.T) {
+	t.Parallel()
+
+	newScyllaDBSnapshot := func() *scyllav1alpha1.ScyllaDBSnapshot {
+		return &scyllav1alpha1.ScyllaDBSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "snapshot",
+				Namespace:         "scylla",
+				UID:               "uid",
+				DeletionTimestamp: pointer.Ptr(metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))),
+				Finalizers:        []string{naming.ScyllaDBSnapshotFinalizer},
+			},
+			Spec: scyllav1alpha1.ScyllaDBSnapshotSpec{
+				ScyllaDBDatacenterRef: scyllav1alpha1.LocalObjectReference{
+					Name: "basic",
+				},
+			},
+			Status: scyllav1alpha1.ScyllaDBSnapshotStatus{
+				SnapshotTag: pointer.Ptr("so_snapshot_uid"),
+				Nodes: []scyllav1alpha1.ScyllaDBSnapshotNodeStatus{
+					{
+						RackName: "a",
+						PodName:  "basic-dc1-a-0",
+					},
+				},
+			},
+		}
+	}
+
+	newScyllaDBDatacenter := func() *scyllav1alpha1.ScyllaDBDatacenter {
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "basic",
+				Namespace:  "scylla",
+				Generation: 1,
+			},
+			Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+				ClusterName:    "basic",
+				DatacenterName: pointer.Ptr("dc1"),
+				Racks: []scyllav1alpha1.RackSpec{
+					{
+						Name: "a",
+						RackTemplate: scyllav1alpha1.RackTemplate{
+							Nodes: pointer.Ptr[int32](2),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name                string
+		scyllaDBSnapshot    *scyllav1alpha1.ScyllaDBSnapshot
+		scyllaDBDatacenters []*scyllav1alpha1.ScyllaDBDatacenter
+		pods                []*corev1.Pod
+		expectedActions     []string
+		expectedErr         error
+	}{
+		{
+			name: "already finalized object is left alone",
+			scyllaDBSnapshot: func() *scyllav1alpha1.ScyllaDBSnapshot {
+				ss := newScyllaDBSnapshot()
+				ss.Finalizers = []string{"other"}
+				return ss
+			}(),
+			scyllaDBDatacenters: []*scyllav1alpha1.ScyllaDBDatacenter{newScyllaDBDatacenter()},
+			expectedActions:     nil,
+			expectedErr:         nil,
+		},
+		{
+			name: "finalizer is removed when the snapshot hasn't been taken",
+			scyllaDBSnapshot: func() *scyllav1alpha1.ScyllaDBSnapshot {
+				ss := newScyllaDBSnapshot()
+				ss.Status.SnapshotTag = nil
+				ss.Status.Nodes = nil
+				return ss
+			}(),
+			scyllaDBDatacenters: []*scyllav1alpha1.ScyllaDBDatacenter{newScyllaDBDatacenter()},
+			expectedActions:     []string{"patch scylladbsnapshots snapshot"},
+			expectedErr:         nil,
+		},
+		{
+			name:                "finalizer is removed when the ScyllaDBDatacenter doesn't exist",
+			scyllaDBSnapshot:    newScyllaDBSnapshot(),
+			scyllaDBDatacenters: nil,
+			expectedActions:     []string{"patch scylladbsnapshots snapshot"},
+			expectedErr:         nil,
+		},
+		{
+			name:                "finalizer is removed when the snapshotted nodes no longer exist",
+			scyllaDBSnapshot:    newScyllaDBSnapshot(),
+			scyllaDBDatacenters: []*scyllav1alpha1.ScyllaDBDatacenter{newScyllaDBDatacenter()},
+			pods:                nil,
+			expectedActions:     []string{"patch scylladbsnapshots snapshot"},
+			expectedErr:         nil,
+		},
+		{
+			name:                "snapshot removal error keeps the finalizer",
+			scyllaDBSnapshot:    newScyllaDBSnapshot(),
+			scyllaDBDatacenters: []*scyllav1alpha1.ScyllaDBDatacenter{newScyllaDBDatacenter()},
+			pods: []*corev1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "basic-dc1-a-0",
+						Namespace: "scylla",
+					},
+				},
+			},
+			expectedActions: nil,
+			expectedErr:     fmt.Errorf(`can't get service "scylla/basic-dc1-a-0": service "basic-dc1-a-0" not found`),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, ctxCancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer ctxCancel()
+
+			sdcCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			for _, sdc := range tc.scyllaDBDatacenters {
+				err := sdcCache.Add(sdc)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			podCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			for _, pod := range tc.pods {
+				err := podCache.Add(pod)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			emptyCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+
+			scyllaClient := scyllafake.NewSimpleClientset(tc.scyllaDBSnapshot)
+			ssc := &Controller{
+				scyllaClient:             scyllaClient.ScyllaV1alpha1(),
+				scyllaDBDatacenterLister: scyllav1alpha1listers.NewScyllaDBDatacenterLister(sdcCache),
+				podLister:                corev1listers.NewPodLister(podCache),
+				serviceLister:            corev1listers.NewServiceLister(emptyCache),
+				secretLister:             corev1listers.NewSecretLister(emptyCache),
+				eventRecorder:            record.NewFakeRecorder(10),
+			}
+
+			_, err := ssc.syncFinalizer(ctx, tc.scyllaDBSnapshot)
+			if !cmp.Equal(fmt.Sprint(err), fmt.Sprint(tc.expectedErr)) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			var gotActions []string
+			for _, action := range scyllaClient.Actions() {
+				namedAction, ok := action.(interface{ GetName() string })
+				if !ok {
+					t.Fatalf("unexpected action %#v", action)
+				}
+				gotActions = append(gotActions, fmt.Sprintf("%s %s %s", action.GetVerb(), action.GetResource().Resource, namedAction.GetName()))
+			}
+			if !cmp.Equal(gotActions, tc.expectedActions) {
+				t.Errorf("expected and got actions differ:\n%s", cmp.Diff(tc.expectedActions, gotActions))
+			}
+		})
+	}
+}
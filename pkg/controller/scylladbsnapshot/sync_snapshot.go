@@ -0,0 +1,158 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbsnapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/util/parallel"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+func (ssc *Controller) syncSnapshot(ctx context.Context, ss *scyllav1alpha1.ScyllaDBSnapshot, status *scyllav1alpha1.ScyllaDBSnapshotStatus) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if status.CompletionTime != nil {
+		return progressingConditions, ssc.syncExpiration(ctx, ss, status)
+	}
+
+	sdc, err := ssc.scyllaDBDatacenterLister.ScyllaDBDatacenters(ss.Namespace).Get(ss.Spec.ScyllaDBDatacenterRef.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               snapshotControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForScyllaDBDatacenter",
+				Message:            fmt.Sprintf("Waiting for ScyllaDBDatacenter %q to exist.", naming.ManualRef(ss.Namespace, ss.Spec.ScyllaDBDatacenterRef.Name)),
+				ObservedGeneration: ss.Generation,
+			})
+			return progressingConditions, nil
+		}
+
+		return progressingConditions, fmt.Errorf("can't get ScyllaDBDatacenter %q: %w", naming.ManualRef(ss.Namespace, ss.Spec.ScyllaDBDatacenterRef.Name), err)
+	}
+
+	if len(status.Nodes) == 0 {
+		rolledOut, err := controllerhelpers.IsScyllaDBDatacenterRolledOut(sdc)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't check if ScyllaDBDatacenter %q is rolled out: %w", naming.ObjRef(sdc), err)
+		}
+
+		if !rolledOut {
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               snapshotControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForScyllaDBDatacenterRollout",
+				Message:            fmt.Sprintf("Waiting for ScyllaDBDatacenter %q to roll out.", naming.ObjRef(sdc)),
+				ObservedGeneration: ss.Generation,
+			})
+			return progressingConditions, nil
+		}
+
+		status.Nodes, err = makeNodeStatuses(sdc)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't make node statuses: %w", err)
+		}
+		status.SnapshotTag = pointer.Ptr(scyllaDBSnapshotTag(ss))
+	}
+
+	hosts, err := ssc.getScyllaDBHosts(sdc, status.Nodes)
+	if err != nil {
+		return progressingConditions, err
+	}
+
+	scyllaClient, err := controllerhelpers.NewScyllaClientForScyllaDBDatacenter(sdc, hosts, ssc.secretLister)
+	if err != nil {
+		return progressingConditions, err
+	}
+	defer scyllaClient.Close()
+
+	existingKeyspaces, err := scyllaClient.Keyspaces(ctx)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't list keyspaces: %w", err)
+	}
+
+	keyspaces, err := getSnapshotKeyspaces(ss.Spec.Keyspaces, existingKeyspaces)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't get keyspaces to snapshot: %w", err)
+	}
+
+	snapshotTag := *status.SnapshotTag
+
+	klog.V(2).InfoS("Taking ScyllaDB snapshot", "ScyllaDBSnapshot", klog.KObj(ss), "SnapshotTag", snapshotTag)
+	// Every goroutine only updates the status of its own node.
+	err = parallel.ForEach(len(status.Nodes), func(i int) error {
+		nodeStatus := &status.Nodes[i]
+		if nodeStatus.SnapshotTime != nil {
+			return nil
+		}
+
+		err := controllerhelpers.SnapshotKeyspaceTables(ctx, scyllaClient, hosts[i], keyspaces, snapshotTag)
+		if err != nil {
+			nodeStatus.Error = pointer.Ptr(err.Error())
+			return fmt.Errorf("can't take snapshot on node %q: %w", naming.ManualRef(ss.Namespace, nodeStatus.PodName), err)
+		}
+
+		nodeStatus.SnapshotTime = pointer.Ptr(metav1.Now())
+		nodeStatus.Error = nil
+
+		return nil
+	})
+	if err != nil {
+		return progressingConditions, err
+	}
+	klog.V(2).InfoS("Took ScyllaDB snapshot", "ScyllaDBSnapshot", klog.KObj(ss), "SnapshotTag", snapshotTag)
+
+	status.CompletionTime = pointer.Ptr(metav1.Now())
+	status.ExpirationTime = getExpirationTime(*status.CompletionTime, ss.Spec.TTL)
+	ssc.eventRecorder.Eventf(ss, corev1.EventTypeNormal, "SnapshotTaken", "Took snapshot %q on %d node(s) of ScyllaDBDatacenter %q", snapshotTag, len(status.Nodes), naming.ObjRef(sdc))
+
+	return progressingConditions, nil
+}
+
+// syncExpiration deletes the ScyllaDBSnapshot once its TTL expires. The snapshot itself is removed from the nodes by the finalizer.
+func (ssc *Controller) syncExpiration(ctx context.Context, ss *scyllav1alpha1.ScyllaDBSnapshot, status *scyllav1alpha1.ScyllaDBSnapshotStatus) error {
+	// TTL can be changed after the snapshot is taken.
+	status.ExpirationTime = getExpirationTime(*status.CompletionTime, ss.Spec.TTL)
+	if status.ExpirationTime == nil || time.Now().Before(status.ExpirationTime.Time) {
+		return nil
+	}
+
+	klog.V(2).InfoS("ScyllaDBSnapshot has expired, deleting it", "ScyllaDBSnapshot", klog.KObj(ss), "ExpirationTime", status.ExpirationTime)
+	err := ssc.scyllaClient.ScyllaDBSnapshots(ss.Namespace).Delete(ctx, ss.Name, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{
+			UID: &ss.UID,
+		},
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("can't delete expired ScyllaDBSnapshot %q: %w", naming.ObjRef(ss), err)
+	}
+
+	ssc.eventRecorder.Eventf(ss, corev1.EventTypeNormal, "SnapshotExpired", "Snapshot %q has expired", *status.SnapshotTag)
+
+	return nil
+}
+
+// getScyllaDBHosts returns the addresses of the ScyllaDB nodes in the order of the node statuses.
+func (ssc *Controller) getScyllaDBHosts(sdc *scyllav1alpha1.ScyllaDBDatacenter, nodeStatuses []scyllav1alpha1.ScyllaDBSnapshotNodeStatus) ([]string, error) {
+	hosts := make([]string, 0, len(nodeStatuses))
+	for _, nodeStatus := range nodeStatuses {
+		host, err := controllerhelpers.GetScyllaHostForPodName(sdc, nodeStatus.PodName, ssc.serviceLister, ssc.podLister)
+		if err != nil {
+			return nil, err
+		}
+
+		hosts = append(hosts, host)
+	}
+
+	return hosts, nil
+}
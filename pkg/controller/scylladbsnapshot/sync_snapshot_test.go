@@ -0,0 +1,258 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbsnapshot
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scyllafake "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned/fake"
+	scyllav1alpha1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestController_syncSnapshot(t *testing.T) {
+	t.Parallel()
+
+	completionTime := metav1.NewTime(time.Now().Add(-time.Hour))
+
+	newScyllaDBSnapshot := func() *scyllav1alpha1.ScyllaDBSnapshot {
+		return &scyllav1alpha1.ScyllaDBSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "snapshot",
+				Namespace:  "scylla",
+				UID:        "uid",
+				Generation: 1,
+			},
+			Spec: scyllav1alpha1.ScyllaDBSnapshotSpec{
+				ScyllaDBDatacenterRef: scyllav1alpha1.LocalObjectReference{
+					Name: "basic",
+				},
+			},
+		}
+	}
+
+	newCompletedStatus := func() *scyllav1alpha1.ScyllaDBSnapshotStatus {
+		return &scyllav1alpha1.ScyllaDBSnapshotStatus{
+			SnapshotTag: pointer.Ptr("so_snapshot_uid"),
+			Nodes: []scyllav1alpha1.ScyllaDBSnapshotNodeStatus{
+				{
+					RackName:     "a",
+					PodName:      "basic-dc1-a-0",
+					SnapshotTime: pointer.Ptr(completionTime),
+				},
+			},
+			CompletionTime: pointer.Ptr(completionTime),
+		}
+	}
+
+	newScyllaDBDatacenter := func() *scyllav1alpha1.ScyllaDBDatacenter {
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "basic",
+				Namespace:  "scylla",
+				Generation: 1,
+			},
+			Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+				ClusterName:    "basic",
+				DatacenterName: pointer.Ptr("dc1"),
+				Racks: []scyllav1alpha1.RackSpec{
+					{
+						Name: "a",
+						RackTemplate: scyllav1alpha1.RackTemplate{
+							Nodes: pointer.Ptr[int32](2),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	newRolledOutScyllaDBDatacenter := func() *scyllav1alpha1.ScyllaDBDatacenter {
+		sdc := newScyllaDBDatacenter()
+		sdc.Status.Conditions = []metav1.Condition{
+			{
+				Type:               scyllav1alpha1.AvailableCondition,
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: 1,
+			},
+			{
+				Type:               scyllav1alpha1.ProgressingCondition,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: 1,
+			},
+			{
+				Type:               scyllav1alpha1.DegradedCondition,
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: 1,
+			},
+		}
+		return sdc
+	}
+
+	tt := []struct {
+		name                       string
+		scyllaDBSnapshot           *scyllav1alpha1.ScyllaDBSnapshot
+		status                     *scyllav1alpha1.ScyllaDBSnapshotStatus
+		scyllaDBDatacenters        []*scyllav1alpha1.ScyllaDBDatacenter
+		expectedStatus             *scyllav1alpha1.ScyllaDBSnapshotStatus
+		expectedProgressingReasons []string
+		expectedActions            []string
+		expectedErr                error
+	}{
+		{
+			name:                       "waits for the ScyllaDBDatacenter to exist",
+			scyllaDBSnapshot:           newScyllaDBSnapshot(),
+			status:                     &scyllav1alpha1.ScyllaDBSnapshotStatus{},
+			scyllaDBDatacenters:        nil,
+			expectedStatus:             &scyllav1alpha1.ScyllaDBSnapshotStatus{},
+			expectedProgressingReasons: []string{"WaitingForScyllaDBDatacenter"},
+			expectedActions:            nil,
+			expectedErr:                nil,
+		},
+		{
+			name:                       "waits for the ScyllaDBDatacenter to roll out",
+			scyllaDBSnapshot:           newScyllaDBSnapshot(),
+			status:                     &scyllav1alpha1.ScyllaDBSnapshotStatus{},
+			scyllaDBDatacenters:        []*scyllav1alpha1.ScyllaDBDatacenter{newScyllaDBDatacenter()},
+			expectedStatus:             &scyllav1alpha1.ScyllaDBSnapshotStatus{},
+			expectedProgressingReasons: []string{"WaitingForScyllaDBDatacenterRollout"},
+			expectedActions:            nil,
+			expectedErr:                nil,
+		},
+		{
+			name:                "records the nodes and the tag before taking the snapshot",
+			scyllaDBSnapshot:    newScyllaDBSnapshot(),
+			status:              &scyllav1alpha1.ScyllaDBSnapshotStatus{},
+			scyllaDBDatacenters: []*scyllav1alpha1.ScyllaDBDatacenter{newRolledOutScyllaDBDatacenter()},
+			expectedStatus: &scyllav1alpha1.ScyllaDBSnapshotStatus{
+				SnapshotTag: pointer.Ptr("so_snapshot_uid"),
+				Nodes: []scyllav1alpha1.ScyllaDBSnapshotNodeStatus{
+					{
+						RackName: "a",
+						PodName:  "basic-dc1-a-0",
+					},
+					{
+						RackName: "a",
+						PodName:  "basic-dc1-a-1",
+					},
+				},
+			},
+			expectedProgressingReasons: nil,
+			expectedActions:            nil,
+			expectedErr:                fmt.Errorf(`can't get service "scylla/basic-dc1-a-0": service "basic-dc1-a-0" not found`),
+		},
+		{
+			name:                       "completed snapshot without TTL is retained",
+			scyllaDBSnapshot:           newScyllaDBSnapshot(),
+			status:                     newCompletedStatus(),
+			scyllaDBDatacenters:        []*scyllav1alpha1.ScyllaDBDatacenter{newRolledOutScyllaDBDatacenter()},
+			expectedStatus:             newCompletedStatus(),
+			expectedProgressingReasons: nil,
+			expectedActions:            nil,
+			expectedErr:                nil,
+		},
+		{
+			name: "completed snapshot is retained until its TTL expires",
+			scyllaDBSnapshot: func() *scyllav1alpha1.ScyllaDBSnapshot {
+				ss := newScyllaDBSnapshot()
+				ss.Spec.TTL = &metav1.Duration{Duration: 2 * time.Hour}
+				return ss
+			}(),
+			status:              newCompletedStatus(),
+			scyllaDBDatacenters: []*scyllav1alpha1.ScyllaDBDatacenter{newRolledOutScyllaDBDatacenter()},
+			expectedStatus: func() *scyllav1alpha1.ScyllaDBSnapshotStatus {
+				status := newCompletedStatus()
+				status.ExpirationTime = pointer.Ptr(metav1.NewTime(completionTime.Add(2 * time.Hour)))
+				return status
+			}(),
+			expectedProgressingReasons: nil,
+			expectedActions:            nil,
+			expectedErr:                nil,
+		},
+		{
+			name: "expired snapshot is deleted",
+			scyllaDBSnapshot: func() *scyllav1alpha1.ScyllaDBSnapshot {
+				ss := newScyllaDBSnapshot()
+				ss.Spec.TTL = &metav1.Duration{Duration: time.Minute}
+				return ss
+			}(),
+			status:              newCompletedStatus(),
+			scyllaDBDatacenters: nil,
+			expectedStatus: func() *scyllav1alpha1.ScyllaDBSnapshotStatus {
+				status := newCompletedStatus()
+				status.ExpirationTime = pointer.Ptr(metav1.NewTime(completionTime.Add(time.Minute)))
+				return status
+			}(),
+			expectedProgressingReasons: nil,
+			expectedActions:            []string{"delete scylladbsnapshots snapshot"},
+			expectedErr:                nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, ctxCancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer ctxCancel()
+
+			sdcCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			for _, sdc := range tc.scyllaDBDatacenters {
+				err := sdcCache.Add(sdc)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			emptyCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+
+			scyllaClient := scyllafake.NewSimpleClientset(tc.scyllaDBSnapshot)
+			ssc := &Controller{
+				scyllaClient:             scyllaClient.ScyllaV1alpha1(),
+				scyllaDBDatacenterLister: scyllav1alpha1listers.NewScyllaDBDatacenterLister(sdcCache),
+				podLister:                corev1listers.NewPodLister(emptyCache),
+				serviceLister:            corev1listers.NewServiceLister(emptyCache),
+				secretLister:             corev1listers.NewSecretLister(emptyCache),
+				eventRecorder:            record.NewFakeRecorder(10),
+			}
+
+			progressingConditions, err := ssc.syncSnapshot(ctx, tc.scyllaDBSnapshot, tc.status)
+			if !cmp.Equal(fmt.Sprint(err), fmt.Sprint(tc.expectedErr)) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			var gotProgressingReasons []string
+			for _, c := range progressingConditions {
+				gotProgressingReasons = append(gotProgressingReasons, c.Reason)
+			}
+			if !cmp.Equal(gotProgressingReasons, tc.expectedProgressingReasons) {
+				t.Errorf("expected and got progressing reasons differ:\n%s", cmp.Diff(tc.expectedProgressingReasons, gotProgressingReasons))
+			}
+
+			if !cmp.Equal(tc.status, tc.expectedStatus) {
+				t.Errorf("expected and got status differ:\n%s", cmp.Diff(tc.expectedStatus, tc.status))
+			}
+
+			var gotActions []string
+			for _, action := range scyllaClient.Actions() {
+				namedAction, ok := action.(interface{ GetName() string })
+				if !ok {
+					t.Fatalf("unexpected action %#v", action)
+				}
+				gotActions = append(gotActions, fmt.Sprintf("%s %s %s", action.GetVerb(), action.GetResource().Resource, namedAction.GetName()))
+			}
+			if !cmp.Equal(gotActions, tc.expectedActions) {
+				t.Errorf("expected and got actions differ:\n%s", cmp.Diff(tc.expectedActions, gotActions))
+			}
+		})
+	}
+}
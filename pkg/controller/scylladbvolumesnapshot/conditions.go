@@ -0,0 +1,10 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbvolumesnapshot
+
+const (
+	volumeSnapshotControllerProgressingCondition        = "VolumeSnapshotControllerProgressing"
+	volumeSnapshotControllerDegradedCondition           = "VolumeSnapshotControllerDegraded"
+	scyllaDBVolumeSnapshotFinalizerProgressingCondition = "ScyllaDBVolumeSnapshotFinalizerProgressing"
+	scyllaDBVolumeSnapshotFinalizerDegradedCondition    = "ScyllaDBVolumeSnapshotFinalizerDegraded"
+)
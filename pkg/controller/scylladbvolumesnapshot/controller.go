@@ -0,0 +1,290 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbvolumesnapshot
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scyllav1alpha1client "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned/typed/scylla/v1alpha1"
+	scyllav1alpha1informers "github.com/scylladb/scylla-operator/pkg/client/scylla/informers/externalversions/scylla/v1alpha1"
+	scyllav1alpha1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/controllertools"
+	"github.com/scylladb/scylla-operator/pkg/kubeinterfaces"
+	"github.com/scylladb/scylla-operator/pkg/scheme"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	apimachineryutilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	apimachineryutilwait "k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	corev1informers "k8s.io/client-go/informers/core/v1"
+	"k8s.io/client-go/kubernetes"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+)
+
+const (
+	ControllerName = "ScyllaDBVolumeSnapshotController"
+
+	// maxSyncDuration enforces preemption. Do not raise the value! Controllers shouldn't actively wait,
+	// but rather use the queue.
+	// Flushing memtables and taking snapshots on every node can take a while, so this needs to be higher than usual.
+	maxSyncDuration = 2 * time.Minute
+
+	// volumeSnapshotPollInterval is the interval of polling VolumeSnapshots for their readiness.
+	// VolumeSnapshots aren't watched, as the snapshot CRDs don't have to be installed in the cluster.
+	volumeSnapshotPollInterval = 10 * time.Second
+)
+
+var (
+	keyFunc                             = cache.DeletionHandlingMetaNamespaceKeyFunc
+	scyllaDBVolumeSnapshotControllerGVK = scyllav1alpha1.GroupVersion.WithKind("ScyllaDBVolumeSnapshot")
+	volumeSnapshotGVR                   = schema.GroupVersionResource{Group: "snapshot.storage.k8s.io", Version: "v1", Resource: "volumesnapshots"}
+	volumeSnapshotGVK                   = volumeSnapshotGVR.GroupVersion().WithKind("VolumeSnapshot")
+)
+
+type Controller struct {
+	kubeClient    kubernetes.Interface
+	scyllaClient  scyllav1alpha1client.ScyllaV1alpha1Interface
+	dynamicClient dynamic.Interface
+
+	scyllaDBVolumeSnapshotLister scyllav1alpha1listers.ScyllaDBVolumeSnapshotLister
+	scyllaDBDatacenterLister     scyllav1alpha1listers.ScyllaDBDatacenterLister
+	serviceLister                corev1listers.ServiceLister
+	podLister                    corev1listers.PodLister
+	secretLister                 corev1listers.SecretLister
+
+	cachesToSync []cache.InformerSynced
+
+	eventRecorder record.EventRecorder
+
+	queue    workqueue.TypedRateLimitingInterface[string]
+	handlers *controllerhelpers.Handlers[*scyllav1alpha1.ScyllaDBVolumeSnapshot]
+}
+
+func NewController(
+	kubeClient kubernetes.Interface,
+	scyllaClient scyllav1alpha1client.ScyllaV1alpha1Interface,
+	dynamicClient dynamic.Interface,
+	scyllaDBVolumeSnapshotInformer scyllav1alpha1informers.ScyllaDBVolumeSnapshotInformer,
+	scyllaDBDatacenterInformer scyllav1alpha1informers.ScyllaDBDatacenterInformer,
+	serviceInformer corev1informers.ServiceInformer,
+	podInformer corev1informers.PodInformer,
+	secretInformer corev1informers.SecretInformer,
+) (*Controller, error) {
+	eventBroadcaster := record.NewBroadcaster()
+	eventBroadcaster.StartStructuredLogging(0)
+	eventBroadcaster.StartRecordingToSink(&corev1client.EventSinkImpl{Interface: kubeClient.CoreV1().Events("")})
+
+	svsc := &Controller{
+		kubeClient:    kubeClient,
+		scyllaClient:  scyllaClient,
+		dynamicClient: dynamicClient,
+
+		scyllaDBVolumeSnapshotLister: scyllaDBVolumeSnapshotInformer.Lister(),
+		scyllaDBDatacenterLister:     scyllaDBDatacenterInformer.Lister(),
+		serviceLister:                serviceInformer.Lister(),
+		podLister:                    podInformer.Lister(),
+		secretLister:                 secretInformer.Lister(),
+
+		cachesToSync: []cache.InformerSynced{
+			scyllaDBVolumeSnapshotInformer.Informer().HasSynced,
+			scyllaDBDatacenterInformer.Informer().HasSynced,
+			serviceInformer.Informer().HasSynced,
+			podInformer.Informer().HasSynced,
+			secretInformer.Informer().HasSynced,
+		},
+
+		eventRecorder: eventBroadcaster.NewRecorder(scheme.Scheme, corev1.EventSource{Component: "scylladbvolumesnapshot-controller"}),
+
+		queue: workqueue.NewTypedRateLimitingQueueWithConfig(
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{
+				Name: "scylladbvolumesnapshot",
+			},
+		),
+	}
+
+	var err error
+	svsc.handlers, err = controllerhelpers.NewHandlers[*scyllav1alpha1.ScyllaDBVolumeSnapshot](
+		svsc.queue,
+		keyFunc,
+		scheme.Scheme,
+		scyllaDBVolumeSnapshotControllerGVK,
+		kubeinterfaces.NamespacedGetList[*scyllav1alpha1.ScyllaDBVolumeSnapshot]{
+			GetFunc: func(namespace, name string) (*scyllav1alpha1.ScyllaDBVolumeSnapshot, error) {
+				return svsc.scyllaDBVolumeSnapshotLister.ScyllaDBVolumeSnapshots(namespace).Get(name)
+			},
+			ListFunc: func(namespace string, selector labels.Selector) (ret []*scyllav1alpha1.ScyllaDBVolumeSnapshot, err error) {
+				return svsc.scyllaDBVolumeSnapshotLister.ScyllaDBVolumeSnapshots(namespace).List(selector)
+			},
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("can't create handlers: %w", err)
+	}
+
+	scyllaDBVolumeSnapshotInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    svsc.addScyllaDBVolumeSnapshot,
+		UpdateFunc: svsc.updateScyllaDBVolumeSnapshot,
+		DeleteFunc: svsc.deleteScyllaDBVolumeSnapshot,
+	})
+
+	scyllaDBDatacenterInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    svsc.addScyllaDBDatacenter,
+		UpdateFunc: svsc.updateScyllaDBDatacenter,
+		DeleteFunc: svsc.deleteScyllaDBDatacenter,
+	})
+
+	return svsc, nil
+}
+
+func (svsc *Controller) processNextItem(ctx context.Context) bool {
+	key, quit := svsc.queue.Get()
+	if quit {
+		return false
+	}
+	defer svsc.queue.Done(key)
+
+	ctx, cancel := context.WithTimeout(ctx, maxSyncDuration)
+	defer cancel()
+	err := svsc.sync(ctx, key)
+	// TODO: Do smarter filtering then just Reduce to handle cases like 2 conflict errors.
+	err = apimachineryutilerrors.Reduce(err)
+	switch {
+	case err == nil:
+		svsc.queue.Forget(key)
+		return true
+
+	case apierrors.IsConflict(err):
+		klog.V(2).InfoS("Hit conflict, will retry in a bit", "Key", key, "Error", err)
+
+	case apierrors.IsAlreadyExists(err):
+		klog.V(2).InfoS("Hit already exists, will retry in a bit", "Key", key, "Error", err)
+
+	default:
+		if controllertools.IsNonRetriable(err) {
+			klog.InfoS("Hit non-retriable error. Dropping the item from the queue.", "Error", err)
+			svsc.queue.Forget(key)
+			return true
+		}
+
+		apimachineryutilruntime.HandleError(fmt.Errorf("syncing key '%v' failed: %v", key, err))
+
+	}
+
+	svsc.queue.AddRateLimited(key)
+
+	return true
+}
+
+func (svsc *Controller) runWorker(ctx context.Context) {
+	for svsc.processNextItem(ctx) {
+	}
+}
+
+func (svsc *Controller) Run(ctx context.Context, workers int) {
+	defer apimachineryutilruntime.HandleCrash()
+
+	klog.InfoS("Starting controller", "controller", ControllerName)
+
+	var wg sync.WaitGroup
+	defer func() {
+		klog.InfoS("Shutting down controller", "controller", ControllerName)
+		svsc.queue.ShutDown()
+		wg.Wait()
+		klog.InfoS("Shut down controller", "controller", ControllerName)
+	}()
+
+	if !cache.WaitForNamedCacheSync(ControllerName, ctx.Done(), svsc.cachesToSync...) {
+		return
+	}
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			apimachineryutilwait.UntilWithContext(ctx, svsc.runWorker, time.Second)
+		}()
+	}
+
+	<-ctx.Done()
+}
+
+func (svsc *Controller) addScyllaDBVolumeSnapshot(obj interface{}) {
+	svsc.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.ScyllaDBVolumeSnapshot),
+		svsc.handlers.Enqueue,
+	)
+}
+
+func (svsc *Controller) updateScyllaDBVolumeSnapshot(old, cur interface{}) {
+	svsc.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.ScyllaDBVolumeSnapshot),
+		cur.(*scyllav1alpha1.ScyllaDBVolumeSnapshot),
+		svsc.handlers.Enqueue,
+		svsc.deleteScyllaDBVolumeSnapshot,
+	)
+}
+
+func (svsc *Controller) deleteScyllaDBVolumeSnapshot(obj interface{}) {
+	svsc.handlers.HandleDelete(
+		obj,
+		svsc.handlers.Enqueue,
+	)
+}
+
+func (svsc *Controller) addScyllaDBDatacenter(obj interface{}) {
+	svsc.handlers.HandleAdd(
+		obj.(*scyllav1alpha1.ScyllaDBDatacenter),
+		svsc.enqueueThroughScyllaDBDatacenter(obj.(*scyllav1alpha1.ScyllaDBDatacenter)),
+	)
+}
+
+func (svsc *Controller) updateScyllaDBDatacenter(old, cur interface{}) {
+	svsc.handlers.HandleUpdate(
+		old.(*scyllav1alpha1.ScyllaDBDatacenter),
+		cur.(*scyllav1alpha1.ScyllaDBDatacenter),
+		svsc.enqueueThroughScyllaDBDatacenter(cur.(*scyllav1alpha1.ScyllaDBDatacenter)),
+		svsc.deleteScyllaDBDatacenter,
+	)
+}
+
+func (svsc *Controller) deleteScyllaDBDatacenter(obj interface{}) {
+	sdc, ok := obj.(*scyllav1alpha1.ScyllaDBDatacenter)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			apimachineryutilruntime.HandleError(fmt.Errorf("couldn't get object from tombstone %#v", obj))
+			return
+		}
+
+		sdc, ok = tombstone.Obj.(*scyllav1alpha1.ScyllaDBDatacenter)
+		if !ok {
+			apimachineryutilruntime.HandleError(fmt.Errorf("tombstone contained object that is not a ScyllaDBDatacenter %#v", obj))
+			return
+		}
+	}
+
+	svsc.handlers.HandleDelete(
+		obj,
+		svsc.enqueueThroughScyllaDBDatacenter(sdc),
+	)
+}
+
+func (svsc *Controller) enqueueThroughScyllaDBDatacenter(sdc *scyllav1alpha1.ScyllaDBDatacenter) controllerhelpers.EnqueueFuncType {
+	return svsc.handlers.EnqueueAllFunc(svsc.handlers.EnqueueWithFilterFunc(func(svs *scyllav1alpha1.ScyllaDBVolumeSnapshot) bool {
+		return svs.Spec.ScyllaDBDatacenterRef.Name == sdc.Name
+	}))
+}
@@ -0,0 +1,64 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbvolumesnapshot
+
+import (
+	"fmt"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func scyllaDBSnapshotTag(svs *scyllav1alpha1.ScyllaDBVolumeSnapshot) string {
+	return fmt.Sprintf("so_volumesnapshot_%s", svs.UID)
+}
+
+func volumeSnapshotName(svs *scyllav1alpha1.ScyllaDBVolumeSnapshot, podName string) string {
+	return fmt.Sprintf("%s-%s", svs.Name, podName)
+}
+
+func makeVolumeSnapshot(svs *scyllav1alpha1.ScyllaDBVolumeSnapshot, volumeStatus *scyllav1alpha1.ScyllaDBVolumeSnapshotVolumeStatus) *unstructured.Unstructured {
+	spec := map[string]any{
+		"source": map[string]any{
+			"persistentVolumeClaimName": volumeStatus.PersistentVolumeClaimName,
+		},
+	}
+	if svs.Spec.VolumeSnapshotClassName != nil {
+		spec["volumeSnapshotClassName"] = *svs.Spec.VolumeSnapshotClassName
+	}
+
+	vs := &unstructured.Unstructured{
+		Object: map[string]any{
+			"spec": spec,
+		},
+	}
+	vs.SetGroupVersionKind(volumeSnapshotGVK)
+	vs.SetName(volumeStatus.VolumeSnapshotName)
+	vs.SetNamespace(svs.Namespace)
+	vs.SetLabels(map[string]string{
+		naming.ScyllaDBVolumeSnapshotNameLabel: svs.Name,
+		naming.RackNameLabel:                   volumeStatus.RackName,
+	})
+	vs.SetOwnerReferences([]metav1.OwnerReference{
+		*metav1.NewControllerRef(svs, scyllaDBVolumeSnapshotControllerGVK),
+	})
+
+	return vs
+}
+
+// getVolumeSnapshotReadiness returns whether the VolumeSnapshot is ready to use and the error reported by the snapshot controller, if any.
+func getVolumeSnapshotReadiness(vs *unstructured.Unstructured) (bool, string, error) {
+	readyToUse, _, err := unstructured.NestedBool(vs.Object, "status", "readyToUse")
+	if err != nil {
+		return false, "", fmt.Errorf("can't get readyToUse status: %w", err)
+	}
+
+	errorMessage, _, err := unstructured.NestedString(vs.Object, "status", "error", "message")
+	if err != nil {
+		return false, "", fmt.Errorf("can't get error message: %w", err)
+	}
+
+	return readyToUse, errorMessage, nil
+}
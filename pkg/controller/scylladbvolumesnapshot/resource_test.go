@@ -0,0 +1,178 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbvolumesnapshot
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMakeVolumeSnapshot(t *testing.T) {
+	t.Parallel()
+
+	newScyllaDBVolumeSnapshot := func() *scyllav1alpha1.ScyllaDBVolumeSnapshot {
+		return &scyllav1alpha1.ScyllaDBVolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "snapshot",
+				Namespace: "scylla",
+				UID:       "uid",
+			},
+			Spec: scyllav1alpha1.ScyllaDBVolumeSnapshotSpec{
+				ScyllaDBDatacenterRef: scyllav1alpha1.LocalObjectReference{
+					Name: "basic",
+				},
+			},
+		}
+	}
+
+	volumeStatus := &scyllav1alpha1.ScyllaDBVolumeSnapshotVolumeStatus{
+		RackName:                  "a",
+		PodName:                   "basic-dc1-a-0",
+		PersistentVolumeClaimName: "data-basic-dc1-a-0",
+		VolumeSnapshotName:        "snapshot-basic-dc1-a-0",
+	}
+
+	newExpectedVolumeSnapshot := func() *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]any{
+				"apiVersion": "snapshot.storage.k8s.io/v1",
+				"kind":       "VolumeSnapshot",
+				"metadata": map[string]any{
+					"name":      "snapshot-basic-dc1-a-0",
+					"namespace": "scylla",
+					"labels": map[string]any{
+						"scylla-operator.scylladb.com/scylladbvolumesnapshot-name": "snapshot",
+						"scylla/rack": "a",
+					},
+					"ownerReferences": []any{
+						map[string]any{
+							"apiVersion":         "scylla.scylladb.com/v1alpha1",
+							"kind":               "ScyllaDBVolumeSnapshot",
+							"name":               "snapshot",
+							"uid":                "uid",
+							"controller":         true,
+							"blockOwnerDeletion": true,
+						},
+					},
+				},
+				"spec": map[string]any{
+					"source": map[string]any{
+						"persistentVolumeClaimName": "data-basic-dc1-a-0",
+					},
+				},
+			},
+		}
+	}
+
+	tt := []struct {
+		name                   string
+		scyllaDBVolumeSnapshot *scyllav1alpha1.ScyllaDBVolumeSnapshot
+		expected               *unstructured.Unstructured
+	}{
+		{
+			name:                   "default VolumeSnapshotClass",
+			scyllaDBVolumeSnapshot: newScyllaDBVolumeSnapshot(),
+			expected:               newExpectedVolumeSnapshot(),
+		},
+		{
+			name: "custom VolumeSnapshotClass",
+			scyllaDBVolumeSnapshot: func() *scyllav1alpha1.ScyllaDBVolumeSnapshot {
+				svs := newScyllaDBVolumeSnapshot()
+				svs.Spec.VolumeSnapshotClassName = pointer.Ptr("csi-snapclass")
+				return svs
+			}(),
+			expected: func() *unstructured.Unstructured {
+				vs := newExpectedVolumeSnapshot()
+				vs.Object["spec"].(map[string]any)["volumeSnapshotClassName"] = "csi-snapclass"
+				return vs
+			}(),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := makeVolumeSnapshot(tc.scyllaDBVolumeSnapshot, volumeStatus)
+			if !cmp.Equal(got, tc.expected) {
+				t.Errorf("expected and got VolumeSnapshots differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func TestGetVolumeSnapshotReadiness(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name                 string
+		status               map[string]any
+		expectedReadyToUse   bool
+		expectedErrorMessage string
+		expectedErr          bool
+	}{
+		{
+			name:                 "no status",
+			status:               nil,
+			expectedReadyToUse:   false,
+			expectedErrorMessage: "",
+		},
+		{
+			name: "ready to use",
+			status: map[string]any{
+				"readyToUse": true,
+			},
+			expectedReadyToUse:   true,
+			expectedErrorMessage: "",
+		},
+		{
+			name: "failed",
+			status: map[string]any{
+				"readyToUse": false,
+				"error": map[string]any{
+					"message": "failed to take snapshot",
+				},
+			},
+			expectedReadyToUse:   false,
+			expectedErrorMessage: "failed to take snapshot",
+		},
+		{
+			name: "malformed readyToUse",
+			status: map[string]any{
+				"readyToUse": "true",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			vs := &unstructured.Unstructured{
+				Object: map[string]any{},
+			}
+			if tc.status != nil {
+				vs.Object["status"] = tc.status
+			}
+
+			readyToUse, errorMessage, err := getVolumeSnapshotReadiness(vs)
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("expected error: %t, got %v", tc.expectedErr, err)
+			}
+
+			if readyToUse != tc.expectedReadyToUse {
+				t.Errorf("expected readyToUse %t, got %t", tc.expectedReadyToUse, readyToUse)
+			}
+
+			if errorMessage != tc.expectedErrorMessage {
+				t.Errorf("expected error message %q, got %q", tc.expectedErrorMessage, errorMessage)
+			}
+		})
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbvolumesnapshot
+
+import (
+	"context"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+func (svsc *Controller) calculateStatus(svs *scyllav1alpha1.ScyllaDBVolumeSnapshot) *scyllav1alpha1.ScyllaDBVolumeSnapshotStatus {
+	status := svs.Status.DeepCopy()
+	status.ObservedGeneration = pointer.Ptr(svs.Generation)
+
+	return status
+}
+
+func (svsc *Controller) updateStatus(ctx context.Context, currentSVS *scyllav1alpha1.ScyllaDBVolumeSnapshot, status *scyllav1alpha1.ScyllaDBVolumeSnapshotStatus) error {
+	if apiequality.Semantic.DeepEqual(&currentSVS.Status, status) {
+		return nil
+	}
+
+	svs := currentSVS.DeepCopy()
+	svs.Status = *status
+
+	klog.V(2).InfoS("Updating status", "ScyllaDBVolumeSnapshot", klog.KObj(svs))
+
+	_, err := svsc.scyllaClient.ScyllaDBVolumeSnapshots(svs.Namespace).UpdateStatus(ctx, svs, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	klog.V(2).InfoS("Status updated", "ScyllaDBVolumeSnapshot", klog.KObj(svs))
+
+	return nil
+}
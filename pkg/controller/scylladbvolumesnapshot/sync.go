@@ -0,0 +1,151 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbvolumesnapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/klog/v2"
+)
+
+func (svsc *Controller) sync(ctx context.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		klog.ErrorS(err, "Failed to split meta namespace cache key", "cacheKey", key)
+		return err
+	}
+
+	startTime := time.Now()
+	klog.V(4).InfoS("Started syncing ScyllaDBVolumeSnapshot", "ScyllaDBVolumeSnapshot", klog.KRef(namespace, name), "startTime", startTime)
+	defer func() {
+		klog.V(4).InfoS("Finished syncing ScyllaDBVolumeSnapshot", "ScyllaDBVolumeSnapshot", klog.KRef(namespace, name), "duration", time.Since(startTime))
+	}()
+
+	svs, err := svsc.scyllaDBVolumeSnapshotLister.ScyllaDBVolumeSnapshots(namespace).Get(name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(2).InfoS("ScyllaDBVolumeSnapshot has been deleted", "ScyllaDBVolumeSnapshot", klog.KRef(namespace, name))
+			return nil
+		}
+
+		return fmt.Errorf("can't get ScyllaDBVolumeSnapshot %q: %w", naming.ManualRef(namespace, name), err)
+	}
+
+	status := svsc.calculateStatus(svs)
+
+	if svs.DeletionTimestamp != nil {
+		err = controllerhelpers.RunSync(
+			&status.Conditions,
+			scyllaDBVolumeSnapshotFinalizerProgressingCondition,
+			scyllaDBVolumeSnapshotFinalizerDegradedCondition,
+			svs.Generation,
+			func() ([]metav1.Condition, error) {
+				return svsc.syncFinalizer(ctx, svs)
+			},
+		)
+		return svsc.updateStatus(ctx, svs, status)
+	}
+
+	if !svsc.hasFinalizer(svs.GetFinalizers()) {
+		err = svsc.addFinalizer(ctx, svs)
+		if err != nil {
+			return fmt.Errorf("can't add finalizer: %w", err)
+		}
+		return nil
+	}
+
+	var errs []error
+	err = controllerhelpers.RunSync(
+		&status.Conditions,
+		volumeSnapshotControllerProgressingCondition,
+		volumeSnapshotControllerDegradedCondition,
+		svs.Generation,
+		func() ([]metav1.Condition, error) {
+			return svsc.syncVolumeSnapshots(ctx, svs, status)
+		},
+	)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't sync volume snapshots: %w", err))
+	}
+
+	var aggregationErrs []error
+	progressingCondition, err := controllerhelpers.AggregateStatusConditions(
+		controllerhelpers.FindStatusConditionsWithSuffix(status.Conditions, scyllav1alpha1.ProgressingCondition),
+		metav1.Condition{
+			Type:               scyllav1alpha1.ProgressingCondition,
+			Status:             metav1.ConditionFalse,
+			Reason:             internalapi.AsExpectedReason,
+			Message:            "",
+			ObservedGeneration: svs.Generation,
+		},
+	)
+	if err != nil {
+		aggregationErrs = append(aggregationErrs, fmt.Errorf("can't aggregate progressing conditions: %w", err))
+	}
+
+	degradedCondition, err := controllerhelpers.AggregateStatusConditions(
+		controllerhelpers.FindStatusConditionsWithSuffix(status.Conditions, scyllav1alpha1.DegradedCondition),
+		metav1.Condition{
+			Type:               scyllav1alpha1.DegradedCondition,
+			Status:             metav1.ConditionFalse,
+			Reason:             internalapi.AsExpectedReason,
+			Message:            "",
+			ObservedGeneration: svs.Generation,
+		},
+	)
+	if err != nil {
+		aggregationErrs = append(aggregationErrs, fmt.Errorf("can't aggregate degraded conditions: %w", err))
+	}
+
+	if len(aggregationErrs) > 0 {
+		errs = append(errs, aggregationErrs...)
+		return apimachineryutilerrors.NewAggregate(errs)
+	}
+
+	apimeta.SetStatusCondition(&status.Conditions, progressingCondition)
+	apimeta.SetStatusCondition(&status.Conditions, degradedCondition)
+	apimeta.SetStatusCondition(&status.Conditions, makeAvailableCondition(status, svs.Generation))
+
+	err = svsc.updateStatus(ctx, svs, status)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("can't update status: %w", err))
+	}
+
+	// VolumeSnapshots aren't watched, poll for their readiness.
+	if status.CompletionTime == nil && len(status.VolumeSnapshots) != 0 {
+		svsc.queue.AddAfter(key, volumeSnapshotPollInterval)
+	}
+
+	return apimachineryutilerrors.NewAggregate(errs)
+}
+
+func makeAvailableCondition(status *scyllav1alpha1.ScyllaDBVolumeSnapshotStatus, generation int64) metav1.Condition {
+	if status.CompletionTime == nil {
+		return metav1.Condition{
+			Type:               scyllav1alpha1.AvailableCondition,
+			Status:             metav1.ConditionFalse,
+			Reason:             "VolumeSnapshotsNotReady",
+			Message:            "Not all VolumeSnapshots are ready to use.",
+			ObservedGeneration: generation,
+		}
+	}
+
+	return metav1.Condition{
+		Type:               scyllav1alpha1.AvailableCondition,
+		Status:             metav1.ConditionTrue,
+		Reason:             internalapi.AsExpectedReason,
+		Message:            "",
+		ObservedGeneration: generation,
+	}
+}
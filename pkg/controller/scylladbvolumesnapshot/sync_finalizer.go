@@ -0,0 +1,119 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbvolumesnapshot
+
+import (
+	"context"
+	"fmt"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	oslices "github.com/scylladb/scylla-operator/pkg/helpers/slices"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+// syncFinalizer removes the ScyllaDB snapshot taken for the VolumeSnapshots from the ScyllaDB nodes
+// before the ScyllaDBVolumeSnapshot is deleted. VolumeSnapshots are garbage collected through owner references.
+func (svsc *Controller) syncFinalizer(ctx context.Context, svs *scyllav1alpha1.ScyllaDBVolumeSnapshot) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if !svsc.hasFinalizer(svs.GetFinalizers()) {
+		klog.V(4).InfoS("Object is already finalized", "ScyllaDBVolumeSnapshot", klog.KObj(svs), "UID", svs.UID)
+		return progressingConditions, nil
+	}
+
+	klog.V(4).InfoS("Finalizing object", "ScyllaDBVolumeSnapshot", klog.KObj(svs), "UID", svs.UID)
+
+	// The ScyllaDB snapshot is removed as soon as the VolumeSnapshots are ready.
+	if svs.Status.SnapshotTag == nil || svs.Status.CompletionTime != nil {
+		klog.V(4).InfoS("ScyllaDB snapshot isn't held by the nodes, removing finalizer.", "ScyllaDBVolumeSnapshot", klog.KObj(svs), "UID", svs.UID)
+		return progressingConditions, svsc.removeFinalizer(ctx, svs)
+	}
+
+	sdc, err := svsc.scyllaDBDatacenterLister.ScyllaDBDatacenters(svs.Namespace).Get(svs.Spec.ScyllaDBDatacenterRef.Name)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return progressingConditions, fmt.Errorf("can't get ScyllaDBDatacenter %q: %w", naming.ManualRef(svs.Namespace, svs.Spec.ScyllaDBDatacenterRef.Name), err)
+		}
+
+		klog.V(4).InfoS("ScyllaDBDatacenter referenced by ScyllaDBVolumeSnapshot does not exist, removing finalizer.", "ScyllaDBVolumeSnapshot", klog.KObj(svs), "UID", svs.UID, "ScyllaDBDatacenter", klog.KRef(svs.Namespace, svs.Spec.ScyllaDBDatacenterRef.Name))
+		return progressingConditions, svsc.removeFinalizer(ctx, svs)
+	}
+
+	// The ScyllaDB snapshot is taken before the VolumeSnapshots are created, so their statuses can be missing.
+	var snapshottedPodNames []string
+	if len(svs.Status.VolumeSnapshots) != 0 {
+		for _, volumeStatus := range svs.Status.VolumeSnapshots {
+			snapshottedPodNames = append(snapshottedPodNames, volumeStatus.PodName)
+		}
+	} else {
+		snapshottedPodNames, err = getScyllaDBDatacenterPodNames(sdc)
+		if err != nil {
+			return progressingConditions, err
+		}
+	}
+
+	// Nodes which have been scaled down since the snapshot was taken no longer hold it.
+	var podNames []string
+	for _, podName := range snapshottedPodNames {
+		_, err = svsc.podLister.Pods(svs.Namespace).Get(podName)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+
+			return progressingConditions, fmt.Errorf("can't get pod %q: %w", naming.ManualRef(svs.Namespace, podName), err)
+		}
+
+		podNames = append(podNames, podName)
+	}
+
+	if len(podNames) != 0 {
+		err = svsc.removeScyllaDBSnapshot(ctx, sdc, podNames, *svs.Status.SnapshotTag)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't remove ScyllaDB snapshot %q: %w", *svs.Status.SnapshotTag, err)
+		}
+
+		klog.V(2).InfoS("Removed ScyllaDB snapshot", "ScyllaDBVolumeSnapshot", klog.KObj(svs), "SnapshotTag", *svs.Status.SnapshotTag)
+	}
+
+	return progressingConditions, svsc.removeFinalizer(ctx, svs)
+}
+
+func (svsc *Controller) hasFinalizer(finalizers []string) bool {
+	return oslices.ContainsItem(finalizers, naming.ScyllaDBVolumeSnapshotFinalizer)
+}
+
+func (svsc *Controller) addFinalizer(ctx context.Context, svs *scyllav1alpha1.ScyllaDBVolumeSnapshot) error {
+	patch, err := controllerhelpers.AddFinalizerPatch(svs, naming.ScyllaDBVolumeSnapshotFinalizer)
+	if err != nil {
+		return fmt.Errorf("can't create add finalizer patch: %w", err)
+	}
+
+	_, err = svsc.scyllaClient.ScyllaDBVolumeSnapshots(svs.Namespace).Patch(ctx, svs.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("can't patch ScyllaDBVolumeSnapshot %q: %w", naming.ObjRef(svs), err)
+	}
+
+	klog.V(2).InfoS("Added finalizer to ScyllaDBVolumeSnapshot", "ScyllaDBVolumeSnapshot", klog.KObj(svs))
+	return nil
+}
+
+func (svsc *Controller) removeFinalizer(ctx context.Context, svs *scyllav1alpha1.ScyllaDBVolumeSnapshot) error {
+	patch, err := controllerhelpers.RemoveFinalizerPatch(svs, naming.ScyllaDBVolumeSnapshotFinalizer)
+	if err != nil {
+		return fmt.Errorf("can't create remove finalizer patch: %w", err)
+	}
+
+	_, err = svsc.scyllaClient.ScyllaDBVolumeSnapshots(svs.Namespace).Patch(ctx, svs.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("can't patch ScyllaDBVolumeSnapshot %q: %w", naming.ObjRef(svs), err)
+	}
+
+	klog.V(2).InfoS("Removed finalizer from ScyllaDBVolumeSnapshot", "ScyllaDBVolumeSnapshot", klog.KObj(svs))
+	return nil
+}
@@ -0,0 +1,268 @@
+// Copyright (C) 2025 ScyllaDB
+
+package scylladbvolumesnapshot
+
+import (
+	"context"
+	"fmt"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/controllerhelpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryutilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/klog/v2"
+)
+
+func (svsc *Controller) syncVolumeSnapshots(ctx context.Context, svs *scyllav1alpha1.ScyllaDBVolumeSnapshot, status *scyllav1alpha1.ScyllaDBVolumeSnapshotStatus) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	if status.CompletionTime != nil {
+		return progressingConditions, nil
+	}
+
+	sdc, err := svsc.scyllaDBDatacenterLister.ScyllaDBDatacenters(svs.Namespace).Get(svs.Spec.ScyllaDBDatacenterRef.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               volumeSnapshotControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForScyllaDBDatacenter",
+				Message:            fmt.Sprintf("Waiting for ScyllaDBDatacenter %q to exist.", naming.ManualRef(svs.Namespace, svs.Spec.ScyllaDBDatacenterRef.Name)),
+				ObservedGeneration: svs.Generation,
+			})
+			return progressingConditions, nil
+		}
+
+		return progressingConditions, fmt.Errorf("can't get ScyllaDBDatacenter %q: %w", naming.ManualRef(svs.Namespace, svs.Spec.ScyllaDBDatacenterRef.Name), err)
+	}
+
+	if len(status.VolumeSnapshots) == 0 {
+		rolledOut, err := controllerhelpers.IsScyllaDBDatacenterRolledOut(sdc)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't check if ScyllaDBDatacenter %q is rolled out: %w", naming.ObjRef(sdc), err)
+		}
+
+		if !rolledOut {
+			progressingConditions = append(progressingConditions, metav1.Condition{
+				Type:               volumeSnapshotControllerProgressingCondition,
+				Status:             metav1.ConditionTrue,
+				Reason:             "WaitingForScyllaDBDatacenterRollout",
+				Message:            fmt.Sprintf("Waiting for ScyllaDBDatacenter %q to roll out.", naming.ObjRef(sdc)),
+				ObservedGeneration: svs.Generation,
+			})
+			return progressingConditions, nil
+		}
+
+		volumeSnapshots, err := svsc.createVolumeSnapshots(ctx, svs, sdc, status)
+		if err != nil {
+			return progressingConditions, err
+		}
+
+		status.VolumeSnapshots = volumeSnapshots
+		svsc.eventRecorder.Eventf(svs, corev1.EventTypeNormal, "VolumeSnapshotsCreated", "Created %d VolumeSnapshot(s) of ScyllaDBDatacenter %q data volumes", len(volumeSnapshots), naming.ObjRef(sdc))
+	}
+
+	allReady := true
+	var errs []error
+	for i := range status.VolumeSnapshots {
+		volumeStatus := &status.VolumeSnapshots[i]
+
+		vs, err := svsc.dynamicClient.Resource(volumeSnapshotGVR).Namespace(svs.Namespace).Get(ctx, volumeStatus.VolumeSnapshotName, metav1.GetOptions{})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't get VolumeSnapshot %q: %w", naming.ManualRef(svs.Namespace, volumeStatus.VolumeSnapshotName), err))
+			continue
+		}
+
+		readyToUse, errorMessage, err := getVolumeSnapshotReadiness(vs)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("can't get readiness of VolumeSnapshot %q: %w", naming.ObjRef(vs), err))
+			continue
+		}
+
+		volumeStatus.ReadyToUse = pointer.Ptr(readyToUse)
+		if len(errorMessage) != 0 {
+			errs = append(errs, fmt.Errorf("VolumeSnapshot %q failed: %s", naming.ObjRef(vs), errorMessage))
+		}
+
+		if !readyToUse {
+			allReady = false
+		}
+	}
+	err = apimachineryutilerrors.NewAggregate(errs)
+	if err != nil {
+		return progressingConditions, err
+	}
+
+	if !allReady {
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               volumeSnapshotControllerProgressingCondition,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForVolumeSnapshots",
+			Message:            "Waiting for VolumeSnapshots to become ready to use.",
+			ObservedGeneration: svs.Generation,
+		})
+		return progressingConditions, nil
+	}
+
+	// Once the VolumeSnapshots are cut, the ScyllaDB snapshot is no longer needed and only takes disk space.
+	if status.SnapshotTag != nil {
+		podNames := make([]string, 0, len(status.VolumeSnapshots))
+		for _, volumeStatus := range status.VolumeSnapshots {
+			podNames = append(podNames, volumeStatus.PodName)
+		}
+
+		err = svsc.removeScyllaDBSnapshot(ctx, sdc, podNames, *status.SnapshotTag)
+		if err != nil {
+			return progressingConditions, fmt.Errorf("can't remove ScyllaDB snapshot %q: %w", *status.SnapshotTag, err)
+		}
+	}
+
+	status.CompletionTime = pointer.Ptr(metav1.Now())
+	svsc.eventRecorder.Event(svs, corev1.EventTypeNormal, "VolumeSnapshotsReady", "All VolumeSnapshots are ready to use")
+
+	return progressingConditions, nil
+}
+
+// createVolumeSnapshots takes a ScyllaDB snapshot on every node, so all data is flushed to the disk,
+// and creates VolumeSnapshots of the nodes' data volumes.
+func (svsc *Controller) createVolumeSnapshots(ctx context.Context, svs *scyllav1alpha1.ScyllaDBVolumeSnapshot, sdc *scyllav1alpha1.ScyllaDBDatacenter, status *scyllav1alpha1.ScyllaDBVolumeSnapshotStatus) ([]scyllav1alpha1.ScyllaDBVolumeSnapshotVolumeStatus, error) {
+	var volumeSnapshots []scyllav1alpha1.ScyllaDBVolumeSnapshotVolumeStatus
+	var hosts []string
+	err := forEachScyllaDBDatacenterPod(sdc, func(rack scyllav1alpha1.RackSpec, podName string) error {
+		host, err := controllerhelpers.GetScyllaHostForPodName(sdc, podName, svsc.serviceLister, svsc.podLister)
+		if err != nil {
+			return err
+		}
+		hosts = append(hosts, host)
+
+		volumeSnapshots = append(volumeSnapshots, scyllav1alpha1.ScyllaDBVolumeSnapshotVolumeStatus{
+			RackName:                  rack.Name,
+			PodName:                   podName,
+			PersistentVolumeClaimName: naming.PVCNameForPod(podName),
+			VolumeSnapshotName:        volumeSnapshotName(svs, podName),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	scyllaClient, err := controllerhelpers.NewScyllaClientForScyllaDBDatacenter(sdc, hosts, svsc.secretLister)
+	if err != nil {
+		return nil, err
+	}
+	defer scyllaClient.Close()
+
+	keyspaces, err := scyllaClient.Keyspaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("can't list keyspaces: %w", err)
+	}
+
+	snapshotTag := scyllaDBSnapshotTag(svs)
+	status.SnapshotTag = pointer.Ptr(snapshotTag)
+
+	klog.V(2).InfoS("Taking ScyllaDB snapshot", "ScyllaDBVolumeSnapshot", klog.KObj(svs), "SnapshotTag", snapshotTag)
+	err = controllerhelpers.SnapshotKeyspaces(ctx, scyllaClient, hosts, keyspaces, snapshotTag)
+	if err != nil {
+		return nil, fmt.Errorf("can't take ScyllaDB snapshot %q: %w", snapshotTag, err)
+	}
+	klog.V(2).InfoS("Took ScyllaDB snapshot", "ScyllaDBVolumeSnapshot", klog.KObj(svs), "SnapshotTag", snapshotTag)
+
+	var errs []error
+	for i := range volumeSnapshots {
+		err = svsc.createVolumeSnapshot(ctx, svs, &volumeSnapshots[i])
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	err = apimachineryutilerrors.NewAggregate(errs)
+	if err != nil {
+		return nil, err
+	}
+
+	return volumeSnapshots, nil
+}
+
+// forEachScyllaDBDatacenterPod calls the function for every ScyllaDB Pod the ScyllaDBDatacenter is supposed to run.
+func forEachScyllaDBDatacenterPod(sdc *scyllav1alpha1.ScyllaDBDatacenter, f func(rack scyllav1alpha1.RackSpec, podName string) error) error {
+	for _, rack := range sdc.Spec.Racks {
+		rackNodeCount, err := controllerhelpers.GetRackNodeCount(sdc, rack.Name)
+		if err != nil {
+			return fmt.Errorf("can't get rack %q node count of ScyllaDBDatacenter %q: %w", rack.Name, naming.ObjRef(sdc), err)
+		}
+
+		for ord := range *rackNodeCount {
+			err = f(rack, naming.MemberServiceName(rack, sdc, int(ord)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// getScyllaDBDatacenterPodNames returns the names of the ScyllaDB Pods the ScyllaDBDatacenter is supposed to run.
+func getScyllaDBDatacenterPodNames(sdc *scyllav1alpha1.ScyllaDBDatacenter) ([]string, error) {
+	var podNames []string
+	err := forEachScyllaDBDatacenterPod(sdc, func(_ scyllav1alpha1.RackSpec, podName string) error {
+		podNames = append(podNames, podName)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return podNames, nil
+}
+
+func (svsc *Controller) createVolumeSnapshot(ctx context.Context, svs *scyllav1alpha1.ScyllaDBVolumeSnapshot, volumeStatus *scyllav1alpha1.ScyllaDBVolumeSnapshotVolumeStatus) error {
+	required := makeVolumeSnapshot(svs, volumeStatus)
+
+	_, err := svsc.dynamicClient.Resource(volumeSnapshotGVR).Namespace(required.GetNamespace()).Create(ctx, required, metav1.CreateOptions{})
+	if err == nil {
+		klog.V(2).InfoS("Created VolumeSnapshot", "ScyllaDBVolumeSnapshot", klog.KObj(svs), "VolumeSnapshot", klog.KObj(required))
+		return nil
+	}
+
+	if !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("can't create VolumeSnapshot %q: %w", naming.ObjRef(required), err)
+	}
+
+	existing, err := svsc.dynamicClient.Resource(volumeSnapshotGVR).Namespace(required.GetNamespace()).Get(ctx, required.GetName(), metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("can't get VolumeSnapshot %q: %w", naming.ObjRef(required), err)
+	}
+
+	controllerRef := metav1.GetControllerOfNoCopy(existing)
+	if controllerRef == nil || controllerRef.UID != svs.UID {
+		return fmt.Errorf("VolumeSnapshot %q already exists and isn't owned by ScyllaDBVolumeSnapshot %q", naming.ObjRef(existing), naming.ObjRef(svs))
+	}
+
+	return nil
+}
+
+func (svsc *Controller) removeScyllaDBSnapshot(ctx context.Context, sdc *scyllav1alpha1.ScyllaDBDatacenter, podNames []string, snapshotTag string) error {
+	hosts := make([]string, 0, len(podNames))
+	for _, podName := range podNames {
+		host, err := controllerhelpers.GetScyllaHostForPodName(sdc, podName, svsc.serviceLister, svsc.podLister)
+		if err != nil {
+			return err
+		}
+
+		hosts = append(hosts, host)
+	}
+
+	scyllaClient, err := controllerhelpers.NewScyllaClientForScyllaDBDatacenter(sdc, hosts, svsc.secretLister)
+	if err != nil {
+		return err
+	}
+	defer scyllaClient.Close()
+
+	return controllerhelpers.RemoveSnapshots(ctx, scyllaClient, hosts, []string{snapshotTag})
+}
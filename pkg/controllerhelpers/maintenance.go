@@ -0,0 +1,51 @@
+// Copyright (C) 2025 ScyllaDB
+
+package controllerhelpers
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/helpers"
+)
+
+// maintenanceWindowCronParser accepts the same syntax as ScyllaDBManagerTaskSchedule cron.
+var maintenanceWindowCronParser = cron.NewParser(helpers.CronParseOptions)
+
+// GetMaintenanceWindowState returns whether now falls into any of the maintenance windows.
+// When it doesn't, it also returns the start of the closest upcoming window.
+// No maintenance windows mean that maintenance is allowed at any time.
+func GetMaintenanceWindowState(maintenanceWindows []scyllav1alpha1.MaintenanceWindow, now time.Time) (bool, time.Time, error) {
+	if len(maintenanceWindows) == 0 {
+		return true, time.Time{}, nil
+	}
+
+	now = now.UTC()
+
+	var nextStart time.Time
+	for i, mw := range maintenanceWindows {
+		schedule, err := maintenanceWindowCronParser.Parse(mw.Cron)
+		if err != nil {
+			return false, time.Time{}, fmt.Errorf("can't parse cron of maintenance window %d: %w", i, err)
+		}
+
+		// The first start after the beginning of a window that would end now either belongs
+		// to a window that is currently open or it is the next start of this window.
+		start := schedule.Next(now.Add(-mw.Duration.Duration))
+		if start.IsZero() {
+			continue
+		}
+
+		if !start.After(now) {
+			return true, time.Time{}, nil
+		}
+
+		if nextStart.IsZero() || start.Before(nextStart) {
+			nextStart = start
+		}
+	}
+
+	return false, nextStart, nil
+}
@@ -0,0 +1,144 @@
+// Copyright (C) 2025 ScyllaDB
+
+package controllerhelpers
+
+import (
+	"testing"
+	"time"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetMaintenanceWindowState(t *testing.T) {
+	t.Parallel()
+
+	// Saturday.
+	saturday := time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC)
+
+	tt := []struct {
+		name               string
+		maintenanceWindows []scyllav1alpha1.MaintenanceWindow
+		now                time.Time
+		expectedOpen       bool
+		expectedNextStart  time.Time
+		expectedErr        bool
+	}{
+		{
+			name:               "no maintenance windows allow maintenance at any time",
+			maintenanceWindows: nil,
+			now:                saturday,
+			expectedOpen:       true,
+			expectedNextStart:  time.Time{},
+		},
+		{
+			name: "now is at the start of a window",
+			maintenanceWindows: []scyllav1alpha1.MaintenanceWindow{
+				{
+					Cron:     "0 2 * * SAT",
+					Duration: metav1.Duration{Duration: 4 * time.Hour},
+				},
+			},
+			now:               saturday.Add(2 * time.Hour),
+			expectedOpen:      true,
+			expectedNextStart: time.Time{},
+		},
+		{
+			name: "now is inside a window",
+			maintenanceWindows: []scyllav1alpha1.MaintenanceWindow{
+				{
+					Cron:     "0 2 * * SAT",
+					Duration: metav1.Duration{Duration: 4 * time.Hour},
+				},
+			},
+			now:               saturday.Add(5 * time.Hour),
+			expectedOpen:      true,
+			expectedNextStart: time.Time{},
+		},
+		{
+			name: "now is at the end of a window",
+			maintenanceWindows: []scyllav1alpha1.MaintenanceWindow{
+				{
+					Cron:     "0 2 * * SAT",
+					Duration: metav1.Duration{Duration: 4 * time.Hour},
+				},
+			},
+			now:               saturday.Add(6 * time.Hour),
+			expectedOpen:      false,
+			expectedNextStart: saturday.Add(7*24*time.Hour + 2*time.Hour),
+		},
+		{
+			name: "now is before a window",
+			maintenanceWindows: []scyllav1alpha1.MaintenanceWindow{
+				{
+					Cron:     "0 2 * * SAT",
+					Duration: metav1.Duration{Duration: 4 * time.Hour},
+				},
+			},
+			now:               saturday.Add(time.Hour),
+			expectedOpen:      false,
+			expectedNextStart: saturday.Add(2 * time.Hour),
+		},
+		{
+			name: "closest of multiple windows is reported",
+			maintenanceWindows: []scyllav1alpha1.MaintenanceWindow{
+				{
+					Cron:     "0 2 * * SAT",
+					Duration: metav1.Duration{Duration: 4 * time.Hour},
+				},
+				{
+					Cron:     "@daily",
+					Duration: metav1.Duration{Duration: time.Hour},
+				},
+			},
+			now:               saturday.Add(12 * time.Hour),
+			expectedOpen:      false,
+			expectedNextStart: saturday.Add(24 * time.Hour),
+		},
+		{
+			name: "window spanning midnight is open after midnight",
+			maintenanceWindows: []scyllav1alpha1.MaintenanceWindow{
+				{
+					Cron:     "0 22 * * FRI",
+					Duration: metav1.Duration{Duration: 4 * time.Hour},
+				},
+			},
+			now:               saturday.Add(time.Hour),
+			expectedOpen:      true,
+			expectedNextStart: time.Time{},
+		},
+		{
+			name: "invalid cron returns an error",
+			maintenanceWindows: []scyllav1alpha1.MaintenanceWindow{
+				{
+					Cron:     "invalid",
+					Duration: metav1.Duration{Duration: time.Hour},
+				},
+			},
+			now:         saturday,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			open, nextStart, err := GetMaintenanceWindowState(tc.maintenanceWindows, tc.now)
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("expected error: %t, got %v", tc.expectedErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if open != tc.expectedOpen {
+				t.Errorf("expected open %t, got %t", tc.expectedOpen, open)
+			}
+
+			if !nextStart.Equal(tc.expectedNextStart) {
+				t.Errorf("expected next start %v, got %v", tc.expectedNextStart, nextStart)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright (C) 2025 ScyllaDB
+
+package controllerhelpers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+)
+
+// GetDatacenterReplicationFactors returns the replication factor of every non-local keyspace in the datacenter.
+func GetDatacenterReplicationFactors(ctx context.Context, scyllaClient *scyllaclient.Client, host, datacenter string) (map[string]int, error) {
+	keyspaces, err := scyllaClient.NonLocalKeyspaces(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("can't get keyspaces: %w", err)
+	}
+
+	replicationFactors := make(map[string]int, len(keyspaces))
+	for _, keyspace := range keyspaces {
+		rf, err := scyllaClient.GetDatacenterReplicationFactor(ctx, host, keyspace, datacenter)
+		if err != nil {
+			return nil, fmt.Errorf("can't get replication factor of keyspace %q: %w", keyspace, err)
+		}
+		replicationFactors[keyspace] = rf
+	}
+
+	return replicationFactors, nil
+}
+
+// GetUnderReplicatedKeyspaces returns the keyspaces which would keep fewer nodes in the datacenter
+// than their replication factor.
+func GetUnderReplicatedKeyspaces(nodes int, replicationFactors map[string]int) []string {
+	var keyspaces []string
+	for keyspace, rf := range replicationFactors {
+		if nodes < rf {
+			keyspaces = append(keyspaces, keyspace)
+		}
+	}
+	sort.Strings(keyspaces)
+
+	return keyspaces
+}
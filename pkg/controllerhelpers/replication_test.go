@@ -0,0 +1,64 @@
+// Copyright (C) 2025 ScyllaDB
+
+package controllerhelpers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetUnderReplicatedKeyspaces(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name               string
+		nodes              int
+		replicationFactors map[string]int
+		expected           []string
+	}{
+		{
+			name:               "no keyspaces",
+			nodes:              1,
+			replicationFactors: map[string]int{},
+			expected:           nil,
+		},
+		{
+			name:  "all keyspaces keep enough nodes",
+			nodes: 3,
+			replicationFactors: map[string]int{
+				"system_auth": 3,
+				"ks":          2,
+			},
+			expected: nil,
+		},
+		{
+			name:  "keyspaces not replicated in the datacenter are ignored",
+			nodes: 0,
+			replicationFactors: map[string]int{
+				"ks": 0,
+			},
+			expected: nil,
+		},
+		{
+			name:  "keyspaces with replication factor exceeding the nodes are reported in order",
+			nodes: 2,
+			replicationFactors: map[string]int{
+				"ks3":         3,
+				"ks2":         2,
+				"system_auth": 3,
+			},
+			expected: []string{"ks3", "system_auth"},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := GetUnderReplicatedKeyspaces(tc.nodes, tc.replicationFactors)
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
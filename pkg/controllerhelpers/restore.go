@@ -0,0 +1,218 @@
+// Copyright (C) 2025 ScyllaDB
+
+package controllerhelpers
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scyllav1alpha1client "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned/typed/scylla/v1alpha1"
+	scyllav1alpha1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/internalapi"
+	"github.com/scylladb/scylla-operator/pkg/kubeinterfaces"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	corev1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+)
+
+// RestoreFromBackupStage is a stage of initializing a cluster from a ScyllaDB Manager backup.
+type RestoreFromBackupStage string
+
+const (
+	RestoreFromBackupStageSchema RestoreFromBackupStage = "schema"
+	RestoreFromBackupStageTables RestoreFromBackupStage = "tables"
+)
+
+// MakeRestoreFromBackupScyllaDBManagerTask returns a ScyllaDBManagerTask restoring the given stage of the backup into the owning cluster.
+func MakeRestoreFromBackupScyllaDBManagerTask(
+	owner metav1.Object,
+	ownerGVK schema.GroupVersionKind,
+	labels map[string]string,
+	options *scyllav1alpha1.RestoreFromBackupOptions,
+	stage RestoreFromBackupStage,
+) (*scyllav1alpha1.ScyllaDBManagerTask, error) {
+	name, err := naming.RestoreFromBackupScyllaDBManagerTaskName(ownerGVK.Kind, owner.GetName(), string(stage))
+	if err != nil {
+		return nil, fmt.Errorf("can't get ScyllaDBManagerTask name: %w", err)
+	}
+
+	restoreOptions := &scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions{
+		Location:    slices.Clone(options.Location),
+		SnapshotTag: options.SnapshotTag,
+		Keyspace:    slices.Clone(options.Keyspace),
+	}
+
+	switch stage {
+	case RestoreFromBackupStageSchema:
+		restoreOptions.RestoreSchema = pointer.Ptr(true)
+
+	case RestoreFromBackupStageTables:
+		restoreOptions.RestoreTables = pointer.Ptr(true)
+
+	default:
+		return nil, fmt.Errorf("unsupported restore stage %q", stage)
+	}
+
+	return &scyllav1alpha1.ScyllaDBManagerTask{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: owner.GetNamespace(),
+			Labels:    maps.Clone(labels),
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(owner, ownerGVK),
+			},
+		},
+		Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+			ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+				Kind: ownerGVK.Kind,
+				Name: owner.GetName(),
+			},
+			Type:    scyllav1alpha1.ScyllaDBManagerTaskTypeRestore,
+			Restore: restoreOptions,
+		},
+	}, nil
+}
+
+// IsRestoreFromBackupScyllaDBManagerTaskDone returns whether the latest run of the restore task finished successfully.
+// It returns an error when the run didn't succeed.
+func IsRestoreFromBackupScyllaDBManagerTaskDone(smt *scyllav1alpha1.ScyllaDBManagerTask) (bool, error) {
+	lastRun := smt.Status.LastRun
+	if lastRun == nil || lastRun.Status == nil {
+		return false, nil
+	}
+
+	switch *lastRun.Status {
+	case managerclient.TaskStatusDone:
+		return true, nil
+
+	case managerclient.TaskStatusError, managerclient.TaskStatusAborted, managerclient.TaskStatusStopped:
+		cause := "unknown"
+		if lastRun.Cause != nil && len(*lastRun.Cause) != 0 {
+			cause = *lastRun.Cause
+		}
+		return false, fmt.Errorf("restore run of ScyllaDBManagerTask %q finished with status %q: %s", naming.ObjRef(smt), *lastRun.Status, cause)
+
+	default:
+		return false, nil
+	}
+}
+
+// SyncRestoreFromBackup initializes the owner from a ScyllaDB Manager backup as requested by options.
+// The progress is recorded in restoreStatus, which has to be set when options are.
+// The schema is restored first, followed by the tables, each by a ScyllaDBManagerTask.
+// The restore starts once the owner is rolled out, until then waitingForRolloutMessage is non-empty and reported.
+// The availability of the owner is reflected by availableConditionType in conditions, it's false until both stages complete.
+// It returns progressing conditions of progressingConditionType while the restore hasn't completed.
+func SyncRestoreFromBackup(
+	ctx context.Context,
+	scyllaClient scyllav1alpha1client.ScyllaDBManagerTasksGetter,
+	scyllaDBManagerTaskLister scyllav1alpha1listers.ScyllaDBManagerTaskLister,
+	eventRecorder record.EventRecorder,
+	owner kubeinterfaces.ObjectInterface,
+	ownerGVK schema.GroupVersionKind,
+	labels map[string]string,
+	options *scyllav1alpha1.RestoreFromBackupOptions,
+	restoreStatus *scyllav1alpha1.RestoreFromBackupStatus,
+	waitingForRolloutMessage string,
+	conditions *[]metav1.Condition,
+	availableConditionType string,
+	progressingConditionType string,
+) ([]metav1.Condition, error) {
+	var progressingConditions []metav1.Condition
+
+	setAvailableCondition := func(conditionStatus metav1.ConditionStatus, reason, message string) {
+		apimeta.SetStatusCondition(conditions, metav1.Condition{
+			Type:               availableConditionType,
+			Status:             conditionStatus,
+			Reason:             reason,
+			Message:            message,
+			ObservedGeneration: owner.GetGeneration(),
+		})
+	}
+
+	if options == nil || restoreStatus.CompletionTime != nil {
+		setAvailableCondition(metav1.ConditionTrue, internalapi.AsExpectedReason, "")
+		return progressingConditions, nil
+	}
+
+	// Restore can only start once the owner is rolled out, otherwise ScyllaDB Manager would fail to reach the nodes.
+	if len(waitingForRolloutMessage) != 0 {
+		setAvailableCondition(metav1.ConditionFalse, "WaitingForRollout", waitingForRolloutMessage)
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               progressingConditionType,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForRollout",
+			Message:            waitingForRolloutMessage,
+			ObservedGeneration: owner.GetGeneration(),
+		})
+		return progressingConditions, nil
+	}
+
+	stage := RestoreFromBackupStageSchema
+	if restoreStatus.SchemaRestored {
+		stage = RestoreFromBackupStageTables
+	}
+
+	required, err := MakeRestoreFromBackupScyllaDBManagerTask(owner, ownerGVK, labels, options, stage)
+	if err != nil {
+		return progressingConditions, fmt.Errorf("can't make restore ScyllaDBManagerTask: %w", err)
+	}
+
+	smt, _, err := resourceapply.ApplyScyllaDBManagerTask(ctx, scyllaClient, scyllaDBManagerTaskLister, eventRecorder, required, resourceapply.ApplyOptions{})
+	if err != nil {
+		setAvailableCondition(metav1.ConditionFalse, "RestoreInProgress", fmt.Sprintf("Restoring the %s from backup.", stage))
+		return progressingConditions, fmt.Errorf("can't apply ScyllaDBManagerTask %q: %w", naming.ObjRef(required), err)
+	}
+
+	done, err := IsRestoreFromBackupScyllaDBManagerTaskDone(smt)
+	if err != nil {
+		setAvailableCondition(metav1.ConditionFalse, "RestoreFailed", fmt.Sprintf("Restoring the %s from backup failed.", stage))
+		return progressingConditions, err
+	}
+
+	if !done {
+		klog.V(4).InfoS("Waiting for restore ScyllaDBManagerTask to complete", ownerGVK.Kind, klog.KObj(owner), "ScyllaDBManagerTask", klog.KObj(smt))
+		setAvailableCondition(metav1.ConditionFalse, "RestoreInProgress", fmt.Sprintf("Restoring the %s from backup.", stage))
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               progressingConditionType,
+			Status:             metav1.ConditionTrue,
+			Reason:             "WaitingForScyllaDBManagerTask",
+			Message:            fmt.Sprintf("Waiting for ScyllaDBManagerTask %q restoring the %s to complete.", naming.ObjRef(smt), stage),
+			ObservedGeneration: owner.GetGeneration(),
+		})
+		return progressingConditions, nil
+	}
+
+	switch stage {
+	case RestoreFromBackupStageSchema:
+		restoreStatus.SchemaRestored = true
+		eventRecorder.Eventf(owner, corev1.EventTypeNormal, "SchemaRestored", "Restored schema from snapshot %q", options.SnapshotTag)
+
+		// Tables are restored on the next sync triggered by the status update.
+		setAvailableCondition(metav1.ConditionFalse, "RestoreInProgress", "Restoring the tables from backup.")
+		progressingConditions = append(progressingConditions, metav1.Condition{
+			Type:               progressingConditionType,
+			Status:             metav1.ConditionTrue,
+			Reason:             "SchemaRestored",
+			Message:            "Schema has been restored, tables are going to be restored next.",
+			ObservedGeneration: owner.GetGeneration(),
+		})
+
+	case RestoreFromBackupStageTables:
+		restoreStatus.CompletionTime = pointer.Ptr(metav1.Now())
+		eventRecorder.Eventf(owner, corev1.EventTypeNormal, "RestoreFromBackupCompleted", "Restored schema and tables from snapshot %q", options.SnapshotTag)
+		setAvailableCondition(metav1.ConditionTrue, internalapi.AsExpectedReason, "")
+	}
+
+	return progressingConditions, nil
+}
@@ -0,0 +1,474 @@
+// Copyright (C) 2025 ScyllaDB
+
+package controllerhelpers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/scylladb/scylla-manager/v3/pkg/managerclient"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	scyllafake "github.com/scylladb/scylla-operator/pkg/client/scylla/clientset/versioned/fake"
+	scyllav1alpha1listers "github.com/scylladb/scylla-operator/pkg/client/scylla/listers/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
+	"github.com/scylladb/scylla-operator/pkg/resourceapply"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+)
+
+func TestMakeRestoreFromBackupScyllaDBManagerTask(t *testing.T) {
+	t.Parallel()
+
+	sdc := &scyllav1alpha1.ScyllaDBDatacenter{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "basic",
+			Namespace: "scylla",
+			UID:       "uid",
+		},
+	}
+
+	options := &scyllav1alpha1.RestoreFromBackupOptions{
+		Location:    []string{"gcs:backups"},
+		SnapshotTag: "sm_20250101000000UTC",
+		Keyspace:    []string{"keyspace"},
+	}
+
+	newExpectedScyllaDBManagerTask := func(name string, restoreOptions *scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions) *scyllav1alpha1.ScyllaDBManagerTask {
+		return &scyllav1alpha1.ScyllaDBManagerTask{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "scylla",
+				Labels: map[string]string{
+					"foo": "bar",
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					{
+						APIVersion:         "scylla.scylladb.com/v1alpha1",
+						Kind:               "ScyllaDBDatacenter",
+						Name:               "basic",
+						UID:                "uid",
+						Controller:         pointer.Ptr(true),
+						BlockOwnerDeletion: pointer.Ptr(true),
+					},
+				},
+			},
+			Spec: scyllav1alpha1.ScyllaDBManagerTaskSpec{
+				ScyllaDBClusterRef: scyllav1alpha1.LocalScyllaDBReference{
+					Kind: "ScyllaDBDatacenter",
+					Name: "basic",
+				},
+				Type:    scyllav1alpha1.ScyllaDBManagerTaskTypeRestore,
+				Restore: restoreOptions,
+			},
+		}
+	}
+
+	tt := []struct {
+		name          string
+		stage         RestoreFromBackupStage
+		expected      *scyllav1alpha1.ScyllaDBManagerTask
+		expectedError error
+	}{
+		{
+			name:  "schema",
+			stage: RestoreFromBackupStageSchema,
+			expected: newExpectedScyllaDBManagerTask("scylladbdatacenter-basic-restore-schema-15jxz", &scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions{
+				Location:      []string{"gcs:backups"},
+				SnapshotTag:   "sm_20250101000000UTC",
+				Keyspace:      []string{"keyspace"},
+				RestoreSchema: pointer.Ptr(true),
+			}),
+		},
+		{
+			name:  "tables",
+			stage: RestoreFromBackupStageTables,
+			expected: newExpectedScyllaDBManagerTask("scylladbdatacenter-basic-restore-tables-2aitq", &scyllav1alpha1.ScyllaDBManagerRestoreTaskOptions{
+				Location:      []string{"gcs:backups"},
+				SnapshotTag:   "sm_20250101000000UTC",
+				Keyspace:      []string{"keyspace"},
+				RestoreTables: pointer.Ptr(true),
+			}),
+		},
+		{
+			name:          "unsupported stage",
+			stage:         "unsupported",
+			expected:      nil,
+			expectedError: errors.New(`unsupported restore stage "unsupported"`),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := MakeRestoreFromBackupScyllaDBManagerTask(sdc, scyllav1alpha1.ScyllaDBDatacenterGVK, map[string]string{"foo": "bar"}, options, tc.stage)
+			if !reflect.DeepEqual(err, tc.expectedError) {
+				t.Fatalf("expected error %#v, got %#v", tc.expectedError, err)
+			}
+
+			if !cmp.Equal(got, tc.expected) {
+				t.Errorf("expected and got ScyllaDBManagerTasks differ:\n%s", cmp.Diff(tc.expected, got))
+			}
+		})
+	}
+}
+
+func TestIsRestoreFromBackupScyllaDBManagerTaskDone(t *testing.T) {
+	t.Parallel()
+
+	newScyllaDBManagerTask := func(lastRun *scyllav1alpha1.ScyllaDBManagerTaskRunStatus) *scyllav1alpha1.ScyllaDBManagerTask {
+		return &scyllav1alpha1.ScyllaDBManagerTask{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "restore",
+				Namespace: "scylla",
+			},
+			Status: scyllav1alpha1.ScyllaDBManagerTaskStatus{
+				LastRun: lastRun,
+			},
+		}
+	}
+
+	tt := []struct {
+		name          string
+		smt           *scyllav1alpha1.ScyllaDBManagerTask
+		expected      bool
+		expectedError error
+	}{
+		{
+			name:     "no run yet",
+			smt:      newScyllaDBManagerTask(nil),
+			expected: false,
+		},
+		{
+			name: "running",
+			smt: newScyllaDBManagerTask(&scyllav1alpha1.ScyllaDBManagerTaskRunStatus{
+				Status: pointer.Ptr("RUNNING"),
+			}),
+			expected: false,
+		},
+		{
+			name: "done",
+			smt: newScyllaDBManagerTask(&scyllav1alpha1.ScyllaDBManagerTaskRunStatus{
+				Status: pointer.Ptr("DONE"),
+			}),
+			expected: true,
+		},
+		{
+			name: "error with cause",
+			smt: newScyllaDBManagerTask(&scyllav1alpha1.ScyllaDBManagerTaskRunStatus{
+				Status: pointer.Ptr("ERROR"),
+				Cause:  pointer.Ptr("no snapshot"),
+			}),
+			expected:      false,
+			expectedError: errors.New(`restore run of ScyllaDBManagerTask "scylla/restore" finished with status "ERROR": no snapshot`),
+		},
+		{
+			name: "stopped without cause",
+			smt: newScyllaDBManagerTask(&scyllav1alpha1.ScyllaDBManagerTaskRunStatus{
+				Status: pointer.Ptr("STOPPED"),
+			}),
+			expected:      false,
+			expectedError: errors.New(`restore run of ScyllaDBManagerTask "scylla/restore" finished with status "STOPPED": unknown`),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := IsRestoreFromBackupScyllaDBManagerTaskDone(tc.smt)
+			if !reflect.DeepEqual(err, tc.expectedError) {
+				t.Fatalf("expected error %#v, got %#v", tc.expectedError, err)
+			}
+
+			if got != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestSyncRestoreFromBackup(t *testing.T) {
+	t.Parallel()
+
+	const (
+		availableConditionType   = "RestoreFromBackupControllerAvailable"
+		progressingConditionType = "RestoreFromBackupControllerProgressing"
+	)
+
+	completionTime := metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	newObj := func() *scyllav1alpha1.ScyllaDBDatacenter {
+		return &scyllav1alpha1.ScyllaDBDatacenter{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "basic",
+				Namespace:  "scylla",
+				UID:        "uid",
+				Generation: 1,
+			},
+			Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+				ClusterName: "basic",
+				RestoreFromBackup: &scyllav1alpha1.RestoreFromBackupOptions{
+					Location:    []string{"gcs:backups"},
+					SnapshotTag: "sm_20250101000000UTC",
+				},
+			},
+		}
+	}
+
+	labels := map[string]string{
+		"foo": "bar",
+	}
+
+	restoreTaskName := func(stage RestoreFromBackupStage) string {
+		name, err := naming.RestoreFromBackupScyllaDBManagerTaskName("ScyllaDBDatacenter", "basic", string(stage))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return name
+	}
+
+	newRestoreTask := func(stage RestoreFromBackupStage, runStatus string) *scyllav1alpha1.ScyllaDBManagerTask {
+		sdc := newObj()
+		smt, err := MakeRestoreFromBackupScyllaDBManagerTask(sdc, scyllav1alpha1.ScyllaDBDatacenterGVK, labels, sdc.Spec.RestoreFromBackup, stage)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = resourceapply.SetHashAnnotation(smt)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		smt.Status.LastRun = &scyllav1alpha1.ScyllaDBManagerTaskRunStatus{
+			Status: pointer.Ptr(runStatus),
+			Cause:  pointer.Ptr("cause"),
+		}
+
+		return smt
+	}
+
+	tt := []struct {
+		name                       string
+		sdc                        *scyllav1alpha1.ScyllaDBDatacenter
+		restoreFromBackupStatus    *scyllav1alpha1.RestoreFromBackupStatus
+		waitingForRolloutMessage   string
+		existingTasks              []*scyllav1alpha1.ScyllaDBManagerTask
+		expectedRestoreStatus      *scyllav1alpha1.RestoreFromBackupStatus
+		expectedAvailableReason    string
+		expectedProgressingReasons []string
+		expectedActions            []string
+		expectedErr                error
+	}{
+		{
+			name: "nothing to restore",
+			sdc: func() *scyllav1alpha1.ScyllaDBDatacenter {
+				sdc := newObj()
+				sdc.Spec.RestoreFromBackup = nil
+				return sdc
+			}(),
+			restoreFromBackupStatus:    nil,
+			expectedRestoreStatus:      nil,
+			expectedAvailableReason:    "AsExpected",
+			expectedProgressingReasons: nil,
+			expectedActions:            nil,
+			expectedErr:                nil,
+		},
+		{
+			name: "completed restore isn't repeated",
+			sdc:  newObj(),
+			restoreFromBackupStatus: &scyllav1alpha1.RestoreFromBackupStatus{
+				SchemaRestored: true,
+				CompletionTime: pointer.Ptr(completionTime),
+			},
+			waitingForRolloutMessage: "Waiting for rollout.",
+			expectedRestoreStatus: &scyllav1alpha1.RestoreFromBackupStatus{
+				SchemaRestored: true,
+				CompletionTime: pointer.Ptr(completionTime),
+			},
+			expectedAvailableReason:    "AsExpected",
+			expectedProgressingReasons: nil,
+			expectedActions:            nil,
+			expectedErr:                nil,
+		},
+		{
+			name:                       "waits for the rollout",
+			sdc:                        newObj(),
+			restoreFromBackupStatus:    &scyllav1alpha1.RestoreFromBackupStatus{},
+			waitingForRolloutMessage:   "Waiting for rollout.",
+			expectedRestoreStatus:      &scyllav1alpha1.RestoreFromBackupStatus{},
+			expectedAvailableReason:    "WaitingForRollout",
+			expectedProgressingReasons: []string{"WaitingForRollout"},
+			expectedActions:            nil,
+			expectedErr:                nil,
+		},
+		{
+			name:                       "creates the schema restore task",
+			sdc:                        newObj(),
+			restoreFromBackupStatus:    &scyllav1alpha1.RestoreFromBackupStatus{},
+			expectedRestoreStatus:      &scyllav1alpha1.RestoreFromBackupStatus{},
+			expectedAvailableReason:    "RestoreInProgress",
+			expectedProgressingReasons: []string{"WaitingForScyllaDBManagerTask"},
+			expectedActions:            []string{fmt.Sprintf("create scylladbmanagertasks %s", restoreTaskName(RestoreFromBackupStageSchema))},
+			expectedErr:                nil,
+		},
+		{
+			name:                       "waits for the schema restore task",
+			sdc:                        newObj(),
+			restoreFromBackupStatus:    &scyllav1alpha1.RestoreFromBackupStatus{},
+			existingTasks:              []*scyllav1alpha1.ScyllaDBManagerTask{newRestoreTask(RestoreFromBackupStageSchema, managerclient.TaskStatusRunning)},
+			expectedRestoreStatus:      &scyllav1alpha1.RestoreFromBackupStatus{},
+			expectedAvailableReason:    "RestoreInProgress",
+			expectedProgressingReasons: []string{"WaitingForScyllaDBManagerTask"},
+			expectedActions:            nil,
+			expectedErr:                nil,
+		},
+		{
+			name:                    "records the restored schema",
+			sdc:                     newObj(),
+			restoreFromBackupStatus: &scyllav1alpha1.RestoreFromBackupStatus{},
+			existingTasks:           []*scyllav1alpha1.ScyllaDBManagerTask{newRestoreTask(RestoreFromBackupStageSchema, managerclient.TaskStatusDone)},
+			expectedRestoreStatus: &scyllav1alpha1.RestoreFromBackupStatus{
+				SchemaRestored: true,
+			},
+			expectedAvailableReason:    "RestoreInProgress",
+			expectedProgressingReasons: []string{"SchemaRestored"},
+			expectedActions:            nil,
+			expectedErr:                nil,
+		},
+		{
+			name: "creates the tables restore task once the schema is restored",
+			sdc:  newObj(),
+			restoreFromBackupStatus: &scyllav1alpha1.RestoreFromBackupStatus{
+				SchemaRestored: true,
+			},
+			existingTasks: []*scyllav1alpha1.ScyllaDBManagerTask{newRestoreTask(RestoreFromBackupStageSchema, managerclient.TaskStatusDone)},
+			expectedRestoreStatus: &scyllav1alpha1.RestoreFromBackupStatus{
+				SchemaRestored: true,
+			},
+			expectedAvailableReason:    "RestoreInProgress",
+			expectedProgressingReasons: []string{"WaitingForScyllaDBManagerTask"},
+			expectedActions:            []string{fmt.Sprintf("create scylladbmanagertasks %s", restoreTaskName(RestoreFromBackupStageTables))},
+			expectedErr:                nil,
+		},
+		{
+			name: "completes the restore once the tables are restored",
+			sdc:  newObj(),
+			restoreFromBackupStatus: &scyllav1alpha1.RestoreFromBackupStatus{
+				SchemaRestored: true,
+			},
+			existingTasks: []*scyllav1alpha1.ScyllaDBManagerTask{newRestoreTask(RestoreFromBackupStageTables, managerclient.TaskStatusDone)},
+			expectedRestoreStatus: &scyllav1alpha1.RestoreFromBackupStatus{
+				SchemaRestored: true,
+				CompletionTime: pointer.Ptr(completionTime),
+			},
+			expectedAvailableReason:    "AsExpected",
+			expectedProgressingReasons: nil,
+			expectedActions:            nil,
+			expectedErr:                nil,
+		},
+		{
+			name:                       "failed restore task is reported",
+			sdc:                        newObj(),
+			restoreFromBackupStatus:    &scyllav1alpha1.RestoreFromBackupStatus{},
+			existingTasks:              []*scyllav1alpha1.ScyllaDBManagerTask{newRestoreTask(RestoreFromBackupStageSchema, managerclient.TaskStatusError)},
+			expectedRestoreStatus:      &scyllav1alpha1.RestoreFromBackupStatus{},
+			expectedAvailableReason:    "RestoreFailed",
+			expectedProgressingReasons: nil,
+			expectedActions:            nil,
+			expectedErr:                fmt.Errorf(`restore run of ScyllaDBManagerTask "scylla/%s" finished with status "ERROR": cause`, restoreTaskName(RestoreFromBackupStageSchema)),
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, ctxCancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer ctxCancel()
+
+			smtCache := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			for _, smt := range tc.existingTasks {
+				err := smtCache.Add(smt)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			scyllaClient := scyllafake.NewSimpleClientset()
+			restoreStatus := tc.restoreFromBackupStatus.DeepCopy()
+			var conditions []metav1.Condition
+
+			progressingConditions, err := SyncRestoreFromBackup(
+				ctx,
+				scyllaClient.ScyllaV1alpha1(),
+				scyllav1alpha1listers.NewScyllaDBManagerTaskLister(smtCache),
+				record.NewFakeRecorder(10),
+				tc.sdc,
+				scyllav1alpha1.ScyllaDBDatacenterGVK,
+				labels,
+				tc.sdc.Spec.RestoreFromBackup,
+				restoreStatus,
+				tc.waitingForRolloutMessage,
+				&conditions,
+				availableConditionType,
+				progressingConditionType,
+			)
+			if !cmp.Equal(fmt.Sprint(err), fmt.Sprint(tc.expectedErr)) {
+				t.Fatalf("expected error %v, got %v", tc.expectedErr, err)
+			}
+
+			var gotProgressingReasons []string
+			for _, c := range progressingConditions {
+				if c.Type != progressingConditionType {
+					t.Errorf("expected progressing condition of type %q, got %q", progressingConditionType, c.Type)
+				}
+				gotProgressingReasons = append(gotProgressingReasons, c.Reason)
+			}
+			if !cmp.Equal(gotProgressingReasons, tc.expectedProgressingReasons) {
+				t.Errorf("expected and got progressing reasons differ:\n%s", cmp.Diff(tc.expectedProgressingReasons, gotProgressingReasons))
+			}
+
+			availableCondition := apimeta.FindStatusCondition(conditions, availableConditionType)
+			if availableCondition == nil {
+				t.Fatalf("expected %q condition to be set", availableConditionType)
+			}
+			if availableCondition.Reason != tc.expectedAvailableReason {
+				t.Errorf("expected available reason %q, got %q", tc.expectedAvailableReason, availableCondition.Reason)
+			}
+
+			if restoreStatus != nil && restoreStatus.CompletionTime != nil && tc.expectedRestoreStatus != nil && tc.expectedRestoreStatus.CompletionTime != nil {
+				restoreStatus.CompletionTime = tc.expectedRestoreStatus.CompletionTime
+			}
+			if !cmp.Equal(restoreStatus, tc.expectedRestoreStatus) {
+				t.Errorf("expected and got restore status differ:\n%s", cmp.Diff(tc.expectedRestoreStatus, restoreStatus))
+			}
+
+			var gotActions []string
+			for _, action := range scyllaClient.Actions() {
+				var name string
+				switch a := action.(type) {
+				case interface{ GetName() string }:
+					name = a.GetName()
+				case interface{ GetObject() runtime.Object }:
+					name = a.GetObject().(metav1.Object).GetName()
+				default:
+					t.Fatalf("unexpected action %#v", action)
+				}
+				gotActions = append(gotActions, fmt.Sprintf("%s %s %s", action.GetVerb(), action.GetResource().Resource, name))
+			}
+			if !cmp.Equal(gotActions, tc.expectedActions) {
+				t.Errorf("expected and got actions differ:\n%s", cmp.Diff(tc.expectedActions, gotActions))
+			}
+		})
+	}
+}
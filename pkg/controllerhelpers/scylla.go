@@ -366,6 +366,18 @@ func GetRackNodeCount(sdc *scyllav1alpha1.ScyllaDBDatacenter, rackName string) (
 	return pointer.Ptr[int32](0), nil
 }
 
+// IsScyllaDBDatacenterScaledToZero returns true when the ScyllaDBDatacenter doesn't require any nodes.
+func IsScyllaDBDatacenterScaledToZero(sdc *scyllav1alpha1.ScyllaDBDatacenter) bool {
+	for _, rack := range sdc.Spec.Racks {
+		nodes, err := GetRackNodeCount(sdc, rack.Name)
+		if err != nil || *nodes != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
 func IsScyllaDBDatacenterRolledOut(sdc *scyllav1alpha1.ScyllaDBDatacenter) (bool, error) {
 	if !helpers.IsStatusConditionPresentAndTrue(sdc.Status.Conditions, scyllav1alpha1.AvailableCondition, sdc.Generation) {
 		return false, nil
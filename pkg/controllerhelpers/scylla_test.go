@@ -1203,6 +1203,92 @@ func TestGetNodeCount(t *testing.T) {
 	}
 }
 
+func TestIsScyllaDBDatacenterScaledToZero(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name     string
+		sdc      *scyllav1alpha1.ScyllaDBDatacenter
+		expected bool
+	}{
+		{
+			name: "racks with nodes",
+			sdc: &scyllav1alpha1.ScyllaDBDatacenter{
+				Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+					Racks: []scyllav1alpha1.RackSpec{
+						{
+							Name: "a",
+							RackTemplate: scyllav1alpha1.RackTemplate{
+								Nodes: pointer.Ptr[int32](0),
+							},
+						},
+						{
+							Name: "b",
+							RackTemplate: scyllav1alpha1.RackTemplate{
+								Nodes: pointer.Ptr[int32](1),
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "rack template with nodes",
+			sdc: &scyllav1alpha1.ScyllaDBDatacenter{
+				Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+					RackTemplate: &scyllav1alpha1.RackTemplate{
+						Nodes: pointer.Ptr[int32](3),
+					},
+					Racks: []scyllav1alpha1.RackSpec{
+						{
+							Name: "a",
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "rack override of rack template with nodes",
+			sdc: &scyllav1alpha1.ScyllaDBDatacenter{
+				Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{
+					RackTemplate: &scyllav1alpha1.RackTemplate{
+						Nodes: pointer.Ptr[int32](3),
+					},
+					Racks: []scyllav1alpha1.RackSpec{
+						{
+							Name: "a",
+							RackTemplate: scyllav1alpha1.RackTemplate{
+								Nodes: pointer.Ptr[int32](0),
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "no racks",
+			sdc: &scyllav1alpha1.ScyllaDBDatacenter{
+				Spec: scyllav1alpha1.ScyllaDBDatacenterSpec{},
+			},
+			expected: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := IsScyllaDBDatacenterScaledToZero(tc.sdc)
+			if got != tc.expected {
+				t.Errorf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
 func TestIsScyllaPod(t *testing.T) {
 	t.Parallel()
 
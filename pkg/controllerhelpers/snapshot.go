@@ -0,0 +1,140 @@
+// Copyright (C) 2025 ScyllaDB
+
+package controllerhelpers
+
+import (
+	"context"
+	"fmt"
+
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/helpers"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+	"github.com/scylladb/scylla-operator/pkg/util/parallel"
+	"k8s.io/apimachinery/pkg/util/sets"
+	corev1listers "k8s.io/client-go/listers/core/v1"
+)
+
+// GetScyllaManagerAgentAuthToken returns the auth token of the ScyllaDB Manager Agent running alongside the ScyllaDBDatacenter nodes.
+func GetScyllaManagerAgentAuthToken(sdc *scyllav1alpha1.ScyllaDBDatacenter, secretLister corev1listers.SecretLister) (string, error) {
+	secretName := naming.AgentAuthTokenSecretName(sdc)
+	secret, err := secretLister.Secrets(sdc.Namespace).Get(secretName)
+	if err != nil {
+		return "", fmt.Errorf("can't get manager agent auth secret %q: %w", naming.ManualRef(sdc.Namespace, secretName), err)
+	}
+
+	token, err := helpers.GetAgentAuthTokenFromSecret(secret)
+	if err != nil {
+		return "", fmt.Errorf("can't get agent token from secret %q: %w", naming.ObjRef(secret), err)
+	}
+
+	return token, nil
+}
+
+// NewScyllaClientForScyllaDBDatacenter creates a ScyllaDB client for the hosts of the ScyllaDBDatacenter,
+// authenticated with the token of its ScyllaDB Manager Agents.
+func NewScyllaClientForScyllaDBDatacenter(sdc *scyllav1alpha1.ScyllaDBDatacenter, hosts []string, secretLister corev1listers.SecretLister) (*scyllaclient.Client, error) {
+	token, err := GetScyllaManagerAgentAuthToken(sdc, secretLister)
+	if err != nil {
+		return nil, fmt.Errorf("can't get manager agent auth token: %w", err)
+	}
+
+	return NewScyllaClientFromToken(hosts, token)
+}
+
+// GetScyllaHostForPodName returns the address of the ScyllaDB node run by the Pod.
+// Member Services share names with the Pods they select.
+func GetScyllaHostForPodName(sdc *scyllav1alpha1.ScyllaDBDatacenter, podName string, serviceLister corev1listers.ServiceLister, podLister corev1listers.PodLister) (string, error) {
+	svc, err := serviceLister.Services(sdc.Namespace).Get(podName)
+	if err != nil {
+		return "", fmt.Errorf("can't get service %q: %w", naming.ManualRef(sdc.Namespace, podName), err)
+	}
+
+	pod, err := podLister.Pods(sdc.Namespace).Get(podName)
+	if err != nil {
+		return "", fmt.Errorf("can't get pod %q: %w", naming.ManualRef(sdc.Namespace, podName), err)
+	}
+
+	host, err := GetScyllaHost(sdc, svc, pod)
+	if err != nil {
+		return "", fmt.Errorf("can't get scylla host for service %q: %w", naming.ObjRef(svc), err)
+	}
+
+	return host, nil
+}
+
+// GetSnapshottedKeyspaces returns the names of the keyspaces which have been snapshotted with the tag.
+func GetSnapshottedKeyspaces(snapshots []*scyllaclient.Snapshot, snapshotTag string) sets.Set[string] {
+	keyspaces := sets.New[string]()
+	for _, snapshot := range snapshots {
+		if snapshot.Key == snapshotTag {
+			keyspaces.Insert(snapshot.Keyspace)
+		}
+	}
+
+	return keyspaces
+}
+
+// SnapshotKeyspaceTables flushes and snapshots the keyspaces on the host. All tables of a keyspace are snapshotted
+// unless the tables are listed. Keyspaces which already have been snapshotted with the tag, e.g. in a previous failed attempt, are skipped.
+func SnapshotKeyspaceTables(ctx context.Context, scyllaClient *scyllaclient.Client, host string, keyspaces []scyllav1alpha1.ScyllaDBSnapshotKeyspace, snapshotTag string) error {
+	snapshots, err := scyllaClient.ListSnapshots(ctx, host)
+	if err != nil {
+		return fmt.Errorf("can't list snapshots on host %q: %w", host, err)
+	}
+
+	snapshottedKeyspaces := GetSnapshottedKeyspaces(snapshots, snapshotTag)
+	for _, keyspace := range keyspaces {
+		if snapshottedKeyspaces.Has(keyspace.Name) {
+			continue
+		}
+
+		err = scyllaClient.TakeSnapshot(ctx, host, snapshotTag, keyspace.Name, keyspace.Tables...)
+		if err != nil {
+			return fmt.Errorf("can't take a snapshot on host %q and keyspace %q: %w", host, keyspace.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// SnapshotKeyspaces flushes and snapshots the keyspaces on all hosts in parallel.
+func SnapshotKeyspaces(ctx context.Context, scyllaClient *scyllaclient.Client, hosts, keyspaces []string, snapshotTag string) error {
+	snapshotKeyspaces := make([]scyllav1alpha1.ScyllaDBSnapshotKeyspace, 0, len(keyspaces))
+	for _, keyspace := range keyspaces {
+		snapshotKeyspaces = append(snapshotKeyspaces, scyllav1alpha1.ScyllaDBSnapshotKeyspace{
+			Name: keyspace,
+		})
+	}
+
+	return parallel.ForEach(len(hosts), func(i int) error {
+		return SnapshotKeyspaceTables(ctx, scyllaClient, hosts[i], snapshotKeyspaces, snapshotTag)
+	})
+}
+
+// RemoveSnapshots removes the snapshots with the tags from all hosts in parallel.
+// Snapshots which don't exist on a host are skipped.
+func RemoveSnapshots(ctx context.Context, scyllaClient *scyllaclient.Client, hosts, snapshotTags []string) error {
+	return parallel.ForEach(len(hosts), func(i int) error {
+		host := hosts[i]
+
+		snapshots, err := scyllaClient.Snapshots(ctx, host)
+		if err != nil {
+			return fmt.Errorf("can't list snapshots on host %q: %w", host, err)
+		}
+
+		existingSnapshotTags := sets.New(snapshots...)
+		for _, snapshotTag := range snapshotTags {
+			if !existingSnapshotTags.Has(snapshotTag) {
+				continue
+			}
+
+			err = scyllaClient.DeleteSnapshot(ctx, host, snapshotTag)
+			if err != nil {
+				return fmt.Errorf("can't delete snapshot %q on host %q: %w", snapshotTag, host, err)
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,332 @@
+// Copyright (C) 2025 ScyllaDB
+
+package controllerhelpers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	scyllav1alpha1 "github.com/scylladb/scylla-operator/pkg/api/scylla/v1alpha1"
+	"github.com/scylladb/scylla-operator/pkg/scyllaclient"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// fakeSnapshotServer serves the snapshot endpoints of ScyllaDB API and records the modifying requests.
+type fakeSnapshotServer struct {
+	lock      sync.Mutex
+	snapshots map[string][][2]string
+	requests  []string
+}
+
+func (s *fakeSnapshotServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	query := r.URL.Query()
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/storage_service/snapshots":
+		type snapshot struct {
+			Ks string `json:"ks"`
+			Cf string `json:"cf"`
+		}
+		type snapshots struct {
+			Key   string     `json:"key"`
+			Value []snapshot `json:"value"`
+		}
+		var resp []snapshots
+		for _, tag := range sets.List(sets.KeySet(s.snapshots)) {
+			var value []snapshot
+			for _, kt := range s.snapshots[tag] {
+				value = append(value, snapshot{Ks: kt[0], Cf: kt[1]})
+			}
+			resp = append(resp, snapshots{Key: tag, Value: value})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(resp)
+		return
+
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, "/storage_service/keyspace_flush/"):
+		s.requests = append(s.requests, fmt.Sprintf("flush %s", strings.TrimPrefix(r.URL.Path, "/storage_service/keyspace_flush/")))
+
+	case r.Method == http.MethodPost && r.URL.Path == "/storage_service/snapshots":
+		tag, keyspace, tables := query.Get("tag"), query.Get("kn"), query.Get("cf")
+		s.requests = append(s.requests, fmt.Sprintf("snapshot %s %s %s", tag, keyspace, tables))
+		s.snapshots[tag] = append(s.snapshots[tag], [2]string{keyspace, tables})
+
+	case r.Method == http.MethodDelete && r.URL.Path == "/storage_service/snapshots":
+		tag := query.Get("tag")
+		s.requests = append(s.requests, fmt.Sprintf("delete %s", tag))
+		delete(s.snapshots, tag)
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write([]byte("{}"))
+}
+
+func newFakeSnapshotScyllaClient(t *testing.T, snapshots map[string][][2]string) (*scyllaclient.Client, string, *fakeSnapshotServer) {
+	t.Helper()
+
+	fakeServer := &fakeSnapshotServer{
+		snapshots: snapshots,
+	}
+	server := httptest.NewServer(fakeServer)
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	host, port, err := net.SplitHostPort(serverURL.Host)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := scyllaclient.DefaultConfig("token", host)
+	cfg.Port = port
+	cfg.Scheme = "http"
+	scyllaClient, err := scyllaclient.NewClient(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(scyllaClient.Close)
+
+	return scyllaClient, host, fakeServer
+}
+
+func TestGetSnapshottedKeyspaces(t *testing.T) {
+	t.Parallel()
+
+	snapshots := []*scyllaclient.Snapshot{
+		{
+			Key:      "so_snapshot_uid",
+			Keyspace: "ks1",
+			Table:    "t1",
+		},
+		{
+			Key:      "so_snapshot_uid",
+			Keyspace: "ks1",
+			Table:    "t2",
+		},
+		{
+			Key:      "so_snapshot_uid",
+			Keyspace: "ks2",
+			Table:    "t1",
+		},
+		{
+			Key:      "other",
+			Keyspace: "ks3",
+			Table:    "t1",
+		},
+	}
+
+	expected := sets.New("ks1", "ks2")
+
+	got := GetSnapshottedKeyspaces(snapshots, "so_snapshot_uid")
+	if !got.Equal(expected) {
+		t.Errorf("expected %v, got %v", sets.List(expected), sets.List(got))
+	}
+}
+
+func TestSnapshotKeyspaceTables(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name             string
+		snapshots        map[string][][2]string
+		keyspaces        []scyllav1alpha1.ScyllaDBSnapshotKeyspace
+		expectedRequests []string
+	}{
+		{
+			name:      "snapshots all keyspaces",
+			snapshots: map[string][][2]string{},
+			keyspaces: []scyllav1alpha1.ScyllaDBSnapshotKeyspace{
+				{
+					Name: "ks1",
+				},
+				{
+					Name:   "ks2",
+					Tables: []string{"t1", "t2"},
+				},
+			},
+			expectedRequests: []string{
+				"flush ks1",
+				"snapshot so_tag ks1 ",
+				"flush ks2",
+				"snapshot so_tag ks2 t1,t2",
+			},
+		},
+		{
+			name: "skips keyspaces snapshotted in a previous attempt",
+			snapshots: map[string][][2]string{
+				"so_tag": {
+					{"ks1", "t1"},
+				},
+				"other": {
+					{"ks2", "t1"},
+				},
+			},
+			keyspaces: []scyllav1alpha1.ScyllaDBSnapshotKeyspace{
+				{
+					Name: "ks1",
+				},
+				{
+					Name: "ks2",
+				},
+			},
+			expectedRequests: []string{
+				"flush ks2",
+				"snapshot so_tag ks2 ",
+			},
+		},
+		{
+			name: "does nothing when all keyspaces are snapshotted",
+			snapshots: map[string][][2]string{
+				"so_tag": {
+					{"ks1", "t1"},
+				},
+			},
+			keyspaces: []scyllav1alpha1.ScyllaDBSnapshotKeyspace{
+				{
+					Name: "ks1",
+				},
+			},
+			expectedRequests: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, ctxCancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer ctxCancel()
+
+			scyllaClient, host, fakeServer := newFakeSnapshotScyllaClient(t, tc.snapshots)
+
+			err := SnapshotKeyspaceTables(ctx, scyllaClient, host, tc.keyspaces, "so_tag")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(fakeServer.requests, tc.expectedRequests) {
+				t.Errorf("expected and got requests differ:\n%s", cmp.Diff(tc.expectedRequests, fakeServer.requests))
+			}
+		})
+	}
+}
+
+func TestSnapshotKeyspaces(t *testing.T) {
+	t.Parallel()
+
+	ctx, ctxCancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer ctxCancel()
+
+	scyllaClient, host, fakeServer := newFakeSnapshotScyllaClient(t, map[string][][2]string{})
+
+	err := SnapshotKeyspaces(ctx, scyllaClient, []string{host}, []string{"system", "system_schema"}, "so_tag")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedRequests := []string{
+		"flush system",
+		"snapshot so_tag system ",
+		"flush system_schema",
+		"snapshot so_tag system_schema ",
+	}
+	if !cmp.Equal(fakeServer.requests, expectedRequests) {
+		t.Errorf("expected and got requests differ:\n%s", cmp.Diff(expectedRequests, fakeServer.requests))
+	}
+}
+
+func TestRemoveSnapshots(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name             string
+		snapshots        map[string][][2]string
+		snapshotTags     []string
+		expectedRequests []string
+	}{
+		{
+			name: "removes existing snapshots",
+			snapshots: map[string][][2]string{
+				"so_tag1": {
+					{"ks1", "t1"},
+				},
+				"so_tag2": {
+					{"ks1", "t1"},
+				},
+				"other": {
+					{"ks1", "t1"},
+				},
+			},
+			snapshotTags: []string{"so_tag1", "so_tag2"},
+			expectedRequests: []string{
+				"delete so_tag1",
+				"delete so_tag2",
+			},
+		},
+		{
+			name: "skips missing snapshots",
+			snapshots: map[string][][2]string{
+				"so_tag2": {
+					{"ks1", "t1"},
+				},
+			},
+			snapshotTags: []string{"so_tag1", "so_tag2"},
+			expectedRequests: []string{
+				"delete so_tag2",
+			},
+		},
+		{
+			name:             "does nothing when no snapshots exist",
+			snapshots:        map[string][][2]string{},
+			snapshotTags:     []string{"so_tag1"},
+			expectedRequests: nil,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, ctxCancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer ctxCancel()
+
+			scyllaClient, host, fakeServer := newFakeSnapshotScyllaClient(t, tc.snapshots)
+
+			err := RemoveSnapshots(ctx, scyllaClient, []string{host}, tc.snapshotTags)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !cmp.Equal(fakeServer.requests, tc.expectedRequests) {
+				t.Errorf("expected and got requests differ:\n%s", cmp.Diff(tc.expectedRequests, fakeServer.requests))
+			}
+
+			gotSnapshotTags := sets.List(sets.KeySet(fakeServer.snapshots))
+			for _, tag := range tc.snapshotTags {
+				if slices.Contains(gotSnapshotTags, tag) {
+					t.Errorf("expected snapshot %q to be removed", tag)
+				}
+			}
+		})
+	}
+}
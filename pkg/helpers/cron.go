@@ -0,0 +1,10 @@
+// Copyright (C) 2025 ScyllaDB
+
+package helpers
+
+import (
+	"github.com/robfig/cron/v3"
+)
+
+// CronParseOptions are the options of the cron syntax accepted by ScyllaDB Manager task schedules and maintenance windows.
+const CronParseOptions = cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor
@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"time"
 )
 
 type UpgradePhase string
@@ -16,6 +17,8 @@ const (
 	RolloutInitUpgradePhase UpgradePhase = "RolloutInit"
 	RolloutRunUpgradePhase  UpgradePhase = "RolloutRun"
 	PostHooksUpgradePhase   UpgradePhase = "PostHooks"
+	RollbackUpgradePhase    UpgradePhase = "Rollback"
+	FailedUpgradePhase      UpgradePhase = "Failed"
 )
 
 type DatacenterUpgradeContext struct {
@@ -24,6 +27,22 @@ type DatacenterUpgradeContext struct {
 	ToVersion         string       `json:"toVersion"`
 	SystemSnapshotTag string       `json:"systemSnapshotTag"`
 	DataSnapshotTag   string       `json:"dataSnapshotTag"`
+
+	// FromImage is the ScyllaDB image the upgrade started from. Failed upgrades are rolled back to it.
+	FromImage string `json:"fromImage,omitempty"`
+
+	// UpgradingNode is the name of the node that is being upgraded during the rollout.
+	UpgradingNode string `json:"upgradingNode,omitempty"`
+	// NodeUpgradeStartTime is the time when the upgrade of UpgradingNode started.
+	NodeUpgradeStartTime *time.Time `json:"nodeUpgradeStartTime,omitempty"`
+
+	// FailureMessage describes why the upgrade has failed.
+	FailureMessage string `json:"failureMessage,omitempty"`
+	// RolledBack indicates whether the nodes of a failed upgrade have been rolled back to FromImage.
+	RolledBack bool `json:"rolledBack,omitempty"`
+
+	// SSTablesUpgradedNodes lists the member Services of nodes that have had their SSTables upgraded after the rollout.
+	SSTablesUpgradedNodes []string `json:"sstablesUpgradedNodes,omitempty"`
 }
 
 func (uc *DatacenterUpgradeContext) Decode(reader io.Reader) error {
@@ -47,8 +47,14 @@ const (
 	// CleanupJobTokenRingHashAnnotation reflects which version of token ring cleanup Job is cleaning.
 	CleanupJobTokenRingHashAnnotation = "internal.scylla-operator.scylladb.com/cleanup-token-ring-hash"
 
+	// PodTemplateHashAnnotation reflects the hash of the pod template a StatefulSet was last applied with.
+	PodTemplateHashAnnotation = "internal.scylla-operator.scylladb.com/pod-template-hash"
+
 	// NodeStatusReportAnnotation reflects the current status report from the ScyllaDB node.
 	NodeStatusReportAnnotation = "internal.scylla.scylladb.com/scylladb-node-status-report"
+
+	// RemoveNodeHostIDsAnnotation holds a comma-separated list of host IDs of unreachable nodes which ScyllaDBDatacenter removes from the cluster.
+	RemoveNodeHostIDsAnnotation = "internal.scylla-operator.scylladb.com/remove-node-host-ids"
 )
 
 // Annotations used for feature backward compatibility between v1.ScyllaCluster and v1alpha1.ScyllaDBDatacenter
@@ -89,6 +95,15 @@ const (
 	// PodTypeCleanupJob indicates that the pod is a cleanup job pod.
 	PodTypeCleanupJob PodType = "cleanup-job"
 
+	// PodTypeUpgradeSSTablesJob indicates that the pod is an SSTables upgrade job pod.
+	PodTypeUpgradeSSTablesJob PodType = "upgradesstables-job"
+
+	// PodTypeRemoveNodeJob indicates that the pod is a node removal job pod.
+	PodTypeRemoveNodeJob PodType = "removenode-job"
+
+	// PodTypeRebuildJob indicates that the pod is a node rebuild job pod.
+	PodTypeRebuildJob PodType = "rebuild-job"
+
 	// PodTypeNodePerftuneJob indicates that the pod is a node perftune job pod.
 	PodTypeNodePerftuneJob PodType = "node-perftune-job"
 
@@ -153,6 +168,9 @@ const (
 	PerftuneContainerName           = "perftune"
 	SysctlsContainerName            = "sysctls"
 	CleanupContainerName            = "cleanup"
+	UpgradeSSTablesContainerName    = "upgradesstables"
+	RemoveNodeContainerName         = "removenode"
+	RebuildContainerName            = "rebuild"
 	RLimitsContainerName            = "rlimits"
 
 	PVCTemplateName = "data"
@@ -236,7 +254,10 @@ const (
 type NodeJobType string
 
 const (
-	JobTypeCleanup NodeJobType = "Cleanup"
+	JobTypeCleanup         NodeJobType = "Cleanup"
+	JobTypeUpgradeSSTables NodeJobType = "UpgradeSSTables"
+	JobTypeRemoveNode      NodeJobType = "RemoveNode"
+	JobTypeRebuild         NodeJobType = "Rebuild"
 )
 
 const (
@@ -311,6 +332,12 @@ const (
 	ScyllaDBManagerClusterRegistrationNameOverrideAnnotation = "internal.scylla-operator.scylladb.com/scylladb-manager-cluster-name-override"
 
 	ScyllaDBManagerTaskFinalizer = "scylla-operator.scylladb.com/scylladbmanagertask-deletion"
+	// ScyllaDBManagerTaskRunNowAnnotation is used to request an immediate run of a ScyllaDBManagerTask without changing its schedule.
+	// Every new value of the annotation triggers a single run.
+	ScyllaDBManagerTaskRunNowAnnotation = "scylla-operator.scylladb.com/scylladb-manager-task-run-now"
+	// ScyllaDBManagerTaskAdoptAnnotation is used to bind a ScyllaDBManagerTask to a pre-existing task in ScyllaDB Manager state.
+	// Its value is the name of the task in ScyllaDB Manager state, which is adopted if it isn't owned by any other ScyllaDBManagerTask.
+	ScyllaDBManagerTaskAdoptAnnotation = "scylla-operator.scylladb.com/scylladb-manager-task-adopt"
 	// ScyllaDBManagerTaskMissingOwnerUIDForceAdoptAnnotation is used to annotate a ScyllaDBManagerTask to force adoption of a matching task in ScyllaDB Manager state that is missing an owner UID label.
 	ScyllaDBManagerTaskMissingOwnerUIDForceAdoptAnnotation         = "internal.scylla-operator.scylladb.com/scylladb-manager-task-missing-owner-uid-force-adopt"
 	ScyllaDBManagerTaskNameOverrideAnnotation                      = "internal.scylla-operator.scylladb.com/scylladb-manager-task-name-override"
@@ -342,3 +369,15 @@ const (
 	// This can be used on a particular member Service to override the normal bootstrap precondition checks, or on the entire ScyllaDBDatacenter to override the default behavior for all members.
 	ForceProceedToBootstrapAnnotation = "scylla-operator.scylladb.com/force-proceed-to-bootstrap"
 )
+
+const (
+	// ScyllaDBVolumeSnapshotNameLabel is used to label VolumeSnapshots created for a ScyllaDBVolumeSnapshot.
+	ScyllaDBVolumeSnapshotNameLabel = "scylla-operator.scylladb.com/scylladbvolumesnapshot-name"
+
+	// ScyllaDBVolumeSnapshotFinalizer is used to remove the ScyllaDB snapshot taken for the VolumeSnapshots from ScyllaDB nodes
+	// before a ScyllaDBVolumeSnapshot is deleted.
+	ScyllaDBVolumeSnapshotFinalizer = "scylla-operator.scylladb.com/scylladbvolumesnapshot-deletion"
+
+	// ScyllaDBSnapshotFinalizer is used to remove the snapshot from ScyllaDB nodes before a ScyllaDBSnapshot is deleted.
+	ScyllaDBSnapshotFinalizer = "scylla-operator.scylladb.com/scylladbsnapshot-deletion"
+)
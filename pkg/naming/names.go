@@ -264,6 +264,18 @@ func CleanupJobForService(svcName string) string {
 	return fmt.Sprintf("cleanup-%s", svcName)
 }
 
+func UpgradeSSTablesJobForService(svcName string) string {
+	return fmt.Sprintf("upgradesstables-%s", svcName)
+}
+
+func RemoveNodeJobForHostID(hostID string) string {
+	return fmt.Sprintf("removenode-%s", hostID)
+}
+
+func RebuildJobForService(svcName string) string {
+	return fmt.Sprintf("rebuild-%s", svcName)
+}
+
 func GetScyllaDBManagedConfigCMName(clusterName string) string {
 	return fmt.Sprintf("%s-managed-config", clusterName)
 }
@@ -321,6 +333,12 @@ func ScyllaDBManagerClusterRegistrationNameForScyllaDBManagerTask(smt *scyllav1a
 	return scyllaDBManagerClusterRegistrationName(smt.Spec.ScyllaDBClusterRef.Kind, smt.Spec.ScyllaDBClusterRef.Name)
 }
 
+// RestoreFromBackupScyllaDBManagerTaskName returns the name of the ScyllaDBManagerTask restoring the given stage of a backup
+// into the cluster of the given kind and name.
+func RestoreFromBackupScyllaDBManagerTaskName(kind, name, stage string) (string, error) {
+	return generateTruncatedHashedName(apimachineryutilvalidation.DNS1123SubdomainMaxLength, kind, name, "restore", stage)
+}
+
 func scyllaDBManagerClusterRegistrationName(kind, name string) (string, error) {
 	return generateTruncatedHashedName(apimachineryutilvalidation.DNS1123SubdomainMaxLength, kind, name)
 }
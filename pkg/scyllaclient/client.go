@@ -17,6 +17,7 @@ import (
 	"github.com/hailocab/go-hostpool"
 	"github.com/scylladb/go-set/strset"
 	"github.com/scylladb/scylla-operator/pkg/auth"
+	"github.com/scylladb/scylla-operator/pkg/pointer"
 	"github.com/scylladb/scylla-operator/pkg/util/httpx"
 	scyllaclient "github.com/scylladb/scylladb-swagger-go-client/scylladb/gen/v1/client"
 	scyllaoperations "github.com/scylladb/scylladb-swagger-go-client/scylladb/gen/v1/client/operations"
@@ -224,6 +225,33 @@ func (c *Client) GetNodeTokens(ctx context.Context, host, endpoint string) ([]st
 	return resp.GetPayload(), nil
 }
 
+// GetDatacenterReplicationFactor returns the number of replicas the keyspace keeps in the datacenter.
+// It is derived from the token ring, as the replication options aren't exposed by the REST API.
+func (c *Client) GetDatacenterReplicationFactor(ctx context.Context, host, keyspace, datacenter string) (int, error) {
+	ctx = forceHost(ctx, host)
+
+	resp, err := c.scyllaClient.Operations.StorageServiceDescribeRingByKeyspaceGet(&scyllaoperations.StorageServiceDescribeRingByKeyspaceGetParams{
+		Context:  ctx,
+		Keyspace: keyspace,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	rf := 0
+	for _, tokenRange := range resp.GetPayload() {
+		replicas := 0
+		for _, ed := range tokenRange.EndpointDetails {
+			if ed.Datacenter == datacenter {
+				replicas++
+			}
+		}
+		rf = max(rf, replicas)
+	}
+
+	return rf, nil
+}
+
 func (c *Client) Cleanup(ctx context.Context, host string, keyspace string) error {
 	const (
 		// Cleanup is synchronous call and may take a long time to finish.
@@ -259,6 +287,41 @@ func (c *Client) StopCleanup(ctx context.Context, host string) error {
 	return nil
 }
 
+func (c *Client) UpgradeSSTables(ctx context.Context, host string, keyspace string) error {
+	const (
+		// Upgrading SSTables is a synchronous call rewriting all SSTables of the keyspace.
+		upgradeSSTablesTimeout = 24 * time.Hour
+	)
+
+	ctx = forceHost(ctx, host)
+	ctx = customTimeout(ctx, upgradeSSTablesTimeout)
+
+	_, err := c.scyllaClient.Operations.StorageServiceKeyspaceUpgradeSstablesByKeyspaceGet(&scyllaoperations.StorageServiceKeyspaceUpgradeSstablesByKeyspaceGetParams{
+		Context:               ctx,
+		Keyspace:              keyspace,
+		ExcludeCurrentVersion: pointer.Ptr(true),
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) StopUpgradeSSTables(ctx context.Context, host string) error {
+	ctx = forceHost(ctx, host)
+
+	_, err := c.scyllaClient.Operations.CompactionManagerStopCompactionPost(&scyllaoperations.CompactionManagerStopCompactionPostParams{
+		Context: ctx,
+		Type:    string(UpgradeCompactionType),
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (c *Client) GetSnitchDatacenter(ctx context.Context, host string) (string, error) {
 	resp, err := c.scyllaClient.Operations.SnitchDatacenterGet(&scyllaoperations.SnitchDatacenterGetParams{
 		Context: ctx,
@@ -285,6 +348,18 @@ func (c *Client) Keyspaces(ctx context.Context) ([]string, error) {
 	return resp.Payload, nil
 }
 
+// NonLocalKeyspaces return a list of keyspaces which are replicated across nodes.
+func (c *Client) NonLocalKeyspaces(ctx context.Context) ([]string, error) {
+	resp, err := c.scyllaClient.Operations.StorageServiceKeyspacesGet(&scyllaoperations.StorageServiceKeyspacesGetParams{
+		Context: ctx,
+		Type:    pointer.Ptr("non_local_strategy"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.Payload, nil
+}
+
 // Snapshots lists available snapshots.
 func (c *Client) Snapshots(ctx context.Context, host string) ([]string, error) {
 	ctx = customTimeout(ctx, snapshotTimeout)
@@ -375,6 +450,50 @@ func (c *Client) Decommission(ctx context.Context, host string) error {
 	return nil
 }
 
+func (c *Client) RemoveNode(ctx context.Context, host string, hostID string) error {
+	const (
+		// Removing a node is a synchronous call streaming the data of the removed node from the remaining replicas.
+		removeNodeTimeout = 24 * time.Hour
+	)
+
+	ctx = forceHost(ctx, host)
+	ctx = customTimeout(ctx, removeNodeTimeout)
+	// A retried request would fail because the node removal is already in progress.
+	ctx = noRetry(ctx)
+
+	_, err := c.scyllaClient.Operations.StorageServiceRemoveNodePost(&scyllaoperations.StorageServiceRemoveNodePostParams{
+		Context: ctx,
+		HostID:  hostID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) Rebuild(ctx context.Context, host string, sourceDatacenter string) error {
+	const (
+		// Rebuild is a synchronous call streaming the data of the node from the source datacenter.
+		rebuildTimeout = 24 * time.Hour
+	)
+
+	ctx = forceHost(ctx, host)
+	ctx = customTimeout(ctx, rebuildTimeout)
+	// A retried request would fail because the rebuild is already in progress.
+	ctx = noRetry(ctx)
+
+	_, err := c.scyllaClient.Operations.StorageServiceRebuildPost(&scyllaoperations.StorageServiceRebuildPostParams{
+		Context:  ctx,
+		SourceDc: &sourceDatacenter,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (c *Client) ScyllaVersion(ctx context.Context) (string, error) {
 	resp, err := c.scyllaClient.Operations.StorageServiceScyllaReleaseVersionGet(&scyllaoperations.StorageServiceScyllaReleaseVersionGetParams{Context: ctx})
 	if err != nil {
@@ -121,6 +121,7 @@ type CompactionType string
 
 const (
 	CleanupCompactionType CompactionType = "CLEANUP"
+	UpgradeCompactionType CompactionType = "UPGRADE"
 )
 
 // NodeStatusAndStateInfo represents a node's status and state (like in nodetool status).
@@ -0,0 +1,101 @@
+// Copyright (C) 2025 ScyllaDB
+
+package semver
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+	"github.com/scylladb/scylla-operator/pkg/naming"
+)
+
+// scyllaDBUpgradeSources maps ScyllaDB release lines to the release lines they can be upgraded from directly.
+// Upgrades within a release line are always supported.
+// New ScyllaDB releases have to be added here, upgrades involving unknown release lines can't be verified.
+var scyllaDBUpgradeSources = map[string][]string{
+	// Open source releases.
+	"4.6": {},
+	"5.0": {"4.6"},
+	"5.1": {"5.0"},
+	"5.2": {"5.1"},
+	"5.4": {"5.2"},
+	"6.0": {"5.4"},
+	"6.1": {"6.0"},
+	"6.2": {"6.1"},
+
+	// Enterprise releases.
+	"2021.1": {},
+	"2022.1": {"2021.1"},
+	"2022.2": {"2022.1"},
+	"2023.1": {"2022.2"},
+	"2024.1": {"2023.1"},
+	"2024.2": {"2024.1"},
+
+	// Source available releases.
+	"2025.1": {"6.2", "2024.1", "2024.2"},
+	"2025.2": {"2025.1"},
+	"2025.3": {"2025.2"},
+}
+
+// ImageToVersion parses the semantic version from the tag of the ScyllaDB image.
+func ImageToVersion(image string) (semver.Version, error) {
+	version, err := naming.ImageToVersion(image)
+	if err != nil {
+		return semver.Version{}, err
+	}
+
+	return semver.Parse(version)
+}
+
+func releaseLine(v semver.Version) string {
+	return fmt.Sprintf("%d.%d", v.Major, v.Minor)
+}
+
+// IsKnownScyllaDBRelease returns true when the upgrade paths of the release line of the version are known.
+func IsKnownScyllaDBRelease(v semver.Version) bool {
+	_, ok := scyllaDBUpgradeSources[releaseLine(v)]
+	return ok
+}
+
+// GetScyllaDBUpgradePath returns the shortest list of release lines an upgrade between the versions has to go through,
+// excluding the release lines of the versions themselves. An empty list means the upgrade is supported directly.
+// It returns false when the upgrade path can't be determined, e.g. for unknown release lines or downgrades.
+func GetScyllaDBUpgradePath(from, to semver.Version) ([]string, bool) {
+	fromLine, toLine := releaseLine(from), releaseLine(to)
+	if fromLine == toLine {
+		return nil, from.LTE(to)
+	}
+
+	if !IsKnownScyllaDBRelease(from) || !IsKnownScyllaDBRelease(to) {
+		return nil, false
+	}
+
+	// Search backwards from the target, so the path is built in the upgrade order.
+	next := map[string]string{
+		toLine: "",
+	}
+	queue := []string{toLine}
+	for len(queue) > 0 {
+		line := queue[0]
+		queue = queue[1:]
+
+		for _, source := range scyllaDBUpgradeSources[line] {
+			if _, visited := next[source]; visited {
+				continue
+			}
+			next[source] = line
+
+			if source == fromLine {
+				var path []string
+				for l := line; l != toLine; l = next[l] {
+					path = append(path, l)
+				}
+				return path, true
+			}
+
+			queue = append(queue, source)
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,141 @@
+// Copyright (C) 2025 ScyllaDB
+
+package semver
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/blang/semver"
+)
+
+func TestImageToVersion(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name            string
+		image           string
+		expectedVersion semver.Version
+		expectedErr     bool
+	}{
+		{
+			name:            "image tagged with a semantic version",
+			image:           "docker.io/scylladb/scylla:2025.1.2",
+			expectedVersion: semver.MustParse("2025.1.2"),
+		},
+		{
+			name:        "image tagged with a non-semantic version",
+			image:       "docker.io/scylladb/scylla:latest",
+			expectedErr: true,
+		},
+		{
+			name:        "untagged image",
+			image:       "docker.io/scylladb/scylla",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ImageToVersion(tc.image)
+			if (err != nil) != tc.expectedErr {
+				t.Fatalf("expected error %t, got %v", tc.expectedErr, err)
+			}
+			if !got.EQ(tc.expectedVersion) {
+				t.Errorf("expected version %q, got %q", tc.expectedVersion, got)
+			}
+		})
+	}
+}
+
+func TestGetScyllaDBUpgradePath(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name         string
+		from         string
+		to           string
+		expectedPath []string
+		expectedOK   bool
+	}{
+		{
+			name:         "patch upgrade",
+			from:         "6.2.0",
+			to:           "6.2.1",
+			expectedPath: nil,
+			expectedOK:   true,
+		},
+		{
+			name:         "direct upgrade",
+			from:         "6.1.0",
+			to:           "6.2.1",
+			expectedPath: nil,
+			expectedOK:   true,
+		},
+		{
+			name:         "direct upgrade from enterprise to source available release",
+			from:         "2024.1.5",
+			to:           "2025.1.0",
+			expectedPath: nil,
+			expectedOK:   true,
+		},
+		{
+			name:         "upgrade skipping a release",
+			from:         "6.0.0",
+			to:           "6.2.0",
+			expectedPath: []string{"6.1"},
+			expectedOK:   true,
+		},
+		{
+			name:         "enterprise upgrade skipping a release",
+			from:         "2023.1.0",
+			to:           "2025.1.0",
+			expectedPath: []string{"2024.1"},
+			expectedOK:   true,
+		},
+		{
+			name:         "upgrade from open source to source available release",
+			from:         "5.4.0",
+			to:           "2025.2.0",
+			expectedPath: []string{"6.0", "6.1", "6.2", "2025.1"},
+			expectedOK:   true,
+		},
+		{
+			name:         "downgrade",
+			from:         "6.2.0",
+			to:           "6.1.0",
+			expectedPath: nil,
+			expectedOK:   false,
+		},
+		{
+			name:         "patch downgrade",
+			from:         "6.2.1",
+			to:           "6.2.0",
+			expectedPath: nil,
+			expectedOK:   false,
+		},
+		{
+			name:         "unknown release",
+			from:         "6.2.0",
+			to:           "7.0.0",
+			expectedPath: nil,
+			expectedOK:   false,
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			path, ok := GetScyllaDBUpgradePath(semver.MustParse(tc.from), semver.MustParse(tc.to))
+			if ok != tc.expectedOK {
+				t.Errorf("expected %t, got %t", tc.expectedOK, ok)
+			}
+			if !reflect.DeepEqual(path, tc.expectedPath) {
+				t.Errorf("expected path %v, got %v", tc.expectedPath, path)
+			}
+		})
+	}
+}